@@ -119,28 +119,909 @@ func (x *LoginWithFacebookRequest) GetAccessToken() string {
 }
 
 type LoginWithAppleRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	IdToken string                 `protobuf:"bytes,1,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	Nonce   string                 `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// 授权码, 用于换取 refresh_token 以便注销账号时撤销授权
+	AuthorizationCode string `protobuf:"bytes,3,opt,name=authorization_code,json=authorizationCode,proto3" json:"authorization_code,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *LoginWithAppleRequest) Reset() {
+	*x = LoginWithAppleRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginWithAppleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginWithAppleRequest) ProtoMessage() {}
+
+func (x *LoginWithAppleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginWithAppleRequest.ProtoReflect.Descriptor instead.
+func (*LoginWithAppleRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LoginWithAppleRequest) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
+func (x *LoginWithAppleRequest) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *LoginWithAppleRequest) GetAuthorizationCode() string {
+	if x != nil {
+		return x.AuthorizationCode
+	}
+	return ""
+}
+
+type LoginWithGoogleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdToken       string                 `protobuf:"bytes,1,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginWithGoogleRequest) Reset() {
+	*x = LoginWithGoogleRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginWithGoogleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginWithGoogleRequest) ProtoMessage() {}
+
+func (x *LoginWithGoogleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginWithGoogleRequest.ProtoReflect.Descriptor instead.
+func (*LoginWithGoogleRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LoginWithGoogleRequest) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
+type LoginWithSnapchatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginWithSnapchatRequest) Reset() {
+	*x = LoginWithSnapchatRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginWithSnapchatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginWithSnapchatRequest) ProtoMessage() {}
+
+func (x *LoginWithSnapchatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginWithSnapchatRequest.ProtoReflect.Descriptor instead.
+func (*LoginWithSnapchatRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LoginWithSnapchatRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+type LoginWithWechatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginWithWechatRequest) Reset() {
+	*x = LoginWithWechatRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginWithWechatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginWithWechatRequest) ProtoMessage() {}
+
+func (x *LoginWithWechatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginWithWechatRequest.ProtoReflect.Descriptor instead.
+func (*LoginWithWechatRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LoginWithWechatRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type LoginWithWechatMiniProgramRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginWithWechatMiniProgramRequest) Reset() {
+	*x = LoginWithWechatMiniProgramRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginWithWechatMiniProgramRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginWithWechatMiniProgramRequest) ProtoMessage() {}
+
+func (x *LoginWithWechatMiniProgramRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginWithWechatMiniProgramRequest.ProtoReflect.Descriptor instead.
+func (*LoginWithWechatMiniProgramRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LoginWithWechatMiniProgramRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type LoginWithOIDCRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	IdToken       string                 `protobuf:"bytes,2,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	Nonce         string                 `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginWithOIDCRequest) Reset() {
+	*x = LoginWithOIDCRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginWithOIDCRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginWithOIDCRequest) ProtoMessage() {}
+
+func (x *LoginWithOIDCRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginWithOIDCRequest.ProtoReflect.Descriptor instead.
+func (*LoginWithOIDCRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LoginWithOIDCRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *LoginWithOIDCRequest) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
+func (x *LoginWithOIDCRequest) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type LoginWithOAuthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	CodeVerifier  string                 `protobuf:"bytes,3,opt,name=code_verifier,json=codeVerifier,proto3" json:"code_verifier,omitempty"`
+	RedirectUri   string                 `protobuf:"bytes,4,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginWithOAuthRequest) Reset() {
+	*x = LoginWithOAuthRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginWithOAuthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginWithOAuthRequest) ProtoMessage() {}
+
+func (x *LoginWithOAuthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginWithOAuthRequest.ProtoReflect.Descriptor instead.
+func (*LoginWithOAuthRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LoginWithOAuthRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *LoginWithOAuthRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *LoginWithOAuthRequest) GetCodeVerifier() string {
+	if x != nil {
+		return x.CodeVerifier
+	}
+	return ""
+}
+
+func (x *LoginWithOAuthRequest) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
+type LoginWithFirebaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdToken       string                 `protobuf:"bytes,1,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginWithFirebaseRequest) Reset() {
+	*x = LoginWithFirebaseRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginWithFirebaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginWithFirebaseRequest) ProtoMessage() {}
+
+func (x *LoginWithFirebaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginWithFirebaseRequest.ProtoReflect.Descriptor instead.
+func (*LoginWithFirebaseRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LoginWithFirebaseRequest) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
+type AppleNotificationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Apple 签名的 JWS
+	Payload       string `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppleNotificationRequest) Reset() {
+	*x = AppleNotificationRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppleNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppleNotificationRequest) ProtoMessage() {}
+
+func (x *AppleNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppleNotificationRequest.ProtoReflect.Descriptor instead.
+func (*AppleNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AppleNotificationRequest) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+type AppleNotificationReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppleNotificationReply) Reset() {
+	*x = AppleNotificationReply{}
+	mi := &file_auth_v1_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppleNotificationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppleNotificationReply) ProtoMessage() {}
+
+func (x *AppleNotificationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppleNotificationReply.ProtoReflect.Descriptor instead.
+func (*AppleNotificationReply) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{11}
+}
+
+type LinkProviderRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Provider string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// 按登录方式填写对应的凭证
+	IdToken       string `protobuf:"bytes,2,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	Nonce         string `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	AccessToken   string `protobuf:"bytes,4,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	Code          string `protobuf:"bytes,5,opt,name=code,proto3" json:"code,omitempty"`
+	CodeVerifier  string `protobuf:"bytes,6,opt,name=code_verifier,json=codeVerifier,proto3" json:"code_verifier,omitempty"`
+	RedirectUri   string `protobuf:"bytes,7,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkProviderRequest) Reset() {
+	*x = LinkProviderRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkProviderRequest) ProtoMessage() {}
+
+func (x *LinkProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkProviderRequest.ProtoReflect.Descriptor instead.
+func (*LinkProviderRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LinkProviderRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *LinkProviderRequest) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
+func (x *LinkProviderRequest) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *LinkProviderRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *LinkProviderRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *LinkProviderRequest) GetCodeVerifier() string {
+	if x != nil {
+		return x.CodeVerifier
+	}
+	return ""
+}
+
+func (x *LinkProviderRequest) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
+type LinkedProvider struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Provider   string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ProviderId string                 `protobuf:"bytes,2,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`
+	// 关联时间, unix 秒
+	CreatedAt int64 `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// 第三方账号资料, 每次登录时更新
+	Email         string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	EmailVerified bool   `protobuf:"varint,5,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	Name          string `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Avatar        string `protobuf:"bytes,7,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkedProvider) Reset() {
+	*x = LinkedProvider{}
+	mi := &file_auth_v1_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkedProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkedProvider) ProtoMessage() {}
+
+func (x *LinkedProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkedProvider.ProtoReflect.Descriptor instead.
+func (*LinkedProvider) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LinkedProvider) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *LinkedProvider) GetProviderId() string {
+	if x != nil {
+		return x.ProviderId
+	}
+	return ""
+}
+
+func (x *LinkedProvider) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *LinkedProvider) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LinkedProvider) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *LinkedProvider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LinkedProvider) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+type UnlinkProviderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkProviderRequest) Reset() {
+	*x = UnlinkProviderRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkProviderRequest) ProtoMessage() {}
+
+func (x *UnlinkProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkProviderRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkProviderRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UnlinkProviderRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type UnlinkProviderReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkProviderReply) Reset() {
+	*x = UnlinkProviderReply{}
+	mi := &file_auth_v1_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkProviderReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkProviderReply) ProtoMessage() {}
+
+func (x *UnlinkProviderReply) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkProviderReply.ProtoReflect.Descriptor instead.
+func (*UnlinkProviderReply) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{15}
+}
+
+type ListLinkedProvidersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLinkedProvidersRequest) Reset() {
+	*x = ListLinkedProvidersRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLinkedProvidersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLinkedProvidersRequest) ProtoMessage() {}
+
+func (x *ListLinkedProvidersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLinkedProvidersRequest.ProtoReflect.Descriptor instead.
+func (*ListLinkedProvidersRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{16}
+}
+
+type ListLinkedProvidersReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Providers     []*LinkedProvider      `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLinkedProvidersReply) Reset() {
+	*x = ListLinkedProvidersReply{}
+	mi := &file_auth_v1_auth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLinkedProvidersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLinkedProvidersReply) ProtoMessage() {}
+
+func (x *ListLinkedProvidersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLinkedProvidersReply.ProtoReflect.Descriptor instead.
+func (*ListLinkedProvidersReply) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListLinkedProvidersReply) GetProviders() []*LinkedProvider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+type MergeAccountsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 被合并账号的登录 token, 证明用户拥有该账号
+	SourceToken   string `protobuf:"bytes,1,opt,name=source_token,json=sourceToken,proto3" json:"source_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeAccountsRequest) Reset() {
+	*x = MergeAccountsRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeAccountsRequest) ProtoMessage() {}
+
+func (x *MergeAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeAccountsRequest.ProtoReflect.Descriptor instead.
+func (*MergeAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MergeAccountsRequest) GetSourceToken() string {
+	if x != nil {
+		return x.SourceToken
+	}
+	return ""
+}
+
+type MergeAccountsReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IdToken       string                 `protobuf:"bytes,1,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
-	Nonce         string                 `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	UserInfo      *UserInfo              `protobuf:"bytes,1,opt,name=user_info,json=userInfo,proto3" json:"user_info,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginWithAppleRequest) Reset() {
-	*x = LoginWithAppleRequest{}
-	mi := &file_auth_v1_auth_proto_msgTypes[2]
+func (x *MergeAccountsReply) Reset() {
+	*x = MergeAccountsReply{}
+	mi := &file_auth_v1_auth_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginWithAppleRequest) String() string {
+func (x *MergeAccountsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginWithAppleRequest) ProtoMessage() {}
+func (*MergeAccountsReply) ProtoMessage() {}
 
-func (x *LoginWithAppleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_v1_auth_proto_msgTypes[2]
+func (x *MergeAccountsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -151,47 +1032,137 @@ func (x *LoginWithAppleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginWithAppleRequest.ProtoReflect.Descriptor instead.
-func (*LoginWithAppleRequest) Descriptor() ([]byte, []int) {
-	return file_auth_v1_auth_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MergeAccountsReply.ProtoReflect.Descriptor instead.
+func (*MergeAccountsReply) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *LoginWithAppleRequest) GetIdToken() string {
+func (x *MergeAccountsReply) GetUserInfo() *UserInfo {
 	if x != nil {
-		return x.IdToken
+		return x.UserInfo
+	}
+	return nil
+}
+
+type LoginAsGuestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 设备生成并保存的随机密钥, 至少 32 个字符
+	DeviceSecret  string `protobuf:"bytes,1,opt,name=device_secret,json=deviceSecret,proto3" json:"device_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginAsGuestRequest) Reset() {
+	*x = LoginAsGuestRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginAsGuestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginAsGuestRequest) ProtoMessage() {}
+
+func (x *LoginAsGuestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginAsGuestRequest.ProtoReflect.Descriptor instead.
+func (*LoginAsGuestRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LoginAsGuestRequest) GetDeviceSecret() string {
+	if x != nil {
+		return x.DeviceSecret
 	}
 	return ""
 }
 
-func (x *LoginWithAppleRequest) GetNonce() string {
+type LinkPhoneRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PhoneNumber      string                 `protobuf:"bytes,1,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	VerificationCode string                 `protobuf:"bytes,2,opt,name=verification_code,json=verificationCode,proto3" json:"verification_code,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LinkPhoneRequest) Reset() {
+	*x = LinkPhoneRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkPhoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkPhoneRequest) ProtoMessage() {}
+
+func (x *LinkPhoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[21]
 	if x != nil {
-		return x.Nonce
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkPhoneRequest.ProtoReflect.Descriptor instead.
+func (*LinkPhoneRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LinkPhoneRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
 	}
 	return ""
 }
 
-type LoginWithGoogleRequest struct {
+func (x *LinkPhoneRequest) GetVerificationCode() string {
+	if x != nil {
+		return x.VerificationCode
+	}
+	return ""
+}
+
+type LinkPhoneReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IdToken       string                 `protobuf:"bytes,1,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	UserInfo      *UserInfo              `protobuf:"bytes,1,opt,name=user_info,json=userInfo,proto3" json:"user_info,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginWithGoogleRequest) Reset() {
-	*x = LoginWithGoogleRequest{}
-	mi := &file_auth_v1_auth_proto_msgTypes[3]
+func (x *LinkPhoneReply) Reset() {
+	*x = LinkPhoneReply{}
+	mi := &file_auth_v1_auth_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginWithGoogleRequest) String() string {
+func (x *LinkPhoneReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginWithGoogleRequest) ProtoMessage() {}
+func (*LinkPhoneReply) ProtoMessage() {}
 
-func (x *LoginWithGoogleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_v1_auth_proto_msgTypes[3]
+func (x *LinkPhoneReply) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -202,40 +1173,86 @@ func (x *LoginWithGoogleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginWithGoogleRequest.ProtoReflect.Descriptor instead.
-func (*LoginWithGoogleRequest) Descriptor() ([]byte, []int) {
-	return file_auth_v1_auth_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use LinkPhoneReply.ProtoReflect.Descriptor instead.
+func (*LinkPhoneReply) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *LoginWithGoogleRequest) GetIdToken() string {
+func (x *LinkPhoneReply) GetUserInfo() *UserInfo {
 	if x != nil {
-		return x.IdToken
+		return x.UserInfo
 	}
-	return ""
+	return nil
 }
 
-type LoginWithSnapchatRequest struct {
+type UploadAvatarRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 原始图片, 支持 jpeg/png/gif
+	Image         []byte `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAvatarRequest) Reset() {
+	*x = UploadAvatarRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAvatarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAvatarRequest) ProtoMessage() {}
+
+func (x *UploadAvatarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAvatarRequest.ProtoReflect.Descriptor instead.
+func (*UploadAvatarRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UploadAvatarRequest) GetImage() []byte {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+type AvatarImage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	Size          int32                  `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginWithSnapchatRequest) Reset() {
-	*x = LoginWithSnapchatRequest{}
-	mi := &file_auth_v1_auth_proto_msgTypes[4]
+func (x *AvatarImage) Reset() {
+	*x = AvatarImage{}
+	mi := &file_auth_v1_auth_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginWithSnapchatRequest) String() string {
+func (x *AvatarImage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginWithSnapchatRequest) ProtoMessage() {}
+func (*AvatarImage) ProtoMessage() {}
 
-func (x *LoginWithSnapchatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_v1_auth_proto_msgTypes[4]
+func (x *AvatarImage) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -246,18 +1263,78 @@ func (x *LoginWithSnapchatRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginWithSnapchatRequest.ProtoReflect.Descriptor instead.
-func (*LoginWithSnapchatRequest) Descriptor() ([]byte, []int) {
-	return file_auth_v1_auth_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use AvatarImage.ProtoReflect.Descriptor instead.
+func (*AvatarImage) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *LoginWithSnapchatRequest) GetAccessToken() string {
+func (x *AvatarImage) GetSize() int32 {
 	if x != nil {
-		return x.AccessToken
+		return x.Size
+	}
+	return 0
+}
+
+func (x *AvatarImage) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type UploadAvatarReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 最大尺寸的地址, 同 user_info.avatar
+	Avatar        string         `protobuf:"bytes,1,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Images        []*AvatarImage `protobuf:"bytes,2,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAvatarReply) Reset() {
+	*x = UploadAvatarReply{}
+	mi := &file_auth_v1_auth_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAvatarReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAvatarReply) ProtoMessage() {}
+
+func (x *UploadAvatarReply) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAvatarReply.ProtoReflect.Descriptor instead.
+func (*UploadAvatarReply) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UploadAvatarReply) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
 	}
 	return ""
 }
 
+func (x *UploadAvatarReply) GetImages() []*AvatarImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
 type LoginResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
@@ -269,7 +1346,7 @@ type LoginResponse struct {
 
 func (x *LoginResponse) Reset() {
 	*x = LoginResponse{}
-	mi := &file_auth_v1_auth_proto_msgTypes[5]
+	mi := &file_auth_v1_auth_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -281,7 +1358,7 @@ func (x *LoginResponse) String() string {
 func (*LoginResponse) ProtoMessage() {}
 
 func (x *LoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_v1_auth_proto_msgTypes[5]
+	mi := &file_auth_v1_auth_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -294,7 +1371,7 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
 func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return file_auth_v1_auth_proto_rawDescGZIP(), []int{5}
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *LoginResponse) GetToken() string {
@@ -319,19 +1396,28 @@ func (x *LoginResponse) GetUserInfo() *UserInfo {
 }
 
 type UserInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Avatar        string                 `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
-	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Avatar string                 `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Phone  string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email  string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	// YYYY-MM-DD, 空表示未设置
+	Birthday      string            `protobuf:"bytes,6,opt,name=birthday,proto3" json:"birthday,omitempty"`
+	Gender        string            `protobuf:"bytes,7,opt,name=gender,proto3" json:"gender,omitempty"`
+	Locale        string            `protobuf:"bytes,8,opt,name=locale,proto3" json:"locale,omitempty"`
+	Timezone      string            `protobuf:"bytes,9,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Country       string            `protobuf:"bytes,10,opt,name=country,proto3" json:"country,omitempty"`
+	Bio           string            `protobuf:"bytes,11,opt,name=bio,proto3" json:"bio,omitempty"`
+	Metadata      map[string]string `protobuf:"bytes,12,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Handle        string            `protobuf:"bytes,13,opt,name=handle,proto3" json:"handle,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UserInfo) Reset() {
 	*x = UserInfo{}
-	mi := &file_auth_v1_auth_proto_msgTypes[6]
+	mi := &file_auth_v1_auth_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -343,7 +1429,7 @@ func (x *UserInfo) String() string {
 func (*UserInfo) ProtoMessage() {}
 
 func (x *UserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_v1_auth_proto_msgTypes[6]
+	mi := &file_auth_v1_auth_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,7 +1442,7 @@ func (x *UserInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserInfo.ProtoReflect.Descriptor instead.
 func (*UserInfo) Descriptor() ([]byte, []int) {
-	return file_auth_v1_auth_proto_rawDescGZIP(), []int{6}
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *UserInfo) GetUserId() int64 {
@@ -394,6 +1480,62 @@ func (x *UserInfo) GetEmail() string {
 	return ""
 }
 
+func (x *UserInfo) GetBirthday() string {
+	if x != nil {
+		return x.Birthday
+	}
+	return ""
+}
+
+func (x *UserInfo) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+func (x *UserInfo) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *UserInfo) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *UserInfo) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *UserInfo) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *UserInfo) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *UserInfo) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
 var File_auth_v1_auth_proto protoreflect.FileDescriptor
 
 const file_auth_v1_auth_proto_rawDesc = "" +
@@ -403,30 +1545,117 @@ const file_auth_v1_auth_proto_rawDesc = "" +
 	"\fphone_number\x18\x01 \x01(\tR\vphoneNumber\x12+\n" +
 	"\x11verification_code\x18\x02 \x01(\tR\x10verificationCode\"=\n" +
 	"\x18LoginWithFacebookRequest\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\"H\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\"w\n" +
 	"\x15LoginWithAppleRequest\x12\x19\n" +
 	"\bid_token\x18\x01 \x01(\tR\aidToken\x12\x14\n" +
-	"\x05nonce\x18\x02 \x01(\tR\x05nonce\"3\n" +
+	"\x05nonce\x18\x02 \x01(\tR\x05nonce\x12-\n" +
+	"\x12authorization_code\x18\x03 \x01(\tR\x11authorizationCode\"3\n" +
 	"\x16LoginWithGoogleRequest\x12\x19\n" +
 	"\bid_token\x18\x01 \x01(\tR\aidToken\"=\n" +
 	"\x18LoginWithSnapchatRequest\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\"u\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\",\n" +
+	"\x16LoginWithWechatRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"7\n" +
+	"!LoginWithWechatMiniProgramRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"c\n" +
+	"\x14LoginWithOIDCRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x19\n" +
+	"\bid_token\x18\x02 \x01(\tR\aidToken\x12\x14\n" +
+	"\x05nonce\x18\x03 \x01(\tR\x05nonce\"\x8f\x01\n" +
+	"\x15LoginWithOAuthRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12#\n" +
+	"\rcode_verifier\x18\x03 \x01(\tR\fcodeVerifier\x12!\n" +
+	"\fredirect_uri\x18\x04 \x01(\tR\vredirectUri\"5\n" +
+	"\x18LoginWithFirebaseRequest\x12\x19\n" +
+	"\bid_token\x18\x01 \x01(\tR\aidToken\"4\n" +
+	"\x18AppleNotificationRequest\x12\x18\n" +
+	"\apayload\x18\x01 \x01(\tR\apayload\"\x18\n" +
+	"\x16AppleNotificationReply\"\xe1\x01\n" +
+	"\x13LinkProviderRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x19\n" +
+	"\bid_token\x18\x02 \x01(\tR\aidToken\x12\x14\n" +
+	"\x05nonce\x18\x03 \x01(\tR\x05nonce\x12!\n" +
+	"\faccess_token\x18\x04 \x01(\tR\vaccessToken\x12\x12\n" +
+	"\x04code\x18\x05 \x01(\tR\x04code\x12#\n" +
+	"\rcode_verifier\x18\x06 \x01(\tR\fcodeVerifier\x12!\n" +
+	"\fredirect_uri\x18\a \x01(\tR\vredirectUri\"\xd5\x01\n" +
+	"\x0eLinkedProvider\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x1f\n" +
+	"\vprovider_id\x18\x02 \x01(\tR\n" +
+	"providerId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\x03R\tcreatedAt\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12%\n" +
+	"\x0eemail_verified\x18\x05 \x01(\bR\remailVerified\x12\x12\n" +
+	"\x04name\x18\x06 \x01(\tR\x04name\x12\x16\n" +
+	"\x06avatar\x18\a \x01(\tR\x06avatar\"3\n" +
+	"\x15UnlinkProviderRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\"\x15\n" +
+	"\x13UnlinkProviderReply\"\x1c\n" +
+	"\x1aListLinkedProvidersRequest\"Q\n" +
+	"\x18ListLinkedProvidersReply\x125\n" +
+	"\tproviders\x18\x01 \x03(\v2\x17.auth.v1.LinkedProviderR\tproviders\"9\n" +
+	"\x14MergeAccountsRequest\x12!\n" +
+	"\fsource_token\x18\x01 \x01(\tR\vsourceToken\"D\n" +
+	"\x12MergeAccountsReply\x12.\n" +
+	"\tuser_info\x18\x01 \x01(\v2\x11.auth.v1.UserInfoR\buserInfo\":\n" +
+	"\x13LoginAsGuestRequest\x12#\n" +
+	"\rdevice_secret\x18\x01 \x01(\tR\fdeviceSecret\"b\n" +
+	"\x10LinkPhoneRequest\x12!\n" +
+	"\fphone_number\x18\x01 \x01(\tR\vphoneNumber\x12+\n" +
+	"\x11verification_code\x18\x02 \x01(\tR\x10verificationCode\"@\n" +
+	"\x0eLinkPhoneReply\x12.\n" +
+	"\tuser_info\x18\x01 \x01(\v2\x11.auth.v1.UserInfoR\buserInfo\"+\n" +
+	"\x13UploadAvatarRequest\x12\x14\n" +
+	"\x05image\x18\x01 \x01(\fR\x05image\"3\n" +
+	"\vAvatarImage\x12\x12\n" +
+	"\x04size\x18\x01 \x01(\x05R\x04size\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"Y\n" +
+	"\x11UploadAvatarReply\x12\x16\n" +
+	"\x06avatar\x18\x01 \x01(\tR\x06avatar\x12,\n" +
+	"\x06images\x18\x02 \x03(\v2\x14.auth.v1.AvatarImageR\x06images\"u\n" +
 	"\rLoginResponse\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1e\n" +
 	"\vis_new_user\x18\x02 \x01(\bR\tisNewUser\x12.\n" +
-	"\tuser_info\x18\x03 \x01(\v2\x11.auth.v1.UserInfoR\buserInfo\"{\n" +
+	"\tuser_info\x18\x03 \x01(\v2\x11.auth.v1.UserInfoR\buserInfo\"\xa1\x03\n" +
 	"\bUserInfo\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
 	"\x06avatar\x18\x03 \x01(\tR\x06avatar\x12\x14\n" +
 	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x14\n" +
-	"\x05email\x18\x05 \x01(\tR\x05email2\xd2\x04\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12\x1a\n" +
+	"\bbirthday\x18\x06 \x01(\tR\bbirthday\x12\x16\n" +
+	"\x06gender\x18\a \x01(\tR\x06gender\x12\x16\n" +
+	"\x06locale\x18\b \x01(\tR\x06locale\x12\x1a\n" +
+	"\btimezone\x18\t \x01(\tR\btimezone\x12\x18\n" +
+	"\acountry\x18\n" +
+	" \x01(\tR\acountry\x12\x10\n" +
+	"\x03bio\x18\v \x01(\tR\x03bio\x12;\n" +
+	"\bmetadata\x18\f \x03(\v2\x1f.auth.v1.UserInfo.MetadataEntryR\bmetadata\x12\x16\n" +
+	"\x06handle\x18\r \x01(\tR\x06handle\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\xb3\x10\n" +
 	"\vAuthService\x12n\n" +
 	"\x0eLoginWithPhone\x12\x1e.auth.v1.LoginWithPhoneRequest\x1a\x16.auth.v1.LoginResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/user/v1/login_with_phone\x12w\n" +
 	"\x11LoginWithFacebook\x12!.auth.v1.LoginWithFacebookRequest\x1a\x16.auth.v1.LoginResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/user/v1/login_with_facebook\x12n\n" +
 	"\x0eLoginWithApple\x12\x1e.auth.v1.LoginWithAppleRequest\x1a\x16.auth.v1.LoginResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/user/v1/login_with_apple\x12q\n" +
 	"\x0fLoginWithGoogle\x12\x1f.auth.v1.LoginWithGoogleRequest\x1a\x16.auth.v1.LoginResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/user/v1/login_with_google\x12w\n" +
-	"\x11LoginWithSnapchat\x12!.auth.v1.LoginWithSnapchatRequest\x1a\x16.auth.v1.LoginResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/user/v1/login_with_snapchatB\x10Z\x0eapi/auth/v1;v1b\x06proto3"
+	"\x11LoginWithSnapchat\x12!.auth.v1.LoginWithSnapchatRequest\x1a\x16.auth.v1.LoginResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/user/v1/login_with_snapchat\x12q\n" +
+	"\x0fLoginWithWechat\x12\x1f.auth.v1.LoginWithWechatRequest\x1a\x16.auth.v1.LoginResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/user/v1/login_with_wechat\x12\x94\x01\n" +
+	"\x1aLoginWithWechatMiniProgram\x12*.auth.v1.LoginWithWechatMiniProgramRequest\x1a\x16.auth.v1.LoginResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/user/v1/login_with_wechat_mini_program\x12k\n" +
+	"\rLoginWithOIDC\x12\x1d.auth.v1.LoginWithOIDCRequest\x1a\x16.auth.v1.LoginResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/user/v1/login_with_oidc\x12n\n" +
+	"\x0eLoginWithOAuth\x12\x1e.auth.v1.LoginWithOAuthRequest\x1a\x16.auth.v1.LoginResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/user/v1/login_with_oauth\x12w\n" +
+	"\x11LoginWithFirebase\x12!.auth.v1.LoginWithFirebaseRequest\x1a\x16.auth.v1.LoginResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/user/v1/login_with_firebase\x12\x80\x01\n" +
+	"\x11AppleNotification\x12!.auth.v1.AppleNotificationRequest\x1a\x1f.auth.v1.AppleNotificationReply\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/user/v1/apple/notifications\x12i\n" +
+	"\fLinkProvider\x12\x1c.auth.v1.LinkProviderRequest\x1a\x17.auth.v1.LinkedProvider\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/user/v1/providers/link\x12t\n" +
+	"\x0eUnlinkProvider\x12\x1e.auth.v1.UnlinkProviderRequest\x1a\x1c.auth.v1.UnlinkProviderReply\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/user/v1/providers/unlink\x12y\n" +
+	"\x13ListLinkedProviders\x12#.auth.v1.ListLinkedProvidersRequest\x1a!.auth.v1.ListLinkedProvidersReply\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/user/v1/providers\x12o\n" +
+	"\rMergeAccounts\x12\x1d.auth.v1.MergeAccountsRequest\x1a\x1b.auth.v1.MergeAccountsReply\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/user/v1/accounts/merge\x12h\n" +
+	"\fLoginAsGuest\x12\x1c.auth.v1.LoginAsGuestRequest\x1a\x16.auth.v1.LoginResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/user/v1/login_as_guest\x12_\n" +
+	"\tLinkPhone\x12\x19.auth.v1.LinkPhoneRequest\x1a\x17.auth.v1.LinkPhoneReply\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/user/v1/phone/link\x12d\n" +
+	"\fUploadAvatar\x12\x1c.auth.v1.UploadAvatarRequest\x1a\x1a.auth.v1.UploadAvatarReply\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/user/v1/avatarB\x10Z\x0eapi/auth/v1;v1b\x06proto3"
 
 var (
 	file_auth_v1_auth_proto_rawDescOnce sync.Once
@@ -440,33 +1669,86 @@ func file_auth_v1_auth_proto_rawDescGZIP() []byte {
 	return file_auth_v1_auth_proto_rawDescData
 }
 
-var file_auth_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_auth_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_auth_v1_auth_proto_goTypes = []any{
-	(*LoginWithPhoneRequest)(nil),    // 0: auth.v1.LoginWithPhoneRequest
-	(*LoginWithFacebookRequest)(nil), // 1: auth.v1.LoginWithFacebookRequest
-	(*LoginWithAppleRequest)(nil),    // 2: auth.v1.LoginWithAppleRequest
-	(*LoginWithGoogleRequest)(nil),   // 3: auth.v1.LoginWithGoogleRequest
-	(*LoginWithSnapchatRequest)(nil), // 4: auth.v1.LoginWithSnapchatRequest
-	(*LoginResponse)(nil),            // 5: auth.v1.LoginResponse
-	(*UserInfo)(nil),                 // 6: auth.v1.UserInfo
+	(*LoginWithPhoneRequest)(nil),             // 0: auth.v1.LoginWithPhoneRequest
+	(*LoginWithFacebookRequest)(nil),          // 1: auth.v1.LoginWithFacebookRequest
+	(*LoginWithAppleRequest)(nil),             // 2: auth.v1.LoginWithAppleRequest
+	(*LoginWithGoogleRequest)(nil),            // 3: auth.v1.LoginWithGoogleRequest
+	(*LoginWithSnapchatRequest)(nil),          // 4: auth.v1.LoginWithSnapchatRequest
+	(*LoginWithWechatRequest)(nil),            // 5: auth.v1.LoginWithWechatRequest
+	(*LoginWithWechatMiniProgramRequest)(nil), // 6: auth.v1.LoginWithWechatMiniProgramRequest
+	(*LoginWithOIDCRequest)(nil),              // 7: auth.v1.LoginWithOIDCRequest
+	(*LoginWithOAuthRequest)(nil),             // 8: auth.v1.LoginWithOAuthRequest
+	(*LoginWithFirebaseRequest)(nil),          // 9: auth.v1.LoginWithFirebaseRequest
+	(*AppleNotificationRequest)(nil),          // 10: auth.v1.AppleNotificationRequest
+	(*AppleNotificationReply)(nil),            // 11: auth.v1.AppleNotificationReply
+	(*LinkProviderRequest)(nil),               // 12: auth.v1.LinkProviderRequest
+	(*LinkedProvider)(nil),                    // 13: auth.v1.LinkedProvider
+	(*UnlinkProviderRequest)(nil),             // 14: auth.v1.UnlinkProviderRequest
+	(*UnlinkProviderReply)(nil),               // 15: auth.v1.UnlinkProviderReply
+	(*ListLinkedProvidersRequest)(nil),        // 16: auth.v1.ListLinkedProvidersRequest
+	(*ListLinkedProvidersReply)(nil),          // 17: auth.v1.ListLinkedProvidersReply
+	(*MergeAccountsRequest)(nil),              // 18: auth.v1.MergeAccountsRequest
+	(*MergeAccountsReply)(nil),                // 19: auth.v1.MergeAccountsReply
+	(*LoginAsGuestRequest)(nil),               // 20: auth.v1.LoginAsGuestRequest
+	(*LinkPhoneRequest)(nil),                  // 21: auth.v1.LinkPhoneRequest
+	(*LinkPhoneReply)(nil),                    // 22: auth.v1.LinkPhoneReply
+	(*UploadAvatarRequest)(nil),               // 23: auth.v1.UploadAvatarRequest
+	(*AvatarImage)(nil),                       // 24: auth.v1.AvatarImage
+	(*UploadAvatarReply)(nil),                 // 25: auth.v1.UploadAvatarReply
+	(*LoginResponse)(nil),                     // 26: auth.v1.LoginResponse
+	(*UserInfo)(nil),                          // 27: auth.v1.UserInfo
+	nil,                                       // 28: auth.v1.UserInfo.MetadataEntry
 }
 var file_auth_v1_auth_proto_depIdxs = []int32{
-	6, // 0: auth.v1.LoginResponse.user_info:type_name -> auth.v1.UserInfo
-	0, // 1: auth.v1.AuthService.LoginWithPhone:input_type -> auth.v1.LoginWithPhoneRequest
-	1, // 2: auth.v1.AuthService.LoginWithFacebook:input_type -> auth.v1.LoginWithFacebookRequest
-	2, // 3: auth.v1.AuthService.LoginWithApple:input_type -> auth.v1.LoginWithAppleRequest
-	3, // 4: auth.v1.AuthService.LoginWithGoogle:input_type -> auth.v1.LoginWithGoogleRequest
-	4, // 5: auth.v1.AuthService.LoginWithSnapchat:input_type -> auth.v1.LoginWithSnapchatRequest
-	5, // 6: auth.v1.AuthService.LoginWithPhone:output_type -> auth.v1.LoginResponse
-	5, // 7: auth.v1.AuthService.LoginWithFacebook:output_type -> auth.v1.LoginResponse
-	5, // 8: auth.v1.AuthService.LoginWithApple:output_type -> auth.v1.LoginResponse
-	5, // 9: auth.v1.AuthService.LoginWithGoogle:output_type -> auth.v1.LoginResponse
-	5, // 10: auth.v1.AuthService.LoginWithSnapchat:output_type -> auth.v1.LoginResponse
-	6, // [6:11] is the sub-list for method output_type
-	1, // [1:6] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	13, // 0: auth.v1.ListLinkedProvidersReply.providers:type_name -> auth.v1.LinkedProvider
+	27, // 1: auth.v1.MergeAccountsReply.user_info:type_name -> auth.v1.UserInfo
+	27, // 2: auth.v1.LinkPhoneReply.user_info:type_name -> auth.v1.UserInfo
+	24, // 3: auth.v1.UploadAvatarReply.images:type_name -> auth.v1.AvatarImage
+	27, // 4: auth.v1.LoginResponse.user_info:type_name -> auth.v1.UserInfo
+	28, // 5: auth.v1.UserInfo.metadata:type_name -> auth.v1.UserInfo.MetadataEntry
+	0,  // 6: auth.v1.AuthService.LoginWithPhone:input_type -> auth.v1.LoginWithPhoneRequest
+	1,  // 7: auth.v1.AuthService.LoginWithFacebook:input_type -> auth.v1.LoginWithFacebookRequest
+	2,  // 8: auth.v1.AuthService.LoginWithApple:input_type -> auth.v1.LoginWithAppleRequest
+	3,  // 9: auth.v1.AuthService.LoginWithGoogle:input_type -> auth.v1.LoginWithGoogleRequest
+	4,  // 10: auth.v1.AuthService.LoginWithSnapchat:input_type -> auth.v1.LoginWithSnapchatRequest
+	5,  // 11: auth.v1.AuthService.LoginWithWechat:input_type -> auth.v1.LoginWithWechatRequest
+	6,  // 12: auth.v1.AuthService.LoginWithWechatMiniProgram:input_type -> auth.v1.LoginWithWechatMiniProgramRequest
+	7,  // 13: auth.v1.AuthService.LoginWithOIDC:input_type -> auth.v1.LoginWithOIDCRequest
+	8,  // 14: auth.v1.AuthService.LoginWithOAuth:input_type -> auth.v1.LoginWithOAuthRequest
+	9,  // 15: auth.v1.AuthService.LoginWithFirebase:input_type -> auth.v1.LoginWithFirebaseRequest
+	10, // 16: auth.v1.AuthService.AppleNotification:input_type -> auth.v1.AppleNotificationRequest
+	12, // 17: auth.v1.AuthService.LinkProvider:input_type -> auth.v1.LinkProviderRequest
+	14, // 18: auth.v1.AuthService.UnlinkProvider:input_type -> auth.v1.UnlinkProviderRequest
+	16, // 19: auth.v1.AuthService.ListLinkedProviders:input_type -> auth.v1.ListLinkedProvidersRequest
+	18, // 20: auth.v1.AuthService.MergeAccounts:input_type -> auth.v1.MergeAccountsRequest
+	20, // 21: auth.v1.AuthService.LoginAsGuest:input_type -> auth.v1.LoginAsGuestRequest
+	21, // 22: auth.v1.AuthService.LinkPhone:input_type -> auth.v1.LinkPhoneRequest
+	23, // 23: auth.v1.AuthService.UploadAvatar:input_type -> auth.v1.UploadAvatarRequest
+	26, // 24: auth.v1.AuthService.LoginWithPhone:output_type -> auth.v1.LoginResponse
+	26, // 25: auth.v1.AuthService.LoginWithFacebook:output_type -> auth.v1.LoginResponse
+	26, // 26: auth.v1.AuthService.LoginWithApple:output_type -> auth.v1.LoginResponse
+	26, // 27: auth.v1.AuthService.LoginWithGoogle:output_type -> auth.v1.LoginResponse
+	26, // 28: auth.v1.AuthService.LoginWithSnapchat:output_type -> auth.v1.LoginResponse
+	26, // 29: auth.v1.AuthService.LoginWithWechat:output_type -> auth.v1.LoginResponse
+	26, // 30: auth.v1.AuthService.LoginWithWechatMiniProgram:output_type -> auth.v1.LoginResponse
+	26, // 31: auth.v1.AuthService.LoginWithOIDC:output_type -> auth.v1.LoginResponse
+	26, // 32: auth.v1.AuthService.LoginWithOAuth:output_type -> auth.v1.LoginResponse
+	26, // 33: auth.v1.AuthService.LoginWithFirebase:output_type -> auth.v1.LoginResponse
+	11, // 34: auth.v1.AuthService.AppleNotification:output_type -> auth.v1.AppleNotificationReply
+	13, // 35: auth.v1.AuthService.LinkProvider:output_type -> auth.v1.LinkedProvider
+	15, // 36: auth.v1.AuthService.UnlinkProvider:output_type -> auth.v1.UnlinkProviderReply
+	17, // 37: auth.v1.AuthService.ListLinkedProviders:output_type -> auth.v1.ListLinkedProvidersReply
+	19, // 38: auth.v1.AuthService.MergeAccounts:output_type -> auth.v1.MergeAccountsReply
+	26, // 39: auth.v1.AuthService.LoginAsGuest:output_type -> auth.v1.LoginResponse
+	22, // 40: auth.v1.AuthService.LinkPhone:output_type -> auth.v1.LinkPhoneReply
+	25, // 41: auth.v1.AuthService.UploadAvatar:output_type -> auth.v1.UploadAvatarReply
+	24, // [24:42] is the sub-list for method output_type
+	6,  // [6:24] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_auth_v1_auth_proto_init() }
@@ -480,7 +1762,7 @@ func file_auth_v1_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_v1_auth_proto_rawDesc), len(file_auth_v1_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
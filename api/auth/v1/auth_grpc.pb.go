@@ -19,11 +19,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AuthService_LoginWithPhone_FullMethodName    = "/auth.v1.AuthService/LoginWithPhone"
-	AuthService_LoginWithFacebook_FullMethodName = "/auth.v1.AuthService/LoginWithFacebook"
-	AuthService_LoginWithApple_FullMethodName    = "/auth.v1.AuthService/LoginWithApple"
-	AuthService_LoginWithGoogle_FullMethodName   = "/auth.v1.AuthService/LoginWithGoogle"
-	AuthService_LoginWithSnapchat_FullMethodName = "/auth.v1.AuthService/LoginWithSnapchat"
+	AuthService_LoginWithPhone_FullMethodName             = "/auth.v1.AuthService/LoginWithPhone"
+	AuthService_LoginWithFacebook_FullMethodName          = "/auth.v1.AuthService/LoginWithFacebook"
+	AuthService_LoginWithApple_FullMethodName             = "/auth.v1.AuthService/LoginWithApple"
+	AuthService_LoginWithGoogle_FullMethodName            = "/auth.v1.AuthService/LoginWithGoogle"
+	AuthService_LoginWithSnapchat_FullMethodName          = "/auth.v1.AuthService/LoginWithSnapchat"
+	AuthService_LoginWithWechat_FullMethodName            = "/auth.v1.AuthService/LoginWithWechat"
+	AuthService_LoginWithWechatMiniProgram_FullMethodName = "/auth.v1.AuthService/LoginWithWechatMiniProgram"
+	AuthService_LoginWithOIDC_FullMethodName              = "/auth.v1.AuthService/LoginWithOIDC"
+	AuthService_LoginWithOAuth_FullMethodName             = "/auth.v1.AuthService/LoginWithOAuth"
+	AuthService_LoginWithFirebase_FullMethodName          = "/auth.v1.AuthService/LoginWithFirebase"
+	AuthService_AppleNotification_FullMethodName          = "/auth.v1.AuthService/AppleNotification"
+	AuthService_LinkProvider_FullMethodName               = "/auth.v1.AuthService/LinkProvider"
+	AuthService_UnlinkProvider_FullMethodName             = "/auth.v1.AuthService/UnlinkProvider"
+	AuthService_ListLinkedProviders_FullMethodName        = "/auth.v1.AuthService/ListLinkedProviders"
+	AuthService_MergeAccounts_FullMethodName              = "/auth.v1.AuthService/MergeAccounts"
+	AuthService_LoginAsGuest_FullMethodName               = "/auth.v1.AuthService/LoginAsGuest"
+	AuthService_LinkPhone_FullMethodName                  = "/auth.v1.AuthService/LinkPhone"
+	AuthService_UploadAvatar_FullMethodName               = "/auth.v1.AuthService/UploadAvatar"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -42,6 +55,32 @@ type AuthServiceClient interface {
 	LoginWithGoogle(ctx context.Context, in *LoginWithGoogleRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	// Snapchat登录
 	LoginWithSnapchat(ctx context.Context, in *LoginWithSnapchatRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// 微信移动应用登录
+	LoginWithWechat(ctx context.Context, in *LoginWithWechatRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// 微信小程序登录
+	LoginWithWechatMiniProgram(ctx context.Context, in *LoginWithWechatMiniProgramRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// 通用OIDC登录, provider 为配置中的 issuer 名称或内置的 microsoft
+	LoginWithOIDC(ctx context.Context, in *LoginWithOIDCRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// OAuth 2.0 授权码(PKCE)登录, provider 为 x 或 tiktok
+	LoginWithOAuth(ctx context.Context, in *LoginWithOAuthRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// Firebase ID token 登录, 按 uid、手机号、邮箱匹配已有用户
+	LoginWithFirebase(ctx context.Context, in *LoginWithFirebaseRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// Apple 服务端通知, 在 Apple 开发者后台配置该地址
+	AppleNotification(ctx context.Context, in *AppleNotificationRequest, opts ...grpc.CallOption) (*AppleNotificationReply, error)
+	// 已登录用户关联新的登录方式
+	LinkProvider(ctx context.Context, in *LinkProviderRequest, opts ...grpc.CallOption) (*LinkedProvider, error)
+	// 解除关联的登录方式, 不能解除最后一个
+	UnlinkProvider(ctx context.Context, in *UnlinkProviderRequest, opts ...grpc.CallOption) (*UnlinkProviderReply, error)
+	// 列出已关联的登录方式
+	ListLinkedProviders(ctx context.Context, in *ListLinkedProvidersRequest, opts ...grpc.CallOption) (*ListLinkedProvidersReply, error)
+	// 把另一个账号合并到当前账号, 当前账号保留
+	MergeAccounts(ctx context.Context, in *MergeAccountsRequest, opts ...grpc.CallOption) (*MergeAccountsReply, error)
+	// 游客登录, 同一设备密钥对应同一个游客账号
+	LoginAsGuest(ctx context.Context, in *LoginAsGuestRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// 已登录用户绑定手机号, 游客绑定后升级为正式账号
+	LinkPhone(ctx context.Context, in *LinkPhoneRequest, opts ...grpc.CallOption) (*LinkPhoneReply, error)
+	// 上传头像, HTTP 同时支持 multipart/form-data 的 file 字段
+	UploadAvatar(ctx context.Context, in *UploadAvatarRequest, opts ...grpc.CallOption) (*UploadAvatarReply, error)
 }
 
 type authServiceClient struct {
@@ -102,6 +141,136 @@ func (c *authServiceClient) LoginWithSnapchat(ctx context.Context, in *LoginWith
 	return out, nil
 }
 
+func (c *authServiceClient) LoginWithWechat(ctx context.Context, in *LoginWithWechatRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_LoginWithWechat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) LoginWithWechatMiniProgram(ctx context.Context, in *LoginWithWechatMiniProgramRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_LoginWithWechatMiniProgram_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) LoginWithOIDC(ctx context.Context, in *LoginWithOIDCRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_LoginWithOIDC_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) LoginWithOAuth(ctx context.Context, in *LoginWithOAuthRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_LoginWithOAuth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) LoginWithFirebase(ctx context.Context, in *LoginWithFirebaseRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_LoginWithFirebase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) AppleNotification(ctx context.Context, in *AppleNotificationRequest, opts ...grpc.CallOption) (*AppleNotificationReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AppleNotificationReply)
+	err := c.cc.Invoke(ctx, AuthService_AppleNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) LinkProvider(ctx context.Context, in *LinkProviderRequest, opts ...grpc.CallOption) (*LinkedProvider, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkedProvider)
+	err := c.cc.Invoke(ctx, AuthService_LinkProvider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) UnlinkProvider(ctx context.Context, in *UnlinkProviderRequest, opts ...grpc.CallOption) (*UnlinkProviderReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkProviderReply)
+	err := c.cc.Invoke(ctx, AuthService_UnlinkProvider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListLinkedProviders(ctx context.Context, in *ListLinkedProvidersRequest, opts ...grpc.CallOption) (*ListLinkedProvidersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLinkedProvidersReply)
+	err := c.cc.Invoke(ctx, AuthService_ListLinkedProviders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) MergeAccounts(ctx context.Context, in *MergeAccountsRequest, opts ...grpc.CallOption) (*MergeAccountsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeAccountsReply)
+	err := c.cc.Invoke(ctx, AuthService_MergeAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) LoginAsGuest(ctx context.Context, in *LoginAsGuestRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_LoginAsGuest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) LinkPhone(ctx context.Context, in *LinkPhoneRequest, opts ...grpc.CallOption) (*LinkPhoneReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkPhoneReply)
+	err := c.cc.Invoke(ctx, AuthService_LinkPhone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) UploadAvatar(ctx context.Context, in *UploadAvatarRequest, opts ...grpc.CallOption) (*UploadAvatarReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadAvatarReply)
+	err := c.cc.Invoke(ctx, AuthService_UploadAvatar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility.
@@ -118,6 +287,32 @@ type AuthServiceServer interface {
 	LoginWithGoogle(context.Context, *LoginWithGoogleRequest) (*LoginResponse, error)
 	// Snapchat登录
 	LoginWithSnapchat(context.Context, *LoginWithSnapchatRequest) (*LoginResponse, error)
+	// 微信移动应用登录
+	LoginWithWechat(context.Context, *LoginWithWechatRequest) (*LoginResponse, error)
+	// 微信小程序登录
+	LoginWithWechatMiniProgram(context.Context, *LoginWithWechatMiniProgramRequest) (*LoginResponse, error)
+	// 通用OIDC登录, provider 为配置中的 issuer 名称或内置的 microsoft
+	LoginWithOIDC(context.Context, *LoginWithOIDCRequest) (*LoginResponse, error)
+	// OAuth 2.0 授权码(PKCE)登录, provider 为 x 或 tiktok
+	LoginWithOAuth(context.Context, *LoginWithOAuthRequest) (*LoginResponse, error)
+	// Firebase ID token 登录, 按 uid、手机号、邮箱匹配已有用户
+	LoginWithFirebase(context.Context, *LoginWithFirebaseRequest) (*LoginResponse, error)
+	// Apple 服务端通知, 在 Apple 开发者后台配置该地址
+	AppleNotification(context.Context, *AppleNotificationRequest) (*AppleNotificationReply, error)
+	// 已登录用户关联新的登录方式
+	LinkProvider(context.Context, *LinkProviderRequest) (*LinkedProvider, error)
+	// 解除关联的登录方式, 不能解除最后一个
+	UnlinkProvider(context.Context, *UnlinkProviderRequest) (*UnlinkProviderReply, error)
+	// 列出已关联的登录方式
+	ListLinkedProviders(context.Context, *ListLinkedProvidersRequest) (*ListLinkedProvidersReply, error)
+	// 把另一个账号合并到当前账号, 当前账号保留
+	MergeAccounts(context.Context, *MergeAccountsRequest) (*MergeAccountsReply, error)
+	// 游客登录, 同一设备密钥对应同一个游客账号
+	LoginAsGuest(context.Context, *LoginAsGuestRequest) (*LoginResponse, error)
+	// 已登录用户绑定手机号, 游客绑定后升级为正式账号
+	LinkPhone(context.Context, *LinkPhoneRequest) (*LinkPhoneReply, error)
+	// 上传头像, HTTP 同时支持 multipart/form-data 的 file 字段
+	UploadAvatar(context.Context, *UploadAvatarRequest) (*UploadAvatarReply, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -143,6 +338,45 @@ func (UnimplementedAuthServiceServer) LoginWithGoogle(context.Context, *LoginWit
 func (UnimplementedAuthServiceServer) LoginWithSnapchat(context.Context, *LoginWithSnapchatRequest) (*LoginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LoginWithSnapchat not implemented")
 }
+func (UnimplementedAuthServiceServer) LoginWithWechat(context.Context, *LoginWithWechatRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginWithWechat not implemented")
+}
+func (UnimplementedAuthServiceServer) LoginWithWechatMiniProgram(context.Context, *LoginWithWechatMiniProgramRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginWithWechatMiniProgram not implemented")
+}
+func (UnimplementedAuthServiceServer) LoginWithOIDC(context.Context, *LoginWithOIDCRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginWithOIDC not implemented")
+}
+func (UnimplementedAuthServiceServer) LoginWithOAuth(context.Context, *LoginWithOAuthRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginWithOAuth not implemented")
+}
+func (UnimplementedAuthServiceServer) LoginWithFirebase(context.Context, *LoginWithFirebaseRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginWithFirebase not implemented")
+}
+func (UnimplementedAuthServiceServer) AppleNotification(context.Context, *AppleNotificationRequest) (*AppleNotificationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppleNotification not implemented")
+}
+func (UnimplementedAuthServiceServer) LinkProvider(context.Context, *LinkProviderRequest) (*LinkedProvider, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkProvider not implemented")
+}
+func (UnimplementedAuthServiceServer) UnlinkProvider(context.Context, *UnlinkProviderRequest) (*UnlinkProviderReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkProvider not implemented")
+}
+func (UnimplementedAuthServiceServer) ListLinkedProviders(context.Context, *ListLinkedProvidersRequest) (*ListLinkedProvidersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLinkedProviders not implemented")
+}
+func (UnimplementedAuthServiceServer) MergeAccounts(context.Context, *MergeAccountsRequest) (*MergeAccountsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeAccounts not implemented")
+}
+func (UnimplementedAuthServiceServer) LoginAsGuest(context.Context, *LoginAsGuestRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginAsGuest not implemented")
+}
+func (UnimplementedAuthServiceServer) LinkPhone(context.Context, *LinkPhoneRequest) (*LinkPhoneReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkPhone not implemented")
+}
+func (UnimplementedAuthServiceServer) UploadAvatar(context.Context, *UploadAvatarRequest) (*UploadAvatarReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadAvatar not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
 
@@ -254,6 +488,240 @@ func _AuthService_LoginWithSnapchat_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_LoginWithWechat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginWithWechatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).LoginWithWechat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_LoginWithWechat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).LoginWithWechat(ctx, req.(*LoginWithWechatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_LoginWithWechatMiniProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginWithWechatMiniProgramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).LoginWithWechatMiniProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_LoginWithWechatMiniProgram_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).LoginWithWechatMiniProgram(ctx, req.(*LoginWithWechatMiniProgramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_LoginWithOIDC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginWithOIDCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).LoginWithOIDC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_LoginWithOIDC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).LoginWithOIDC(ctx, req.(*LoginWithOIDCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_LoginWithOAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginWithOAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).LoginWithOAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_LoginWithOAuth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).LoginWithOAuth(ctx, req.(*LoginWithOAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_LoginWithFirebase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginWithFirebaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).LoginWithFirebase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_LoginWithFirebase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).LoginWithFirebase(ctx, req.(*LoginWithFirebaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_AppleNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppleNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).AppleNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_AppleNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).AppleNotification(ctx, req.(*AppleNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_LinkProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).LinkProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_LinkProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).LinkProvider(ctx, req.(*LinkProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UnlinkProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UnlinkProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_UnlinkProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UnlinkProvider(ctx, req.(*UnlinkProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListLinkedProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLinkedProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListLinkedProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListLinkedProviders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListLinkedProviders(ctx, req.(*ListLinkedProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_MergeAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).MergeAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_MergeAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).MergeAccounts(ctx, req.(*MergeAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_LoginAsGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginAsGuestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).LoginAsGuest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_LoginAsGuest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).LoginAsGuest(ctx, req.(*LoginAsGuestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_LinkPhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkPhoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).LinkPhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_LinkPhone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).LinkPhone(ctx, req.(*LinkPhoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UploadAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadAvatarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UploadAvatar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_UploadAvatar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UploadAvatar(ctx, req.(*UploadAvatarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -281,6 +749,58 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LoginWithSnapchat",
 			Handler:    _AuthService_LoginWithSnapchat_Handler,
 		},
+		{
+			MethodName: "LoginWithWechat",
+			Handler:    _AuthService_LoginWithWechat_Handler,
+		},
+		{
+			MethodName: "LoginWithWechatMiniProgram",
+			Handler:    _AuthService_LoginWithWechatMiniProgram_Handler,
+		},
+		{
+			MethodName: "LoginWithOIDC",
+			Handler:    _AuthService_LoginWithOIDC_Handler,
+		},
+		{
+			MethodName: "LoginWithOAuth",
+			Handler:    _AuthService_LoginWithOAuth_Handler,
+		},
+		{
+			MethodName: "LoginWithFirebase",
+			Handler:    _AuthService_LoginWithFirebase_Handler,
+		},
+		{
+			MethodName: "AppleNotification",
+			Handler:    _AuthService_AppleNotification_Handler,
+		},
+		{
+			MethodName: "LinkProvider",
+			Handler:    _AuthService_LinkProvider_Handler,
+		},
+		{
+			MethodName: "UnlinkProvider",
+			Handler:    _AuthService_UnlinkProvider_Handler,
+		},
+		{
+			MethodName: "ListLinkedProviders",
+			Handler:    _AuthService_ListLinkedProviders_Handler,
+		},
+		{
+			MethodName: "MergeAccounts",
+			Handler:    _AuthService_MergeAccounts_Handler,
+		},
+		{
+			MethodName: "LoginAsGuest",
+			Handler:    _AuthService_LoginAsGuest_Handler,
+		},
+		{
+			MethodName: "LinkPhone",
+			Handler:    _AuthService_LinkPhone_Handler,
+		},
+		{
+			MethodName: "UploadAvatar",
+			Handler:    _AuthService_UploadAvatar_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth/v1/auth.proto",
@@ -19,23 +19,62 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationAuthServiceAppleNotification = "/auth.v1.AuthService/AppleNotification"
+const OperationAuthServiceLinkPhone = "/auth.v1.AuthService/LinkPhone"
+const OperationAuthServiceLinkProvider = "/auth.v1.AuthService/LinkProvider"
+const OperationAuthServiceListLinkedProviders = "/auth.v1.AuthService/ListLinkedProviders"
+const OperationAuthServiceLoginAsGuest = "/auth.v1.AuthService/LoginAsGuest"
 const OperationAuthServiceLoginWithApple = "/auth.v1.AuthService/LoginWithApple"
 const OperationAuthServiceLoginWithFacebook = "/auth.v1.AuthService/LoginWithFacebook"
+const OperationAuthServiceLoginWithFirebase = "/auth.v1.AuthService/LoginWithFirebase"
 const OperationAuthServiceLoginWithGoogle = "/auth.v1.AuthService/LoginWithGoogle"
+const OperationAuthServiceLoginWithOAuth = "/auth.v1.AuthService/LoginWithOAuth"
+const OperationAuthServiceLoginWithOIDC = "/auth.v1.AuthService/LoginWithOIDC"
 const OperationAuthServiceLoginWithPhone = "/auth.v1.AuthService/LoginWithPhone"
 const OperationAuthServiceLoginWithSnapchat = "/auth.v1.AuthService/LoginWithSnapchat"
+const OperationAuthServiceLoginWithWechat = "/auth.v1.AuthService/LoginWithWechat"
+const OperationAuthServiceLoginWithWechatMiniProgram = "/auth.v1.AuthService/LoginWithWechatMiniProgram"
+const OperationAuthServiceMergeAccounts = "/auth.v1.AuthService/MergeAccounts"
+const OperationAuthServiceUnlinkProvider = "/auth.v1.AuthService/UnlinkProvider"
+const OperationAuthServiceUploadAvatar = "/auth.v1.AuthService/UploadAvatar"
 
 type AuthServiceHTTPServer interface {
+	// AppleNotification Apple 服务端通知, 在 Apple 开发者后台配置该地址
+	AppleNotification(context.Context, *AppleNotificationRequest) (*AppleNotificationReply, error)
+	// LinkPhone 已登录用户绑定手机号, 游客绑定后升级为正式账号
+	LinkPhone(context.Context, *LinkPhoneRequest) (*LinkPhoneReply, error)
+	// LinkProvider 已登录用户关联新的登录方式
+	LinkProvider(context.Context, *LinkProviderRequest) (*LinkedProvider, error)
+	// ListLinkedProviders 列出已关联的登录方式
+	ListLinkedProviders(context.Context, *ListLinkedProvidersRequest) (*ListLinkedProvidersReply, error)
+	// LoginAsGuest 游客登录, 同一设备密钥对应同一个游客账号
+	LoginAsGuest(context.Context, *LoginAsGuestRequest) (*LoginResponse, error)
 	// LoginWithApple Apple登录
 	LoginWithApple(context.Context, *LoginWithAppleRequest) (*LoginResponse, error)
 	// LoginWithFacebook Facebook登录
 	LoginWithFacebook(context.Context, *LoginWithFacebookRequest) (*LoginResponse, error)
+	// LoginWithFirebase Firebase ID token 登录, 按 uid、手机号、邮箱匹配已有用户
+	LoginWithFirebase(context.Context, *LoginWithFirebaseRequest) (*LoginResponse, error)
 	// LoginWithGoogle Google登录
 	LoginWithGoogle(context.Context, *LoginWithGoogleRequest) (*LoginResponse, error)
+	// LoginWithOAuth OAuth 2.0 授权码(PKCE)登录, provider 为 x 或 tiktok
+	LoginWithOAuth(context.Context, *LoginWithOAuthRequest) (*LoginResponse, error)
+	// LoginWithOIDC 通用OIDC登录, provider 为配置中的 issuer 名称或内置的 microsoft
+	LoginWithOIDC(context.Context, *LoginWithOIDCRequest) (*LoginResponse, error)
 	// LoginWithPhone 手机号登录
 	LoginWithPhone(context.Context, *LoginWithPhoneRequest) (*LoginResponse, error)
 	// LoginWithSnapchat Snapchat登录
 	LoginWithSnapchat(context.Context, *LoginWithSnapchatRequest) (*LoginResponse, error)
+	// LoginWithWechat 微信移动应用登录
+	LoginWithWechat(context.Context, *LoginWithWechatRequest) (*LoginResponse, error)
+	// LoginWithWechatMiniProgram 微信小程序登录
+	LoginWithWechatMiniProgram(context.Context, *LoginWithWechatMiniProgramRequest) (*LoginResponse, error)
+	// MergeAccounts 把另一个账号合并到当前账号, 当前账号保留
+	MergeAccounts(context.Context, *MergeAccountsRequest) (*MergeAccountsReply, error)
+	// UnlinkProvider 解除关联的登录方式, 不能解除最后一个
+	UnlinkProvider(context.Context, *UnlinkProviderRequest) (*UnlinkProviderReply, error)
+	// UploadAvatar 上传头像, HTTP 同时支持 multipart/form-data 的 file 字段
+	UploadAvatar(context.Context, *UploadAvatarRequest) (*UploadAvatarReply, error)
 }
 
 func RegisterAuthServiceHTTPServer(s *http.Server, srv AuthServiceHTTPServer) {
@@ -45,6 +84,19 @@ func RegisterAuthServiceHTTPServer(s *http.Server, srv AuthServiceHTTPServer) {
 	r.POST("/user/v1/login_with_apple", _AuthService_LoginWithApple0_HTTP_Handler(srv))
 	r.POST("/user/v1/login_with_google", _AuthService_LoginWithGoogle0_HTTP_Handler(srv))
 	r.POST("/user/v1/login_with_snapchat", _AuthService_LoginWithSnapchat0_HTTP_Handler(srv))
+	r.POST("/user/v1/login_with_wechat", _AuthService_LoginWithWechat0_HTTP_Handler(srv))
+	r.POST("/user/v1/login_with_wechat_mini_program", _AuthService_LoginWithWechatMiniProgram0_HTTP_Handler(srv))
+	r.POST("/user/v1/login_with_oidc", _AuthService_LoginWithOIDC0_HTTP_Handler(srv))
+	r.POST("/user/v1/login_with_oauth", _AuthService_LoginWithOAuth0_HTTP_Handler(srv))
+	r.POST("/user/v1/login_with_firebase", _AuthService_LoginWithFirebase0_HTTP_Handler(srv))
+	r.POST("/user/v1/apple/notifications", _AuthService_AppleNotification0_HTTP_Handler(srv))
+	r.POST("/user/v1/providers/link", _AuthService_LinkProvider0_HTTP_Handler(srv))
+	r.POST("/user/v1/providers/unlink", _AuthService_UnlinkProvider0_HTTP_Handler(srv))
+	r.GET("/user/v1/providers", _AuthService_ListLinkedProviders0_HTTP_Handler(srv))
+	r.POST("/user/v1/accounts/merge", _AuthService_MergeAccounts0_HTTP_Handler(srv))
+	r.POST("/user/v1/login_as_guest", _AuthService_LoginAsGuest0_HTTP_Handler(srv))
+	r.POST("/user/v1/phone/link", _AuthService_LinkPhone0_HTTP_Handler(srv))
+	r.POST("/user/v1/avatar", _AuthService_UploadAvatar0_HTTP_Handler(srv))
 }
 
 func _AuthService_LoginWithPhone0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
@@ -157,12 +209,308 @@ func _AuthService_LoginWithSnapchat0_HTTP_Handler(srv AuthServiceHTTPServer) fun
 	}
 }
 
+func _AuthService_LoginWithWechat0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LoginWithWechatRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceLoginWithWechat)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LoginWithWechat(ctx, req.(*LoginWithWechatRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_LoginWithWechatMiniProgram0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LoginWithWechatMiniProgramRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceLoginWithWechatMiniProgram)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LoginWithWechatMiniProgram(ctx, req.(*LoginWithWechatMiniProgramRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_LoginWithOIDC0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LoginWithOIDCRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceLoginWithOIDC)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LoginWithOIDC(ctx, req.(*LoginWithOIDCRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_LoginWithOAuth0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LoginWithOAuthRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceLoginWithOAuth)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LoginWithOAuth(ctx, req.(*LoginWithOAuthRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_LoginWithFirebase0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LoginWithFirebaseRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceLoginWithFirebase)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LoginWithFirebase(ctx, req.(*LoginWithFirebaseRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_AppleNotification0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AppleNotificationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceAppleNotification)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AppleNotification(ctx, req.(*AppleNotificationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AppleNotificationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_LinkProvider0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LinkProviderRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceLinkProvider)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LinkProvider(ctx, req.(*LinkProviderRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LinkedProvider)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_UnlinkProvider0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnlinkProviderRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceUnlinkProvider)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnlinkProvider(ctx, req.(*UnlinkProviderRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnlinkProviderReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ListLinkedProviders0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListLinkedProvidersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceListLinkedProviders)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListLinkedProviders(ctx, req.(*ListLinkedProvidersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListLinkedProvidersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_MergeAccounts0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MergeAccountsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceMergeAccounts)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MergeAccounts(ctx, req.(*MergeAccountsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MergeAccountsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_LoginAsGuest0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LoginAsGuestRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceLoginAsGuest)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LoginAsGuest(ctx, req.(*LoginAsGuestRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_LinkPhone0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LinkPhoneRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceLinkPhone)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LinkPhone(ctx, req.(*LinkPhoneRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LinkPhoneReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_UploadAvatar0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UploadAvatarRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceUploadAvatar)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UploadAvatar(ctx, req.(*UploadAvatarRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UploadAvatarReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type AuthServiceHTTPClient interface {
+	AppleNotification(ctx context.Context, req *AppleNotificationRequest, opts ...http.CallOption) (rsp *AppleNotificationReply, err error)
+	LinkPhone(ctx context.Context, req *LinkPhoneRequest, opts ...http.CallOption) (rsp *LinkPhoneReply, err error)
+	LinkProvider(ctx context.Context, req *LinkProviderRequest, opts ...http.CallOption) (rsp *LinkedProvider, err error)
+	ListLinkedProviders(ctx context.Context, req *ListLinkedProvidersRequest, opts ...http.CallOption) (rsp *ListLinkedProvidersReply, err error)
+	LoginAsGuest(ctx context.Context, req *LoginAsGuestRequest, opts ...http.CallOption) (rsp *LoginResponse, err error)
 	LoginWithApple(ctx context.Context, req *LoginWithAppleRequest, opts ...http.CallOption) (rsp *LoginResponse, err error)
 	LoginWithFacebook(ctx context.Context, req *LoginWithFacebookRequest, opts ...http.CallOption) (rsp *LoginResponse, err error)
+	LoginWithFirebase(ctx context.Context, req *LoginWithFirebaseRequest, opts ...http.CallOption) (rsp *LoginResponse, err error)
 	LoginWithGoogle(ctx context.Context, req *LoginWithGoogleRequest, opts ...http.CallOption) (rsp *LoginResponse, err error)
+	LoginWithOAuth(ctx context.Context, req *LoginWithOAuthRequest, opts ...http.CallOption) (rsp *LoginResponse, err error)
+	LoginWithOIDC(ctx context.Context, req *LoginWithOIDCRequest, opts ...http.CallOption) (rsp *LoginResponse, err error)
 	LoginWithPhone(ctx context.Context, req *LoginWithPhoneRequest, opts ...http.CallOption) (rsp *LoginResponse, err error)
 	LoginWithSnapchat(ctx context.Context, req *LoginWithSnapchatRequest, opts ...http.CallOption) (rsp *LoginResponse, err error)
+	LoginWithWechat(ctx context.Context, req *LoginWithWechatRequest, opts ...http.CallOption) (rsp *LoginResponse, err error)
+	LoginWithWechatMiniProgram(ctx context.Context, req *LoginWithWechatMiniProgramRequest, opts ...http.CallOption) (rsp *LoginResponse, err error)
+	MergeAccounts(ctx context.Context, req *MergeAccountsRequest, opts ...http.CallOption) (rsp *MergeAccountsReply, err error)
+	UnlinkProvider(ctx context.Context, req *UnlinkProviderRequest, opts ...http.CallOption) (rsp *UnlinkProviderReply, err error)
+	UploadAvatar(ctx context.Context, req *UploadAvatarRequest, opts ...http.CallOption) (rsp *UploadAvatarReply, err error)
 }
 
 type AuthServiceHTTPClientImpl struct {
@@ -173,6 +521,71 @@ func NewAuthServiceHTTPClient(client *http.Client) AuthServiceHTTPClient {
 	return &AuthServiceHTTPClientImpl{client}
 }
 
+func (c *AuthServiceHTTPClientImpl) AppleNotification(ctx context.Context, in *AppleNotificationRequest, opts ...http.CallOption) (*AppleNotificationReply, error) {
+	var out AppleNotificationReply
+	pattern := "/user/v1/apple/notifications"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceAppleNotification))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) LinkPhone(ctx context.Context, in *LinkPhoneRequest, opts ...http.CallOption) (*LinkPhoneReply, error) {
+	var out LinkPhoneReply
+	pattern := "/user/v1/phone/link"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceLinkPhone))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) LinkProvider(ctx context.Context, in *LinkProviderRequest, opts ...http.CallOption) (*LinkedProvider, error) {
+	var out LinkedProvider
+	pattern := "/user/v1/providers/link"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceLinkProvider))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) ListLinkedProviders(ctx context.Context, in *ListLinkedProvidersRequest, opts ...http.CallOption) (*ListLinkedProvidersReply, error) {
+	var out ListLinkedProvidersReply
+	pattern := "/user/v1/providers"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceListLinkedProviders))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) LoginAsGuest(ctx context.Context, in *LoginAsGuestRequest, opts ...http.CallOption) (*LoginResponse, error) {
+	var out LoginResponse
+	pattern := "/user/v1/login_as_guest"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceLoginAsGuest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *AuthServiceHTTPClientImpl) LoginWithApple(ctx context.Context, in *LoginWithAppleRequest, opts ...http.CallOption) (*LoginResponse, error) {
 	var out LoginResponse
 	pattern := "/user/v1/login_with_apple"
@@ -199,6 +612,19 @@ func (c *AuthServiceHTTPClientImpl) LoginWithFacebook(ctx context.Context, in *L
 	return &out, nil
 }
 
+func (c *AuthServiceHTTPClientImpl) LoginWithFirebase(ctx context.Context, in *LoginWithFirebaseRequest, opts ...http.CallOption) (*LoginResponse, error) {
+	var out LoginResponse
+	pattern := "/user/v1/login_with_firebase"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceLoginWithFirebase))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *AuthServiceHTTPClientImpl) LoginWithGoogle(ctx context.Context, in *LoginWithGoogleRequest, opts ...http.CallOption) (*LoginResponse, error) {
 	var out LoginResponse
 	pattern := "/user/v1/login_with_google"
@@ -212,6 +638,32 @@ func (c *AuthServiceHTTPClientImpl) LoginWithGoogle(ctx context.Context, in *Log
 	return &out, nil
 }
 
+func (c *AuthServiceHTTPClientImpl) LoginWithOAuth(ctx context.Context, in *LoginWithOAuthRequest, opts ...http.CallOption) (*LoginResponse, error) {
+	var out LoginResponse
+	pattern := "/user/v1/login_with_oauth"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceLoginWithOAuth))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) LoginWithOIDC(ctx context.Context, in *LoginWithOIDCRequest, opts ...http.CallOption) (*LoginResponse, error) {
+	var out LoginResponse
+	pattern := "/user/v1/login_with_oidc"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceLoginWithOIDC))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *AuthServiceHTTPClientImpl) LoginWithPhone(ctx context.Context, in *LoginWithPhoneRequest, opts ...http.CallOption) (*LoginResponse, error) {
 	var out LoginResponse
 	pattern := "/user/v1/login_with_phone"
@@ -237,3 +689,68 @@ func (c *AuthServiceHTTPClientImpl) LoginWithSnapchat(ctx context.Context, in *L
 	}
 	return &out, nil
 }
+
+func (c *AuthServiceHTTPClientImpl) LoginWithWechat(ctx context.Context, in *LoginWithWechatRequest, opts ...http.CallOption) (*LoginResponse, error) {
+	var out LoginResponse
+	pattern := "/user/v1/login_with_wechat"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceLoginWithWechat))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) LoginWithWechatMiniProgram(ctx context.Context, in *LoginWithWechatMiniProgramRequest, opts ...http.CallOption) (*LoginResponse, error) {
+	var out LoginResponse
+	pattern := "/user/v1/login_with_wechat_mini_program"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceLoginWithWechatMiniProgram))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) MergeAccounts(ctx context.Context, in *MergeAccountsRequest, opts ...http.CallOption) (*MergeAccountsReply, error) {
+	var out MergeAccountsReply
+	pattern := "/user/v1/accounts/merge"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceMergeAccounts))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) UnlinkProvider(ctx context.Context, in *UnlinkProviderRequest, opts ...http.CallOption) (*UnlinkProviderReply, error) {
+	var out UnlinkProviderReply
+	pattern := "/user/v1/providers/unlink"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceUnlinkProvider))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthServiceHTTPClientImpl) UploadAvatar(ctx context.Context, in *UploadAvatarRequest, opts ...http.CallOption) (*UploadAvatarReply, error) {
+	var out UploadAvatarReply
+	pattern := "/user/v1/avatar"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceUploadAvatar))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
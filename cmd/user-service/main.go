@@ -2,9 +2,11 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"os"
 
 	"user-service/internal/conf"
+	"user-service/internal/server"
 	"user-service/third_party/snowflake"
 
 	"github.com/go-kratos/kratos/v2"
@@ -34,7 +36,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, js *server.JobServer) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -44,6 +46,7 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 		kratos.Server(
 			gs,
 			hs,
+			js,
 		),
 	)
 }
@@ -75,13 +78,22 @@ func main() {
 		panic(err)
 	}
 
+	// user-service migrate up|down|status 只执行数据库迁移
+	if flag.Arg(0) == "migrate" {
+		if err := runMigrate(bc.Data, bc.User, logger, flag.Args()[1:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	// uid generate, todo 获取consul_id 注册的node id
 	uidGen, err := snowflake.NewNode(bc.NodeId)
 	if err != nil {
 		panic(err)
 	}
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Jwt, uidGen, logger)
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Jwt, bc.Auth, bc.Avatar, bc.User, uidGen, logger)
 	if err != nil {
 		panic(err)
 	}
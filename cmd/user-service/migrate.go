@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"user-service/internal/biz"
+	"user-service/internal/conf"
+	"user-service/internal/data"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const migrateUsage = `usage: user-service -conf <path> migrate <command>
+
+commands:
+  up [-baseline version]  apply pending migrations, an existing schema needs -baseline
+  down                    revert the last applied migration
+  status                  show the current version and pending migrations
+  normalize-phones        rewrite stored phone numbers to E.164 with user.phone.default_calling_code`
+
+// runMigrate 执行 migrate 子命令, 先校验参数再连接数据库
+func runMigrate(c *conf.Data, userCfg *conf.User, logger log.Logger, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing migrate command\n%s", migrateUsage)
+	}
+	var baseline string
+	switch args[0] {
+	case "up":
+		fs := flag.NewFlagSet("up", flag.ContinueOnError)
+		fs.StringVar(&baseline, "baseline", "", "mark the existing schema as this version without executing it")
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+	case "down", "status", "normalize-phones":
+	default:
+		return fmt.Errorf("unknown migrate command %q\n%s", args[0], migrateUsage)
+	}
+
+	m, cleanup, err := data.NewMigrator(c, logger)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		return m.Up(ctx, baseline)
+	case "down":
+		return m.Down(ctx)
+	case "normalize-phones":
+		return normalizePhones(ctx, m, userCfg)
+	}
+
+	status, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+	current := status.Current
+	if current == "" {
+		current = "none"
+	}
+	fmt.Printf("current version: %s\n", current)
+	fmt.Printf("latest version:  %s\n", status.Latest)
+	if status.Error != "" {
+		fmt.Printf("last error:      %s\n", status.Error)
+	}
+	if len(status.Pending) == 0 {
+		fmt.Println("pending:         none")
+	} else {
+		fmt.Printf("pending:         %s\n", strings.Join(status.Pending, ", "))
+	}
+	if status.Drift != "" {
+		fmt.Printf("schema drift:    %s\n", status.Drift)
+	}
+	return nil
+}
+
+// normalizePhones 用登录时相同的规则规范化已保存的手机号, 并输出需要人工处理的用户
+func normalizePhones(ctx context.Context, m *data.Migrator, userCfg *conf.User) error {
+	phones, err := biz.NewPhoneNormalizer(userCfg)
+	if err != nil {
+		return err
+	}
+	result, err := m.NormalizePhones(ctx, phones.Normalize)
+	if result != nil {
+		fmt.Printf("updated:   %d\n", result.Updated)
+		if len(result.Invalid) > 0 {
+			fmt.Printf("invalid:   %v\n", result.Invalid)
+		}
+		if len(result.Conflicts) > 0 {
+			fmt.Printf("conflicts: %v\n", result.Conflicts)
+		}
+	}
+	return err
+}
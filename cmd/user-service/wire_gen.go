@@ -24,22 +24,48 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, jwt *conf.Jwt, node *snowflake.Node, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, jwt *conf.Jwt, auth *conf.Auth, avatar *conf.Avatar, user *conf.User, node *snowflake.Node, logger log.Logger) (*kratos.App, func(), error) {
 	dataData, cleanup, err := data.NewData(confData, logger)
 	if err != nil {
 		return nil, nil, err
 	}
+	sessionRepo := data.NewSessionRepo(dataData, logger)
+	userRepo := data.NewUserRepo(dataData, logger, node)
+	deletionRepo := data.NewDeletionRepo(dataData, logger)
+	sessionCase := biz.NewSessionCase(sessionRepo, userRepo, deletionRepo, jwt, logger)
 	greeterRepo := data.NewGreeterRepo(dataData, logger)
 	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
 	greeterService := service.NewGreeterService(greeterUsecase)
-	userRepo := data.NewUserRepo(dataData, logger, node)
 	authProviderRepo := data.NewAuthProviderRepo(dataData, logger)
-	userAuthCase := biz.NewUserAuthCase(userRepo, authProviderRepo, logger)
-	userCase := biz.NewUserCase(userRepo, logger)
-	loginService := service.NewLoginService(jwt, logger, node, userAuthCase, userCase)
-	grpcServer := server.NewGRPCServer(confServer, greeterService, loginService, logger)
-	httpServer := server.NewHTTPServer(confServer, greeterService, loginService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	wechatRepo := data.NewWechatRepo(dataData, logger)
+	avatarRepo := data.NewAvatarRepo(dataData, logger)
+	avatarCase := biz.NewAvatarCase(avatar, avatarRepo, userRepo, logger)
+	phoneNormalizer, err := biz.NewPhoneNormalizer(user)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	userAuthCase := biz.NewUserAuthCase(userRepo, authProviderRepo, wechatRepo, avatarCase, phoneNormalizer, auth, logger)
+	userCase := biz.NewUserCase(userRepo, phoneNormalizer, logger)
+	appleNotificationRepo := data.NewAppleNotificationRepo(dataData, logger)
+	appleNotificationCase := biz.NewAppleNotificationCase(userRepo, authProviderRepo, appleNotificationRepo, sessionRepo, logger)
+	exportRepo := data.NewExportRepo(dataData, logger)
+	exportCase := biz.NewExportCase(user, exportRepo, userRepo, authProviderRepo, sessionRepo, logger)
+	deletionCase := biz.NewDeletionCase(user, deletionRepo, sessionRepo, avatarCase, exportCase, logger)
+	loginService, err := service.NewLoginService(jwt, auth, confData, logger, userAuthCase, userCase, appleNotificationCase, sessionCase, avatarCase, deletionCase)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	handleRepo := data.NewHandleRepo(dataData, logger)
+	handleCase := biz.NewHandleCase(user, handleRepo, userRepo, logger)
+	userService := service.NewUserService(logger, userCase, handleCase, deletionCase, exportCase)
+	adminCase := biz.NewAdminCase(user, userRepo, authProviderRepo, sessionRepo, phoneNormalizer, logger)
+	adminService := service.NewAdminService(logger, adminCase)
+	grpcServer := server.NewGRPCServer(confServer, sessionCase, greeterService, loginService, userService, adminService, logger)
+	httpServer := server.NewHTTPServer(confServer, sessionCase, greeterService, loginService, userService, adminService, logger)
+	jobServer := server.NewJobServer(auth, user, userAuthCase, avatarCase, deletionCase, exportCase, logger)
+	app := newApp(logger, grpcServer, httpServer, jobServer)
 	return app, func() {
 		cleanup()
 	}, nil
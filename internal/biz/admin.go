@@ -0,0 +1,148 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	adminv1 "user-service/api/admin/v1"
+	v1 "user-service/api/auth/v1"
+	"user-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 账号状态
+const (
+	// UserActive 正常
+	UserActive = "active"
+	// UserSuspended 临时停用, 通常带结束时间
+	UserSuspended = "suspended"
+	// UserBanned 封禁
+	UserBanned = "banned"
+	// UserDisabled 停用, 如应用户或监管要求
+	UserDisabled = "disabled"
+)
+
+// userStatuses 允许设置的状态
+var userStatuses = map[string]bool{
+	UserActive:    true,
+	UserSuspended: true,
+	UserBanned:    true,
+	UserDisabled:  true,
+}
+
+// maxStatusReasonLen 原因代码的最大长度
+const maxStatusReasonLen = 255
+
+var (
+	// ErrAccountBlocked 账号已被停用, metadata 中包含状态、原因和结束时间
+	ErrAccountBlocked = errors.Forbidden(v1.ErrorReason_ACCOUNT_BLOCKED.String(), "account is blocked")
+	// ErrPermissionDenied 不是管理员
+	ErrPermissionDenied = errors.Forbidden(adminv1.ErrorReason_PERMISSION_DENIED.String(), "permission denied")
+	// ErrInvalidStatus 状态、原因或结束时间不合法
+	ErrInvalidStatus = errors.BadRequest(adminv1.ErrorReason_INVALID_STATUS.String(), "invalid status")
+)
+
+// Blocked 账号当前是否被停用, 到期的停用视为已恢复
+func (u *User) Blocked(now time.Time) bool {
+	if u.Status == "" || u.Status == UserActive {
+		return false
+	}
+	return u.StatusExpiresAt == nil || now.Before(*u.StatusExpiresAt)
+}
+
+// CheckActive 账号被停用时返回 ErrAccountBlocked
+func (u *User) CheckActive() error {
+	if !u.Blocked(time.Now()) {
+		return nil
+	}
+	until := ""
+	if u.StatusExpiresAt != nil {
+		until = u.StatusExpiresAt.UTC().Format(time.RFC3339)
+	}
+	return ErrAccountBlocked.WithMetadata(map[string]string{
+		"status": u.Status,
+		"reason": u.StatusReason,
+		"until":  until,
+	})
+}
+
+// StatusUpdate 账号状态修改
+type StatusUpdate struct {
+	Status    string
+	Reason    string
+	ChangedBy int64
+	// ExpiresAt 停用结束的时间, nil 表示永久
+	ExpiresAt *time.Time
+}
+
+// AdminCase 管理员操作
+type AdminCase struct {
+	userRepo    UserRepo
+	authRepo    AuthProviderRepo
+	sessionRepo SessionRepo
+	phones      *PhoneNormalizer
+	admins      map[int64]bool
+	log         *log.Helper
+}
+
+// NewAdminCase new an AdminCase.
+func NewAdminCase(cfg *conf.User, userRepo UserRepo, authRepo AuthProviderRepo, sessionRepo SessionRepo, phones *PhoneNormalizer, logger log.Logger) *AdminCase {
+	uc := &AdminCase{
+		userRepo:    userRepo,
+		authRepo:    authRepo,
+		sessionRepo: sessionRepo,
+		phones:      phones,
+		admins:      make(map[int64]bool),
+		log:         log.NewHelper(logger),
+	}
+	for _, id := range cfg.GetAdmins() {
+		uc.admins[id] = true
+	}
+	return uc
+}
+
+// CheckAdmin 不是管理员时返回 ErrPermissionDenied
+func (uc *AdminCase) CheckAdmin(userID int64) error {
+	if !uc.admins[userID] {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// GetStatus 查询用户账号状态
+func (uc *AdminCase) GetStatus(ctx context.Context, adminID, userID int64) (*User, error) {
+	if err := uc.CheckAdmin(adminID); err != nil {
+		return nil, err
+	}
+	return uc.userRepo.FindByID(ctx, userID)
+}
+
+// SetStatus 修改用户账号状态, 停用时撤销用户的全部会话
+func (uc *AdminCase) SetStatus(ctx context.Context, adminID, userID int64, s *StatusUpdate) (*User, error) {
+	if err := uc.CheckAdmin(adminID); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("SetUserStatus: %v %v %v %v by %v", userID, s.Status, s.Reason, s.ExpiresAt, adminID)
+	if !userStatuses[s.Status] || len(s.Reason) > maxStatusReasonLen {
+		return nil, ErrInvalidStatus
+	}
+	if s.Status == UserActive {
+		s.Reason, s.ExpiresAt = "", nil
+	} else if s.ExpiresAt != nil && !s.ExpiresAt.After(time.Now()) {
+		return nil, ErrInvalidStatus.WithMetadata(map[string]string{"field": "expires_at"})
+	}
+	s.ChangedBy = adminID
+
+	u, err := uc.userRepo.UpdateStatus(ctx, userID, s)
+	if err != nil {
+		return nil, err
+	}
+	if s.Status != UserActive {
+		if err = uc.sessionRepo.RevokeByUser(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+	return u, nil
+}
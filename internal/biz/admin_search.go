@@ -0,0 +1,170 @@
+package biz
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	adminv1 "user-service/api/admin/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+const (
+	defaultUserPageSize = 20
+	maxUserPageSize     = 100
+)
+
+// 用户列表的排序字段
+const (
+	UserOrderCreatedAt = "created_at"
+	UserOrderUserID    = "user_id"
+	UserOrderName      = "name"
+)
+
+// ErrInvalidQuery 搜索条件、排序或分页游标不合法, metadata 中包含字段名
+var ErrInvalidQuery = errors.BadRequest(adminv1.ErrorReason_INVALID_QUERY.String(), "invalid query")
+
+// UserFilter 用户搜索条件, 零值表示不过滤
+type UserFilter struct {
+	Phone        string
+	Email        string
+	ProviderType string
+	ProviderID   string
+	// CreatedFrom 包含, CreatedTo 不包含
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	// Status 保存的状态, 不考虑停用是否已到期
+	Status        string
+	NamePrefix    string
+	IncludeMerged bool
+
+	// 以下由 AdminCase 根据排序和游标设置
+	OrderBy string
+	Desc    bool
+	// After 上一页的最后一个用户, 只用到 ID 和排序字段
+	After *User
+	Limit int
+}
+
+// AdminUser 管理后台看到的用户, 包含关联的登录方式
+type AdminUser struct {
+	*User
+	Providers []*LinkedProvider
+}
+
+// userCursor 分页游标, 记录排序方式和上一页最后一个用户的排序字段
+type userCursor struct {
+	OrderBy   string `json:"o"`
+	ID        int64  `json:"i"`
+	UserID    int64  `json:"u,omitempty"`
+	Name      string `json:"n,omitempty"`
+	CreatedAt int64  `json:"c,omitempty"`
+}
+
+// ListUsers 搜索用户, orderBy 为排序字段, 前面加 - 表示倒序; 返回当前页和下一页的游标
+func (uc *AdminCase) ListUsers(ctx context.Context, adminID int64, f *UserFilter, orderBy string, pageSize int, pageToken string) ([]*AdminUser, string, error) {
+	if err := uc.CheckAdmin(adminID); err != nil {
+		return nil, "", err
+	}
+	if f.Status != "" && !userStatuses[f.Status] {
+		return nil, "", ErrInvalidQuery.WithMetadata(map[string]string{"field": "status"})
+	}
+	if f.Phone != "" {
+		phone, err := uc.phones.Normalize(f.Phone)
+		if err != nil {
+			return nil, "", ErrInvalidQuery.WithMetadata(map[string]string{"field": "phone"})
+		}
+		f.Phone = phone
+	}
+	if f.ProviderID != "" && f.ProviderType == "" {
+		return nil, "", ErrInvalidQuery.WithMetadata(map[string]string{"field": "provider"})
+	}
+	if f.CreatedFrom != nil && f.CreatedTo != nil && !f.CreatedFrom.Before(*f.CreatedTo) {
+		return nil, "", ErrInvalidQuery.WithMetadata(map[string]string{"field": "created_to"})
+	}
+
+	if orderBy == "" {
+		orderBy = "-" + UserOrderCreatedAt
+	}
+	f.OrderBy, f.Desc = strings.TrimPrefix(orderBy, "-"), strings.HasPrefix(orderBy, "-")
+	switch f.OrderBy {
+	case UserOrderCreatedAt, UserOrderUserID, UserOrderName:
+	default:
+		return nil, "", ErrInvalidQuery.WithMetadata(map[string]string{"field": "order_by"})
+	}
+	if pageToken != "" {
+		after, err := decodeUserCursor(pageToken, orderBy)
+		if err != nil {
+			return nil, "", err
+		}
+		f.After = after
+	}
+	if pageSize <= 0 {
+		pageSize = defaultUserPageSize
+	}
+	if pageSize > maxUserPageSize {
+		pageSize = maxUserPageSize
+	}
+	// 多查一个判断是否还有下一页
+	f.Limit = pageSize + 1
+
+	users, err := uc.userRepo.Search(ctx, f)
+	if err != nil {
+		return nil, "", err
+	}
+	next := ""
+	if len(users) > pageSize {
+		users = users[:pageSize]
+		next = encodeUserCursor(orderBy, users[pageSize-1])
+	}
+
+	userIDs := make([]int64, 0, len(users))
+	for _, u := range users {
+		userIDs = append(userIDs, u.UserID)
+	}
+	providers, err := uc.authRepo.ListByUsers(ctx, userIDs)
+	if err != nil {
+		return nil, "", err
+	}
+	result := make([]*AdminUser, 0, len(users))
+	for _, u := range users {
+		result = append(result, &AdminUser{User: u, Providers: providers[u.UserID]})
+	}
+	return result, next, nil
+}
+
+func encodeUserCursor(orderBy string, u *User) string {
+	c := userCursor{OrderBy: orderBy, ID: u.ID}
+	switch strings.TrimPrefix(orderBy, "-") {
+	case UserOrderUserID:
+		c.UserID = u.UserID
+	case UserOrderName:
+		c.Name = u.Name
+	default:
+		c.CreatedAt = u.CreatedAt.UnixNano()
+	}
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeUserCursor 解析游标, 排序方式和生成游标时不同视为不合法
+func decodeUserCursor(token, orderBy string) (*User, error) {
+	invalid := ErrInvalidQuery.WithMetadata(map[string]string{"field": "page_token"})
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalid
+	}
+	var c userCursor
+	if err = json.Unmarshal(data, &c); err != nil || c.OrderBy != orderBy || c.ID <= 0 {
+		return nil, invalid
+	}
+	return &User{
+		ID:        c.ID,
+		UserID:    c.UserID,
+		Name:      c.Name,
+		CreatedAt: time.Unix(0, c.CreatedAt),
+	}, nil
+}
@@ -0,0 +1,91 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"user-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func (r *fakeUserRepo) UpdateStatus(ctx context.Context, userID int64, s *StatusUpdate) (*User, error) {
+	u, ok := r.users[userID]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	u.Status, u.StatusReason, u.StatusExpiresAt = s.Status, s.Reason, s.ExpiresAt
+	copied := *u
+	return &copied, nil
+}
+
+type fakeSessionRepo struct {
+	SessionRepo
+	revoked []int64
+}
+
+func (r *fakeSessionRepo) RevokeByUser(ctx context.Context, userID int64) error {
+	r.revoked = append(r.revoked, userID)
+	return nil
+}
+
+func TestCheckActive(t *testing.T) {
+	past, future := time.Now().Add(-time.Minute), time.Now().Add(time.Hour)
+	for _, u := range []*User{
+		{},
+		{Status: UserActive},
+		// 到期的停用视为已恢复
+		{Status: UserSuspended, StatusExpiresAt: &past},
+	} {
+		if err := u.CheckActive(); err != nil {
+			t.Errorf("expected %q until %v to be active, got %v", u.Status, u.StatusExpiresAt, err)
+		}
+	}
+
+	err := (&User{Status: UserSuspended, StatusReason: "spam", StatusExpiresAt: &future}).CheckActive()
+	if !errors.Is(err, ErrAccountBlocked) {
+		t.Fatalf("expected ErrAccountBlocked, got %v", err)
+	}
+	md := errors.FromError(err).Metadata
+	if md["status"] != UserSuspended || md["reason"] != "spam" || md["until"] != future.UTC().Format(time.RFC3339) {
+		t.Fatalf("unexpected metadata %v", md)
+	}
+	if err = (&User{Status: UserBanned}).CheckActive(); !errors.Is(err, ErrAccountBlocked) {
+		t.Fatalf("expected permanent ban to be blocked, got %v", err)
+	}
+}
+
+func TestSetStatus(t *testing.T) {
+	users := &fakeUserRepo{users: map[int64]*User{2: {UserID: 2}}}
+	sessions := &fakeSessionRepo{}
+	uc := NewAdminCase(&conf.User{Admins: []int64{1}}, users, nil, sessions, nil, log.NewStdLogger(io.Discard))
+	ctx := context.Background()
+
+	if _, err := uc.SetStatus(ctx, 2, 2, &StatusUpdate{Status: UserBanned}); !errors.Is(err, ErrPermissionDenied) {
+		t.Fatalf("expected ErrPermissionDenied, got %v", err)
+	}
+	past := time.Now().Add(-time.Minute)
+	if _, err := uc.SetStatus(ctx, 1, 2, &StatusUpdate{Status: UserSuspended, ExpiresAt: &past}); !errors.Is(err, ErrInvalidStatus) {
+		t.Fatalf("expected ErrInvalidStatus for a past expiry, got %v", err)
+	}
+
+	// 停用时撤销全部会话, 已签发的 token 随即失效
+	u, err := uc.SetStatus(ctx, 1, 2, &StatusUpdate{Status: UserBanned, Reason: "fraud"})
+	if err != nil {
+		t.Fatalf("error banning user, %s", err)
+	}
+	if !u.Blocked(time.Now()) || len(sessions.revoked) != 1 || sessions.revoked[0] != 2 {
+		t.Fatalf("expected user blocked and sessions revoked, got %+v %v", u, sessions.revoked)
+	}
+
+	// 恢复时清除原因, 不再撤销会话
+	if u, err = uc.SetStatus(ctx, 1, 2, &StatusUpdate{Status: UserActive, Reason: "appeal"}); err != nil {
+		t.Fatalf("error restoring user, %s", err)
+	}
+	if u.Blocked(time.Now()) || u.StatusReason != "" || len(sessions.revoked) != 1 {
+		t.Fatalf("expected user restored without revoking again, got %+v %v", u, sessions.revoked)
+	}
+}
@@ -0,0 +1,146 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Apple 服务端通知的事件类型
+const (
+	AppleEventEmailDisabled  = "email-disabled"
+	AppleEventEmailEnabled   = "email-enabled"
+	AppleEventConsentRevoked = "consent-revoked"
+	AppleEventAccountDelete  = "account-delete"
+)
+
+// AppleEvent Apple 服务端通知中的事件
+type AppleEvent struct {
+	Type           string
+	Subject        string
+	Email          string
+	IsPrivateEmail bool
+	EventTime      time.Time
+}
+
+// AppleNotificationRepo 定义 Apple 通知审计仓储接口
+type AppleNotificationRepo interface {
+	// Save 记录收到的事件及处理结果
+	Save(ctx context.Context, event *AppleEvent, payload, result string) error
+	// LatestEventTime 返回该 Apple ID 最近一次处理成功的事件时间, 没有时返回零值
+	LatestEventTime(ctx context.Context, subject string) (time.Time, error)
+}
+
+// Apple 通知审计中的处理结果, 处理失败时为错误信息
+const (
+	AppleResultOK         = "ok"
+	AppleResultStale      = "stale event"
+	AppleResultEmailTaken = "email already used by another account"
+)
+
+// AppleNotificationCase 处理 Apple 服务端通知
+type AppleNotificationCase struct {
+	userRepo    UserRepo
+	authRepo    AuthProviderRepo
+	notifyRepo  AppleNotificationRepo
+	sessionRepo SessionRepo
+	log         *log.Helper
+}
+
+// NewAppleNotificationCase 创建 Apple 通知处理实例
+func NewAppleNotificationCase(userRepo UserRepo, authRepo AuthProviderRepo, notifyRepo AppleNotificationRepo, sessionRepo SessionRepo, logger log.Logger) *AppleNotificationCase {
+	return &AppleNotificationCase{
+		userRepo:    userRepo,
+		authRepo:    authRepo,
+		notifyRepo:  notifyRepo,
+		sessionRepo: sessionRepo,
+		log:         log.NewHelper(logger),
+	}
+}
+
+// Handle 处理单个事件, 无论成功与否都会记录审计
+func (uc *AppleNotificationCase) Handle(ctx context.Context, event *AppleEvent, payload string) error {
+	uc.log.WithContext(ctx).Infof("Handle apple event: %v %v", event.Type, event.Subject)
+	result, err := uc.apply(ctx, event)
+	if err != nil {
+		result = truncate(err.Error(), 255)
+	}
+
+	if saveErr := uc.notifyRepo.Save(ctx, event, payload, result); saveErr != nil {
+		uc.log.WithContext(ctx).Errorf("failed to save apple notification: %v", saveErr)
+		if err == nil {
+			err = saveErr
+		}
+	}
+	return err
+}
+
+// apply 处理事件, 返回审计中记录的结果; 返回错误时 Apple 会重试
+func (uc *AppleNotificationCase) apply(ctx context.Context, event *AppleEvent) (string, error) {
+	// Apple 不保证投递顺序, 早于已处理事件的通知不再处理, 避免旧的邮箱状态覆盖新的
+	if !event.EventTime.IsZero() {
+		latest, err := uc.notifyRepo.LatestEventTime(ctx, event.Subject)
+		if err != nil {
+			return "", err
+		}
+		if event.EventTime.Before(latest) {
+			uc.log.WithContext(ctx).Warnf("stale apple event: %v %v %v", event.Type, event.Subject, event.EventTime)
+			return AppleResultStale, nil
+		}
+	}
+
+	found, err := uc.authRepo.FindByAppleID(ctx, event.Subject)
+	if errors.Is(err, ErrUserNotFound) {
+		// 未关联的 Apple ID 只记录, 不视为错误
+		uc.log.WithContext(ctx).Warnf("apple id not linked: %v", event.Subject)
+		return AppleResultOK, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	switch event.Type {
+	case AppleEventConsentRevoked:
+		// 用户在 Apple 侧停止使用 Apple 登录本应用
+		return AppleResultOK, uc.unlink(ctx, found.UserID, event.Subject)
+	case AppleEventAccountDelete:
+		// Apple ID 已注销, 解除关联并标记账号
+		if err = uc.unlink(ctx, found.UserID, event.Subject); err != nil {
+			return "", err
+		}
+		return AppleResultOK, uc.userRepo.SetAppleAccountDeleted(ctx, found.UserID, true)
+	case AppleEventEmailEnabled:
+		if event.Email == "" || event.Email == found.Email {
+			return AppleResultOK, nil
+		}
+		found.Email = event.Email
+		_, err = uc.userRepo.Update(ctx, found)
+		// 邮箱已属于其他账号时无法处理, 重试也不会成功
+		if errors.Is(err, ErrAccountExists) {
+			uc.log.WithContext(ctx).Warnf("apple email %v of user %v is used by another account", event.Email, found.UserID)
+			return AppleResultEmailTaken, nil
+		}
+		return AppleResultOK, err
+	case AppleEventEmailDisabled:
+		// 中转邮箱停止转发, 不再保留该地址
+		if event.Email == "" || event.Email != found.Email {
+			return AppleResultOK, nil
+		}
+		found.Email = ""
+		_, err = uc.userRepo.Update(ctx, found)
+		return AppleResultOK, err
+	}
+
+	uc.log.WithContext(ctx).Warnf("unknown apple event type: %v", event.Type)
+	return AppleResultOK, nil
+}
+
+// unlink 解除 Apple ID 关联并撤销通过它登录的会话, 之后用新的 Apple ID 登录也不会恢复这些会话
+func (uc *AppleNotificationCase) unlink(ctx context.Context, userID int64, subject string) error {
+	if err := uc.authRepo.Delete(ctx, "apple", subject); err != nil {
+		return err
+	}
+	return uc.sessionRepo.RevokeByProvider(ctx, userID, "apple")
+}
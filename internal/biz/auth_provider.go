@@ -2,14 +2,34 @@ package biz
 
 import (
 	"context"
+	"time"
 
 	"user-service/internal/data/ent"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
 
+// LinkedProvider 用户关联的第三方登录方式
+type LinkedProvider struct {
+	ProviderType   string
+	ProviderID     string
+	Email          string
+	EmailVerified  bool
+	Name           string
+	Avatar         string
+	RawClaims      map[string]interface{}
+	AccessToken    string
+	RefreshToken   string
+	TokenExpiresAt *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
 // AuthProviderRepo 定义用户授权接口
 type AuthProviderRepo interface {
+	// FindByProvider 根据登录方式和第三方ID查找用户
+	FindByProvider(ctx context.Context, providerType, providerID string) (*User, error)
+	// FindByAppleID 根据Apple ID查找用户
 	FindByAppleID(ctx context.Context, appleID string) (*User, error)
 	// FindByGoogleID 根据Google ID查找用户
 	FindByGoogleID(ctx context.Context, googleID string) (*User, error)
@@ -19,6 +39,19 @@ type AuthProviderRepo interface {
 	FindBySnapchatID(ctx context.Context, snapchatID string) (*User, error)
 	// Create 创建授权用户
 	Create(ctx context.Context, proType, id string, userInfo *ent.User) error
+	// Delete 删除第三方登录关联
+	Delete(ctx context.Context, providerType, providerID string) error
+	// Unlink 锁定用户后解除某种登录方式, 不能解除最后一个登录方式 (手机号也算一个);
+	// 微信同时删除各应用下的 openid, 否则仍能通过 openid 登录
+	Unlink(ctx context.Context, userID int64, providerType string) error
+	// ListByUser 列出用户关联的第三方登录方式
+	ListByUser(ctx context.Context, userID int64) ([]*LinkedProvider, error)
+	// ListByUsers 批量列出关联的第三方登录方式, 按 user_id 分组
+	ListByUsers(ctx context.Context, userIDs []int64) (map[int64][]*LinkedProvider, error)
+	// UpdateIdentity 保存关联账号的资料、原始声明和令牌
+	UpdateIdentity(ctx context.Context, identity *Identity) error
+	// UpdateRefreshToken 保存第三方 refresh_token
+	UpdateRefreshToken(ctx context.Context, providerType, providerID, refreshToken string) error
 }
 
 // AuthProviderCase 用户登陆授权实例的使用
@@ -35,6 +68,12 @@ func NewAuthProviderCase(repo AuthProviderRepo, logger log.Logger) *AuthProvider
 	}
 }
 
+// FindByProvider 根据登录方式和第三方ID查找用户
+func (uc *AuthProviderCase) FindByProvider(ctx context.Context, providerType, providerID string) (*User, error) {
+	uc.log.WithContext(ctx).Infof("FindByProvider: %v %v", providerType, providerID)
+	return uc.repo.FindByProvider(ctx, providerType, providerID)
+}
+
 // FindByGoogleID 根据Google ID查找用户
 func (uc *AuthProviderCase) FindByGoogleID(ctx context.Context, googleID string) (*User, error) {
 	uc.log.WithContext(ctx).Infof("FindByGoogleID: %v", googleID)
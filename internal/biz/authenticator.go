@@ -0,0 +1,122 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	v1 "user-service/api/auth/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+var (
+	// ErrProviderNotSupported 未配置的登录方式
+	ErrProviderNotSupported = errors.BadRequest(v1.ErrorReason_PROVIDER_NOT_SUPPORTED.String(), "provider not supported")
+	// ErrInvalidCredential 第三方凭证校验失败
+	ErrInvalidCredential = errors.Unauthorized(v1.ErrorReason_INVALID_CREDENTIAL.String(), "invalid credential")
+	// ErrTenantNotAllowed 企业租户不允许登录
+	ErrTenantNotAllowed = errors.Forbidden(v1.ErrorReason_TENANT_NOT_ALLOWED.String(), "tenant not allowed")
+	// ErrUnauthenticated 缺少或无效的登录 token
+	ErrUnauthenticated = errors.Unauthorized(v1.ErrorReason_UNAUTHENTICATED.String(), "unauthenticated")
+)
+
+// Credential 客户端提交的第三方登录凭证
+type Credential struct {
+	IDToken     string
+	Nonce       string
+	AccessToken string
+	// OAuth 2.0 授权码及 PKCE 参数
+	Code         string
+	CodeVerifier string
+	RedirectURI  string
+}
+
+// Identity 第三方认证通过后的用户身份
+type Identity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Phone         string
+	Name          string
+	Avatar        string
+	// RawClaims 第三方返回的原始声明或用户信息
+	RawClaims map[string]interface{}
+	// 第三方令牌, 配置了加密密钥时才会保存
+	AccessToken    string
+	RefreshToken   string
+	TokenExpiresAt time.Time
+}
+
+// Authenticator 第三方身份认证器
+type Authenticator interface {
+	// Provider 认证器名称, 即 auth_provider.provider_type
+	Provider() string
+	// Authenticate 校验凭证并返回身份
+	Authenticate(ctx context.Context, cred *Credential) (*Identity, error)
+}
+
+// TokenRevoker 撤销第三方授权, 注销账号时调用
+type TokenRevoker interface {
+	// Provider 对应 auth_provider 中的 provider_type
+	Provider() string
+	// Revoke 撤销 refresh_token
+	Revoke(ctx context.Context, refreshToken string) error
+}
+
+// AuthenticatorRegistry 认证器注册表
+type AuthenticatorRegistry struct {
+	mu             sync.RWMutex
+	authenticators map[string]Authenticator
+}
+
+// NewAuthenticatorRegistry 创建认证器注册表
+func NewAuthenticatorRegistry(authenticators ...Authenticator) *AuthenticatorRegistry {
+	r := &AuthenticatorRegistry{
+		authenticators: make(map[string]Authenticator),
+	}
+	for _, a := range authenticators {
+		r.Register(a)
+	}
+	return r
+}
+
+// Register 注册认证器, 同名认证器会被覆盖
+func (r *AuthenticatorRegistry) Register(a Authenticator) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.authenticators[a.Provider()] = a
+}
+
+// Has 是否已注册同名认证器
+func (r *AuthenticatorRegistry) Has(provider string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, ok := r.authenticators[provider]
+	return ok
+}
+
+// Get 根据名称获取认证器
+func (r *AuthenticatorRegistry) Get(provider string) (Authenticator, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	a, ok := r.authenticators[provider]
+	if !ok {
+		return nil, ErrProviderNotSupported
+	}
+	return a, nil
+}
+
+// Providers 已注册的认证器名称
+func (r *AuthenticatorRegistry) Providers() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	providers := make([]string, 0, len(r.authenticators))
+	for name := range r.authenticators {
+		providers = append(providers, name)
+	}
+	sort.Strings(providers)
+	return providers
+}
@@ -0,0 +1,266 @@
+package biz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	stderrors "errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "user-service/api/auth/v1"
+	"user-service/internal/conf"
+	"user-service/third_party/imaging"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultAvatarMaxBytes     = 5 << 20
+	defaultAvatarMaxDimension = 4096
+	defaultAvatarWorkers      = 2
+	defaultAvatarQueueSize    = 100
+	defaultAvatarFetchTimeout = 10 * time.Second
+	avatarJPEGQuality         = 85
+)
+
+// defaultAvatarSizes 默认生成的头像尺寸
+var defaultAvatarSizes = []int{64, 256, 512}
+
+var (
+	// ErrInvalidImage 不是支持的图片格式或图片已损坏
+	ErrInvalidImage = errors.BadRequest(v1.ErrorReason_INVALID_IMAGE.String(), "invalid image")
+	// ErrAvatarTooLarge 图片文件或尺寸超过限制
+	ErrAvatarTooLarge = errors.BadRequest(v1.ErrorReason_IMAGE_TOO_LARGE.String(), "image too large")
+	// ErrAvatarStorageDisabled 未配置对象存储
+	ErrAvatarStorageDisabled = errors.ServiceUnavailable(v1.ErrorReason_STORAGE_DISABLED.String(), "avatar storage is not configured")
+)
+
+// AvatarRepo 头像下载和存储
+type AvatarRepo interface {
+	// Enabled 是否配置了对象存储
+	Enabled() bool
+	// Fetch 下载第三方头像, 超过 maxBytes 时返回 ErrAvatarTooLarge
+	Fetch(ctx context.Context, url string, maxBytes int64) ([]byte, error)
+	// Put 写入对象存储并返回访问地址
+	Put(ctx context.Context, key, contentType string, data []byte) (string, error)
+	// IsStored 头像地址是否指向我们自己的存储
+	IsStored(url string) bool
+	// Delete 删除存储中的头像, 不是我们存储的地址时忽略
+	Delete(ctx context.Context, url string) error
+}
+
+// AvatarImage 生成的某个尺寸的头像
+type AvatarImage struct {
+	Size int
+	URL  string
+}
+
+// avatarTask 待转存的第三方头像
+type avatarTask struct {
+	userID    int64
+	sourceURL string
+}
+
+// AvatarCase 把第三方头像转存到自己的存储, 避免 CDN 地址过期和泄露用户 IP
+type AvatarCase struct {
+	repo         AvatarRepo
+	userRepo     UserRepo
+	sizes        []int
+	maxBytes     int64
+	maxDimension int
+	workers      int
+	fetchTimeout time.Duration
+	queue        chan avatarTask
+	log          *log.Helper
+}
+
+// NewAvatarCase new an AvatarCase.
+func NewAvatarCase(cfg *conf.Avatar, repo AvatarRepo, userRepo UserRepo, logger log.Logger) *AvatarCase {
+	uc := &AvatarCase{
+		repo:         repo,
+		userRepo:     userRepo,
+		sizes:        defaultAvatarSizes,
+		maxBytes:     cfg.GetMaxBytes(),
+		maxDimension: int(cfg.GetMaxDimension()),
+		workers:      int(cfg.GetWorkers()),
+		fetchTimeout: cfg.GetFetchTimeout().AsDuration(),
+		log:          log.NewHelper(logger),
+	}
+	if len(cfg.GetSizes()) > 0 {
+		uc.sizes = make([]int, 0, len(cfg.GetSizes()))
+		for _, size := range cfg.GetSizes() {
+			uc.sizes = append(uc.sizes, int(size))
+		}
+		sort.Ints(uc.sizes)
+	}
+	if uc.maxBytes <= 0 {
+		uc.maxBytes = defaultAvatarMaxBytes
+	}
+	if uc.maxDimension <= 0 {
+		uc.maxDimension = defaultAvatarMaxDimension
+	}
+	if uc.workers <= 0 {
+		uc.workers = defaultAvatarWorkers
+	}
+	if uc.fetchTimeout <= 0 {
+		uc.fetchTimeout = defaultAvatarFetchTimeout
+	}
+	queueSize := int(cfg.GetQueueSize())
+	if queueSize <= 0 {
+		queueSize = defaultAvatarQueueSize
+	}
+	uc.queue = make(chan avatarTask, queueSize)
+	return uc
+}
+
+// Ingest 异步转存第三方头像
+// 用户已有自己存储中的头像(上传或已转存)时不再跟随第三方更新, 旧数据中的第三方地址会被替换;
+// 未配置存储时只为没有头像的用户直接使用第三方地址
+func (uc *AvatarCase) Ingest(ctx context.Context, u *User, sourceURL string) {
+	if u == nil || sourceURL == "" || uc.repo.IsStored(u.Avatar) {
+		return
+	}
+	if !uc.repo.Enabled() {
+		if u.Avatar != "" {
+			return
+		}
+		if err := uc.userRepo.UpdateAvatar(ctx, u.UserID, sourceURL); err != nil {
+			uc.log.WithContext(ctx).Errorf("failed to update user avatar, error: %v", err)
+			return
+		}
+		u.Avatar = sourceURL
+		return
+	}
+
+	select {
+	case uc.queue <- avatarTask{userID: u.UserID, sourceURL: sourceURL}:
+	default:
+		uc.log.WithContext(ctx).Warnf("avatar queue is full, dropping user %d", u.UserID)
+	}
+}
+
+// Run 启动处理协程消费转存队列, 阻塞直到 ctx 结束
+func (uc *AvatarCase) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	for i := 0; i < uc.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case task := <-uc.queue:
+					if err := uc.ingest(ctx, task); err != nil {
+						uc.log.Warnf("failed to ingest avatar for user %d, error: %v", task.userID, err)
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return nil
+}
+
+func (uc *AvatarCase) ingest(ctx context.Context, task avatarTask) error {
+	fetchCtx, cancel := context.WithTimeout(ctx, uc.fetchTimeout)
+	defer cancel()
+	data, err := uc.repo.Fetch(fetchCtx, task.sourceURL, uc.maxBytes)
+	if err != nil {
+		return err
+	}
+
+	images, err := uc.store(ctx, task.userID, data)
+	if err != nil {
+		return err
+	}
+
+	// 处理期间用户可能已上传头像
+	u, err := uc.userRepo.FindByID(ctx, task.userID)
+	if err != nil {
+		return err
+	}
+	if uc.repo.IsStored(u.Avatar) {
+		return nil
+	}
+	return uc.userRepo.UpdateAvatar(ctx, task.userID, images[len(images)-1].URL)
+}
+
+// Upload 保存用户上传的头像, 所有尺寸写入成功后才更新用户头像
+func (uc *AvatarCase) Upload(ctx context.Context, userID int64, data []byte) ([]*AvatarImage, error) {
+	uc.log.WithContext(ctx).Infof("Upload avatar: %v %v", userID, len(data))
+	if !uc.repo.Enabled() {
+		return nil, ErrAvatarStorageDisabled
+	}
+	images, err := uc.store(ctx, userID, data)
+	if err != nil {
+		return nil, err
+	}
+	if err = uc.userRepo.UpdateAvatar(ctx, userID, images[len(images)-1].URL); err != nil {
+		return nil, err
+	}
+	return images, nil
+}
+
+// Remove 删除用户当前头像的所有尺寸, 用于清除账号
+func (uc *AvatarCase) Remove(ctx context.Context, u *User) error {
+	if !uc.repo.IsStored(u.Avatar) {
+		return nil
+	}
+	// 头像地址是最大尺寸, 其他尺寸只有文件名末尾的尺寸不同
+	base := strings.TrimSuffix(u.Avatar, fmt.Sprintf("_%d.jpg", uc.sizes[len(uc.sizes)-1]))
+	if base == u.Avatar {
+		return uc.repo.Delete(ctx, u.Avatar)
+	}
+	for _, size := range uc.sizes {
+		if err := uc.repo.Delete(ctx, fmt.Sprintf("%s_%d.jpg", base, size)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MaxBytes 允许的图片最大字节数
+func (uc *AvatarCase) MaxBytes() int64 {
+	return uc.maxBytes
+}
+
+// store 校验图片并生成各尺寸的 JPEG 写入存储, 按尺寸从小到大返回
+// 重新编码会去掉 EXIF 等元数据; 文件名使用原图内容摘要, 同一张图片重复处理时覆盖相同的对象
+func (uc *AvatarCase) store(ctx context.Context, userID int64, data []byte) ([]*AvatarImage, error) {
+	if len(data) == 0 {
+		return nil, ErrInvalidImage
+	}
+	if int64(len(data)) > uc.maxBytes {
+		return nil, ErrAvatarTooLarge
+	}
+	img, _, err := imaging.Decode(data, uc.maxDimension)
+	if err != nil {
+		if stderrors.Is(err, imaging.ErrTooLarge) {
+			return nil, ErrAvatarTooLarge
+		}
+		return nil, ErrInvalidImage.WithCause(err)
+	}
+
+	sum := sha256.Sum256(data)
+	digest := hex.EncodeToString(sum[:8])
+	images := make([]*AvatarImage, 0, len(uc.sizes))
+	for _, size := range uc.sizes {
+		thumb, err := imaging.EncodeJPEG(imaging.Thumbnail(img, size), avatarJPEGQuality)
+		if err != nil {
+			return nil, err
+		}
+		key := fmt.Sprintf("avatars/%d/%s_%d.jpg", userID, digest, size)
+		url, err := uc.repo.Put(ctx, key, "image/jpeg", thumb)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, &AvatarImage{Size: size, URL: url})
+	}
+	return images, nil
+}
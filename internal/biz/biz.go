@@ -3,4 +3,12 @@ package biz
 import "github.com/google/wire"
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewGreeterUsecase, NewUserCase, NewAuthProviderCase, NewUserAuthCase)
+var ProviderSet = wire.NewSet(NewGreeterUsecase, NewPhoneNormalizer, NewUserCase, NewAuthProviderCase, NewUserAuthCase, NewAppleNotificationCase, NewSessionCase, NewAvatarCase, NewHandleCase, NewDeletionCase, NewExportCase, NewAdminCase)
+
+// truncate 按字符截断到 n 个字符以内, 与 MySQL varchar 的长度一致, 避免截断多字节字符
+func truncate(s string, n int) string {
+	if r := []rune(s); len(r) > n {
+		return string(r[:n])
+	}
+	return s
+}
@@ -0,0 +1,21 @@
+package biz
+
+import "testing"
+
+func TestTruncate(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		n    int
+		want string
+	}{
+		{"failed", 10, "failed"},
+		{"failed", 4, "fail"},
+		// 多字节字符按字符计数, 不会截成无效的 UTF-8
+		{"导出失败", 3, "导出失"},
+		{"导出失败", 4, "导出失败"},
+	} {
+		if got := truncate(tt.s, tt.n); got != tt.want {
+			t.Errorf("truncate(%q, %d) = %q, want %q", tt.s, tt.n, got, tt.want)
+		}
+	}
+}
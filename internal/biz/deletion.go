@@ -0,0 +1,161 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	v1 "user-service/api/user/v1"
+	"user-service/internal/conf"
+	"user-service/third_party/jwt"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultDeletionGracePeriod = 30 * 24 * time.Hour
+	defaultDeletionRecentAuth  = 10 * time.Minute
+)
+
+// ErrReauthenticationRequired 敏感操作要求最近重新登录过
+var ErrReauthenticationRequired = errors.Unauthorized(v1.ErrorReason_REAUTHENTICATION_REQUIRED.String(), "please sign in again to continue")
+
+// DeletionRepo 账号注销仓储
+type DeletionRepo interface {
+	// Schedule 标记用户待注销, scheduledAt 之后清除
+	Schedule(ctx context.Context, userID int64, scheduledAt time.Time) error
+	// Cancel 取消待注销状态, 返回是否原本处于待注销状态
+	Cancel(ctx context.Context, userID int64) (bool, error)
+	// ListDue 列出冷静期已结束的用户, 最多 limit 个
+	ListDue(ctx context.Context, before time.Time, limit int) ([]*User, error)
+	// Purge 删除用户及其合并过来的账号的资料、登录方式、会话和缓存, 并在同一事务中记录账号已删除事件
+	// 用户已取消注销或未到期时不删除, 返回 nil
+	Purge(ctx context.Context, userID int64, before time.Time) (*PurgedAccount, error)
+	// PublishEvents 发布待发布的用户事件, 最多 limit 个, 返回发布数量
+	PublishEvents(ctx context.Context, limit int) (int, error)
+}
+
+// PurgedAccount 已清除的账号, 提交后据此清理外部数据
+type PurgedAccount struct {
+	// UserIDs 删除的 user_id, 包括合并到该用户的旧账号
+	UserIDs []int64
+	// Providers 删除前关联的登录方式, 含解密后的 refresh_token
+	Providers []*LinkedProvider
+	// Avatars 删除的账号的头像地址
+	Avatars []string
+	// ExportKeys 导出文件在私有存储中的对象 key
+	ExportKeys []string
+}
+
+// DeletionCase 账号注销: 申请后进入冷静期, 期间重新登录即取消, 到期后由后台任务彻底清除
+type DeletionCase struct {
+	repo        DeletionRepo
+	sessionRepo SessionRepo
+	avatarCase  *AvatarCase
+	exportCase  *ExportCase
+	revokers    []TokenRevoker
+	gracePeriod time.Duration
+	recentAuth  time.Duration
+	log         *log.Helper
+}
+
+// NewDeletionCase new a DeletionCase.
+func NewDeletionCase(cfg *conf.User, repo DeletionRepo, sessionRepo SessionRepo, avatarCase *AvatarCase, exportCase *ExportCase, logger log.Logger) *DeletionCase {
+	c := cfg.GetDeletion()
+	uc := &DeletionCase{
+		repo:        repo,
+		sessionRepo: sessionRepo,
+		avatarCase:  avatarCase,
+		exportCase:  exportCase,
+		gracePeriod: c.GetGracePeriod().AsDuration(),
+		recentAuth:  c.GetRecentAuth().AsDuration(),
+		log:         log.NewHelper(logger),
+	}
+	if uc.gracePeriod <= 0 {
+		uc.gracePeriod = defaultDeletionGracePeriod
+	}
+	if uc.recentAuth <= 0 {
+		uc.recentAuth = defaultDeletionRecentAuth
+	}
+	return uc
+}
+
+// RegisterRevoker 注册清除账号时需要撤销的第三方授权
+func (uc *DeletionCase) RegisterRevoker(revoker TokenRevoker) {
+	uc.revokers = append(uc.revokers, revoker)
+}
+
+// Request 申请注销, token 必须是最近签发的; 撤销全部会话, 返回计划清除的时间
+func (uc *DeletionCase) Request(ctx context.Context, claims *jwt.Claims) (time.Time, error) {
+	uc.log.WithContext(ctx).Infof("RequestDeletion: %v", claims.UserID)
+	if claims.IssuedAt.IsZero() || time.Since(claims.IssuedAt) > uc.recentAuth {
+		return time.Time{}, ErrReauthenticationRequired
+	}
+
+	scheduledAt := time.Now().Add(uc.gracePeriod)
+	if err := uc.repo.Schedule(ctx, claims.UserID, scheduledAt); err != nil {
+		return time.Time{}, err
+	}
+	if err := uc.sessionRepo.RevokeByUser(ctx, claims.UserID); err != nil {
+		return time.Time{}, err
+	}
+	return scheduledAt, nil
+}
+
+// Purge 清除冷静期已结束的账号, 最多 limit 个, 返回清除数量
+func (uc *DeletionCase) Purge(ctx context.Context, limit int) (int, error) {
+	users, err := uc.repo.ListDue(ctx, time.Now(), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for _, u := range users {
+		purged, err := uc.purge(ctx, u)
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("failed to purge user %d, error: %v", u.UserID, err)
+			continue
+		}
+		if purged {
+			n++
+		}
+	}
+	if n > 0 {
+		uc.log.WithContext(ctx).Infof("PurgeDeletions: purged %v users", n)
+	}
+	return n, nil
+}
+
+// purge 先在事务中确认并删除, 提交后再撤销授权和删除文件, 期间取消注销的用户不受影响
+func (uc *DeletionCase) purge(ctx context.Context, u *User) (bool, error) {
+	account, err := uc.repo.Purge(ctx, u.UserID, time.Now())
+	if err != nil || account == nil {
+		return false, err
+	}
+
+	// 账号已删除, 外部清理失败只记录, 不影响清除结果
+	for _, revoker := range uc.revokers {
+		for _, p := range account.Providers {
+			if p.ProviderType != revoker.Provider() || p.RefreshToken == "" {
+				continue
+			}
+			if err = revoker.Revoke(ctx, p.RefreshToken); err != nil {
+				uc.log.WithContext(ctx).Warnf("failed to revoke %s token for user %d, error: %v", revoker.Provider(), u.UserID, err)
+			}
+		}
+	}
+	if err = uc.exportCase.RemoveArchives(ctx, account.ExportKeys); err != nil {
+		uc.log.WithContext(ctx).Errorf("failed to remove exports %v for user %d, error: %v", account.ExportKeys, u.UserID, err)
+	}
+	for _, avatar := range account.Avatars {
+		if err = uc.avatarCase.Remove(ctx, &User{UserID: u.UserID, Avatar: avatar}); err != nil {
+			uc.log.WithContext(ctx).Warnf("failed to remove avatar %s for user %d, error: %v", avatar, u.UserID, err)
+		}
+	}
+	return true, nil
+}
+
+// PublishEvents 发布账号已删除等用户事件, 最多 limit 个, 返回发布数量
+func (uc *DeletionCase) PublishEvents(ctx context.Context, limit int) (int, error) {
+	return uc.repo.PublishEvents(ctx, limit)
+}
@@ -0,0 +1,99 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"user-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeDeletionRepo Purge 返回预设的结果, nil 表示已取消注销
+type fakeDeletionRepo struct {
+	DeletionRepo
+	due     []*User
+	account *PurgedAccount
+}
+
+func (r *fakeDeletionRepo) ListDue(ctx context.Context, before time.Time, limit int) ([]*User, error) {
+	return r.due, nil
+}
+
+func (r *fakeDeletionRepo) Purge(ctx context.Context, userID int64, before time.Time) (*PurgedAccount, error) {
+	return r.account, nil
+}
+
+type fakeExportRepo struct {
+	ExportRepo
+	deleted []string
+}
+
+func (r *fakeExportRepo) DeleteArchive(ctx context.Context, key string) error {
+	r.deleted = append(r.deleted, key)
+	return nil
+}
+
+type fakeRevoker struct {
+	revoked []string
+}
+
+func (r *fakeRevoker) Provider() string {
+	return "apple"
+}
+
+func (r *fakeRevoker) Revoke(ctx context.Context, refreshToken string) error {
+	r.revoked = append(r.revoked, refreshToken)
+	return nil
+}
+
+func newTestDeletionCase(repo DeletionRepo) (*DeletionCase, *fakeExportRepo, *fakeRevoker) {
+	exports := &fakeExportRepo{}
+	revoker := &fakeRevoker{}
+	uc := NewDeletionCase(&conf.User{}, repo, nil, nil, &ExportCase{repo: exports}, log.NewStdLogger(io.Discard))
+	uc.RegisterRevoker(revoker)
+	return uc, exports, revoker
+}
+
+func TestPurgeCancelledDeletionKeepsTokensAndExports(t *testing.T) {
+	// 列出时已到期, 清除事务中发现用户已取消注销
+	repo := &fakeDeletionRepo{due: []*User{{UserID: 1}}}
+	uc, exports, revoker := newTestDeletionCase(repo)
+
+	n, err := uc.Purge(context.Background(), 10)
+	if err != nil || n != 0 {
+		t.Fatalf("expected nothing purged, got %d %v", n, err)
+	}
+	if len(revoker.revoked) != 0 || len(exports.deleted) != 0 {
+		t.Fatalf("expected no side effects, got revoked %v deleted %v", revoker.revoked, exports.deleted)
+	}
+}
+
+func TestPurgeCleansUpAfterCommit(t *testing.T) {
+	repo := &fakeDeletionRepo{
+		due: []*User{{UserID: 1}},
+		account: &PurgedAccount{
+			UserIDs: []int64{1, 2},
+			Providers: []*LinkedProvider{
+				{ProviderType: "apple", RefreshToken: "merged-token"},
+				{ProviderType: "google", RefreshToken: "google-token"},
+			},
+			ExportKeys: []string{"exports/1/a.zip"},
+		},
+	}
+	uc, exports, revoker := newTestDeletionCase(repo)
+
+	n, err := uc.Purge(context.Background(), 10)
+	if err != nil || n != 1 {
+		t.Fatalf("expected 1 purged, got %d %v", n, err)
+	}
+	// 合并过来的账号的授权同样撤销, 只交给对应的 revoker
+	if len(revoker.revoked) != 1 || revoker.revoked[0] != "merged-token" {
+		t.Fatalf("expected the apple token revoked, got %v", revoker.revoked)
+	}
+	if len(exports.deleted) != 1 || exports.deleted[0] != "exports/1/a.zip" {
+		t.Fatalf("expected the export archive deleted, got %v", exports.deleted)
+	}
+}
@@ -0,0 +1,392 @@
+package biz
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "user-service/api/user/v1"
+	"user-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultExportLinkTTL         = 15 * time.Minute
+	defaultExportRetention       = 7 * 24 * time.Hour
+	defaultExportRequestInterval = 24 * time.Hour
+	// exportRunTimeout 处理中的导出超过该时长视为中断, 重新处理
+	exportRunTimeout = 10 * time.Minute
+)
+
+// 导出状态
+const (
+	ExportPending = "pending"
+	ExportRunning = "running"
+	ExportReady   = "ready"
+	ExportFailed  = "failed"
+	ExportExpired = "expired"
+)
+
+// ExportDownloadPath 下载导出文件的地址, 使用签名校验而不是登录 token
+const ExportDownloadPath = "/user/v1/exports/{export_id}/download"
+
+var (
+	// ErrExportNotFound 导出不存在或不属于当前用户
+	ErrExportNotFound = errors.NotFound(v1.ErrorReason_EXPORT_NOT_FOUND.String(), "data export not found")
+	// ErrExportTooSoon 距离上次申请时间太短
+	ErrExportTooSoon = errors.New(429, v1.ErrorReason_EXPORT_TOO_SOON.String(), "data export was requested recently")
+	// ErrExportDisabled 未配置私有存储或签名密钥
+	ErrExportDisabled = errors.ServiceUnavailable(v1.ErrorReason_EXPORT_DISABLED.String(), "data export is not configured")
+	// ErrExportLinkInvalid 下载链接签名错误或已过期
+	ErrExportLinkInvalid = errors.Forbidden(v1.ErrorReason_EXPORT_LINK_INVALID.String(), "download link is invalid or expired")
+)
+
+// DataExport 个人数据导出
+type DataExport struct {
+	ExportID    string
+	UserID      int64
+	Status      string
+	ObjectKey   string
+	Error       string
+	CompletedAt *time.Time
+	ExpiresAt   *time.Time
+	CreatedAt   time.Time
+}
+
+// ExportRepo 数据导出仓储
+type ExportRepo interface {
+	// Enabled 是否配置了私有存储
+	Enabled() bool
+	// Create 创建待处理的导出
+	Create(ctx context.Context, e *DataExport) (*DataExport, error)
+	// Find 根据 export_id 查找, 不存在时返回 ErrExportNotFound
+	Find(ctx context.Context, exportID string) (*DataExport, error)
+	// FindLatest 查找用户最近一次导出, 没有时返回 nil
+	FindLatest(ctx context.Context, userID int64) (*DataExport, error)
+	// ListPending 列出待处理和处理中断的导出, staleBefore 之前开始处理的视为中断
+	ListPending(ctx context.Context, staleBefore time.Time, limit int) ([]*DataExport, error)
+	// Claim 标记为处理中, 已被其他实例处理时返回 false
+	Claim(ctx context.Context, exportID string, staleBefore time.Time) (bool, error)
+	// Complete 标记完成
+	Complete(ctx context.Context, exportID, objectKey string, expiresAt time.Time) error
+	// Fail 标记失败
+	Fail(ctx context.Context, exportID, reason string) error
+	// ListExpired 列出已到期但文件未删除的导出
+	ListExpired(ctx context.Context, before time.Time, limit int) ([]*DataExport, error)
+	// Expire 标记文件已删除
+	Expire(ctx context.Context, exportID string) error
+	// PutArchive 写入私有存储
+	PutArchive(ctx context.Context, key string, data []byte) error
+	// GetArchive 读取私有存储
+	GetArchive(ctx context.Context, key string) ([]byte, error)
+	// DeleteArchive 删除私有存储中的文件, 不存在时不返回错误
+	DeleteArchive(ctx context.Context, key string) error
+}
+
+// ExportCase 个人数据导出: 申请后由后台任务生成 zip, 通过带签名的链接下载
+type ExportCase struct {
+	repo            ExportRepo
+	userRepo        UserRepo
+	authRepo        AuthProviderRepo
+	sessionRepo     SessionRepo
+	signingKey      []byte
+	baseURL         string
+	linkTTL         time.Duration
+	retention       time.Duration
+	requestInterval time.Duration
+	log             *log.Helper
+}
+
+// NewExportCase new an ExportCase.
+func NewExportCase(cfg *conf.User, repo ExportRepo, userRepo UserRepo, authRepo AuthProviderRepo, sessionRepo SessionRepo, logger log.Logger) *ExportCase {
+	c := cfg.GetExport()
+	uc := &ExportCase{
+		repo:            repo,
+		userRepo:        userRepo,
+		authRepo:        authRepo,
+		sessionRepo:     sessionRepo,
+		signingKey:      []byte(c.GetSigningKey()),
+		baseURL:         strings.TrimSuffix(c.GetBaseUrl(), "/"),
+		linkTTL:         c.GetLinkTtl().AsDuration(),
+		retention:       c.GetRetention().AsDuration(),
+		requestInterval: c.GetRequestInterval().AsDuration(),
+		log:             log.NewHelper(logger),
+	}
+	if uc.linkTTL <= 0 {
+		uc.linkTTL = defaultExportLinkTTL
+	}
+	if uc.retention <= 0 {
+		uc.retention = defaultExportRetention
+	}
+	if uc.requestInterval <= 0 {
+		uc.requestInterval = defaultExportRequestInterval
+	}
+	return uc
+}
+
+// Request 申请导出, 已有未完成的导出时直接返回
+func (uc *ExportCase) Request(ctx context.Context, userID int64) (*DataExport, error) {
+	uc.log.WithContext(ctx).Infof("RequestDataExport: %v", userID)
+	if !uc.enabled() {
+		return nil, ErrExportDisabled
+	}
+	latest, err := uc.repo.FindLatest(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if latest != nil {
+		if latest.Status == ExportPending || latest.Status == ExportRunning {
+			return latest, nil
+		}
+		if next := latest.CreatedAt.Add(uc.requestInterval); time.Now().Before(next) {
+			return nil, ErrExportTooSoon.WithMetadata(map[string]string{"next_request_at": next.UTC().Format(time.RFC3339)})
+		}
+	}
+
+	buf := make([]byte, 16)
+	if _, err = rand.Read(buf); err != nil {
+		return nil, err
+	}
+	return uc.repo.Create(ctx, &DataExport{
+		ExportID: hex.EncodeToString(buf),
+		UserID:   userID,
+		Status:   ExportPending,
+	})
+}
+
+// Get 查询用户自己的导出
+func (uc *ExportCase) Get(ctx context.Context, userID int64, exportID string) (*DataExport, error) {
+	e, err := uc.repo.Find(ctx, exportID)
+	if err != nil {
+		return nil, err
+	}
+	if e.UserID != userID {
+		return nil, ErrExportNotFound
+	}
+	return e, nil
+}
+
+// DownloadURL 生成有时效的下载链接, 不晚于文件删除时间
+func (uc *ExportCase) DownloadURL(e *DataExport) (string, time.Time) {
+	if e.Status != ExportReady || e.ExpiresAt == nil {
+		return "", time.Time{}
+	}
+	expires := time.Now().Add(uc.linkTTL)
+	if e.ExpiresAt.Before(expires) {
+		expires = *e.ExpiresAt
+	}
+	query := url.Values{
+		"expires":   {strconv.FormatInt(expires.Unix(), 10)},
+		"signature": {uc.sign(e.ExportID, expires.Unix())},
+	}
+	path := strings.Replace(ExportDownloadPath, "{export_id}", e.ExportID, 1)
+	return uc.baseURL + path + "?" + query.Encode(), expires
+}
+
+// Download 校验下载链接并返回导出文件
+func (uc *ExportCase) Download(ctx context.Context, exportID, expires, signature string) ([]byte, error) {
+	if !uc.enabled() {
+		return nil, ErrExportDisabled
+	}
+	unix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().Unix() > unix {
+		return nil, ErrExportLinkInvalid
+	}
+	if !hmac.Equal([]byte(signature), []byte(uc.sign(exportID, unix))) {
+		return nil, ErrExportLinkInvalid
+	}
+
+	e, err := uc.repo.Find(ctx, exportID)
+	if err != nil {
+		return nil, err
+	}
+	if e.Status != ExportReady {
+		return nil, ErrExportNotFound
+	}
+	uc.log.WithContext(ctx).Infof("DownloadDataExport: %v %v", e.UserID, exportID)
+	return uc.repo.GetArchive(ctx, e.ObjectKey)
+}
+
+// Process 生成待处理的导出, 最多 limit 个, 返回处理数量
+func (uc *ExportCase) Process(ctx context.Context, limit int) (int, error) {
+	staleBefore := time.Now().Add(-exportRunTimeout)
+	exports, err := uc.repo.ListPending(ctx, staleBefore, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for _, e := range exports {
+		claimed, err := uc.repo.Claim(ctx, e.ExportID, staleBefore)
+		if err != nil {
+			return n, err
+		}
+		if !claimed {
+			continue
+		}
+		n++
+		if err = uc.build(ctx, e); err != nil {
+			uc.log.WithContext(ctx).Errorf("failed to build data export %s, error: %v", e.ExportID, err)
+			if err = uc.repo.Fail(ctx, e.ExportID, truncate(err.Error(), 255)); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// Cleanup 删除已到期的导出文件, 最多 limit 个, 返回处理数量
+func (uc *ExportCase) Cleanup(ctx context.Context, limit int) (int, error) {
+	exports, err := uc.repo.ListExpired(ctx, time.Now(), limit)
+	if err != nil {
+		return 0, err
+	}
+	for i, e := range exports {
+		if err = uc.expire(ctx, e); err != nil {
+			return i, err
+		}
+	}
+	return len(exports), nil
+}
+
+// RemoveArchives 删除私有存储中的导出文件, 用于清除账号后清理已删除记录对应的文件
+func (uc *ExportCase) RemoveArchives(ctx context.Context, keys []string) error {
+	for _, key := range keys {
+		if err := uc.repo.DeleteArchive(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (uc *ExportCase) expire(ctx context.Context, e *DataExport) error {
+	if e.ObjectKey != "" {
+		if err := uc.repo.DeleteArchive(ctx, e.ObjectKey); err != nil {
+			return err
+		}
+	}
+	return uc.repo.Expire(ctx, e.ExportID)
+}
+
+func (uc *ExportCase) build(ctx context.Context, e *DataExport) error {
+	archive, err := uc.archive(ctx, e.UserID)
+	if err != nil {
+		return err
+	}
+	key := fmt.Sprintf("exports/%d/%s.zip", e.UserID, e.ExportID)
+	if err = uc.repo.PutArchive(ctx, key, archive); err != nil {
+		return err
+	}
+	return uc.repo.Complete(ctx, e.ExportID, key, time.Now().Add(uc.retention))
+}
+
+// exportProvider 导出的登录方式, 不包含令牌
+type exportProvider struct {
+	ProviderType  string                 `json:"provider_type"`
+	ProviderID    string                 `json:"provider_id"`
+	Email         string                 `json:"email,omitempty"`
+	EmailVerified bool                   `json:"email_verified"`
+	Name          string                 `json:"name,omitempty"`
+	Avatar        string                 `json:"avatar,omitempty"`
+	RawClaims     map[string]interface{} `json:"raw_claims,omitempty"`
+	CreatedAt     time.Time              `json:"created_at"`
+	UpdatedAt     time.Time              `json:"updated_at"`
+}
+
+// exportSession 导出的会话, 同时是登录历史
+type exportSession struct {
+	Provider  string     `json:"provider"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+// archive 生成 zip, 每类数据一个 JSON 文件
+func (uc *ExportCase) archive(ctx context.Context, userID int64) ([]byte, error) {
+	u, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	providers, err := uc.authRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := uc.sessionRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	exportProviders := make([]*exportProvider, 0, len(providers))
+	for _, p := range providers {
+		exportProviders = append(exportProviders, &exportProvider{
+			ProviderType:  p.ProviderType,
+			ProviderID:    p.ProviderID,
+			Email:         p.Email,
+			EmailVerified: p.EmailVerified,
+			Name:          p.Name,
+			Avatar:        p.Avatar,
+			RawClaims:     p.RawClaims,
+			CreatedAt:     p.CreatedAt,
+			UpdatedAt:     p.UpdatedAt,
+		})
+	}
+	exportSessions := make([]*exportSession, 0, len(sessions))
+	for _, s := range sessions {
+		exportSessions = append(exportSessions, &exportSession{
+			Provider:  s.Provider,
+			CreatedAt: s.CreatedAt,
+			ExpiresAt: s.ExpiresAt,
+			RevokedAt: s.RevokedAt,
+		})
+	}
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	files := []struct {
+		name string
+		v    interface{}
+	}{
+		{"user.json", u},
+		{"auth_providers.json", exportProviders},
+		{"sessions.json", exportSessions},
+	}
+	for _, f := range files {
+		data, err := json.MarshalIndent(f.v, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		fw, err := w.Create(f.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = fw.Write(data); err != nil {
+			return nil, err
+		}
+	}
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (uc *ExportCase) enabled() bool {
+	return len(uc.signingKey) > 0 && uc.repo.Enabled()
+}
+
+func (uc *ExportCase) sign(exportID string, expires int64) string {
+	mac := hmac.New(sha256.New, uc.signingKey)
+	mac.Write([]byte("data-export\n" + exportID + "\n" + strconv.FormatInt(expires, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
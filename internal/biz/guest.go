@@ -0,0 +1,92 @@
+package biz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// GuestProvider 游客账号在 auth_provider 中的类型
+const GuestProvider = "guest"
+
+// FindOrCreateGuest 根据设备密钥查找或创建游客账号
+// 只保存密钥的 SHA-256, 游客账号没有姓名、邮箱和手机号
+func (uc *UserAuthCase) FindOrCreateGuest(ctx context.Context, deviceSecret string) (*User, bool, error) {
+	sum := sha256.Sum256([]byte(deviceSecret))
+	guestID := hex.EncodeToString(sum[:])
+	uc.log.WithContext(ctx).Infof("FindOrCreateGuest: %v", guestID)
+
+	found, err := uc.authRepo.FindByProvider(ctx, GuestProvider, guestID)
+	if err == nil {
+		return found, false, nil
+	}
+	if !errors.Is(err, ErrUserNotFound) {
+		return nil, false, err
+	}
+
+	login := &ProviderLogin{ProviderType: GuestProvider, ProviderID: guestID}
+	return uc.userRepo.FindOrCreateByProvider(ctx, login, &User{})
+}
+
+// LinkPhone 为已登录用户绑定手机号, 游客绑定后升级为正式账号
+func (uc *UserAuthCase) LinkPhone(ctx context.Context, userID int64, phone string) (*User, error) {
+	uc.log.WithContext(ctx).Infof("LinkPhone: %v %v", userID, phone)
+	phone, err := uc.phones.Normalize(phone)
+	if err != nil {
+		return nil, err
+	}
+	u, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if u.Phone == phone {
+		return u, nil
+	}
+	// 已绑定其他手机号, 或手机号已被其他用户使用
+	if u.Phone != "" {
+		return nil, ErrProviderAlreadyLinked
+	}
+	_, err = uc.userRepo.FindByPhone(ctx, phone)
+	if err == nil {
+		return nil, ErrProviderAlreadyLinked
+	}
+	if !errors.Is(err, ErrUserNotFound) {
+		return nil, err
+	}
+
+	u.Phone = phone
+	if u, err = uc.userRepo.Update(ctx, u); err != nil {
+		return nil, err
+	}
+	return u, uc.upgradeGuest(ctx, userID)
+}
+
+// upgradeGuest 关联了正式登录方式后删除游客密钥, 账号不再能用设备密钥登录
+func (uc *UserAuthCase) upgradeGuest(ctx context.Context, userID int64) error {
+	providers, err := uc.authRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, p := range providers {
+		if p.ProviderType != GuestProvider {
+			continue
+		}
+		uc.log.WithContext(ctx).Infof("upgrade guest: %v", userID)
+		if err = uc.authRepo.Delete(ctx, p.ProviderType, p.ProviderID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CleanupGuests 删除 inactiveSince 之后没有登录过的游客账号, 返回删除数量
+func (uc *UserAuthCase) CleanupGuests(ctx context.Context, inactiveSince time.Time, limit int) (int, error) {
+	n, err := uc.userRepo.DeleteInactiveGuests(ctx, inactiveSince, limit)
+	if n > 0 {
+		uc.log.WithContext(ctx).Infof("CleanupGuests: deleted %v guests inactive since %v", n, inactiveSince)
+	}
+	return n, err
+}
@@ -0,0 +1,217 @@
+package biz
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	v1 "user-service/api/user/v1"
+	"user-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultHandleChangeInterval = 30 * 24 * time.Hour
+	defaultHandleHoldPeriod     = 90 * 24 * time.Hour
+)
+
+// handle 不可用的原因
+const (
+	HandleInvalid  = "invalid"
+	HandleReserved = "reserved"
+	HandleTaken    = "taken"
+	HandleHeld     = "held"
+)
+
+// handlePattern 3-30 位, 字母开头, 只包含字母、数字和下划线
+var handlePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{2,29}$`)
+
+// reservedHandles 系统路径和容易冒充官方的名称
+var reservedHandles = []string{
+	"about", "account", "accounts", "admin", "administrator", "api", "app", "auth",
+	"contact", "help", "home", "login", "logout", "mail", "me", "mod", "moderator",
+	"null", "official", "privacy", "register", "root", "security", "settings",
+	"signin", "signup", "staff", "support", "system", "terms", "undefined", "user",
+	"users", "www",
+}
+
+// blockedWords 屏蔽词, handle 去掉下划线后包含即不可用
+var blockedWords = []string{
+	"bitch", "cunt", "fuck", "nazi", "nigger", "porn", "shit", "whore",
+}
+
+var (
+	// ErrHandleInvalid handle 格式不正确
+	ErrHandleInvalid = errors.BadRequest(v1.ErrorReason_HANDLE_INVALID.String(), "handle must be 3-30 letters, digits or underscores and start with a letter")
+	// ErrHandleUnavailable handle 被保留、已被使用或在保留期内
+	ErrHandleUnavailable = errors.Conflict(v1.ErrorReason_HANDLE_UNAVAILABLE.String(), "handle is not available")
+	// ErrHandleChangeTooSoon 距离上次修改时间太短
+	ErrHandleChangeTooSoon = errors.New(429, v1.ErrorReason_HANDLE_CHANGE_TOO_SOON.String(), "handle was changed recently")
+)
+
+// HandleHistory 用户用过的 handle
+type HandleHistory struct {
+	UserID    int64
+	Handle    string
+	HeldUntil time.Time
+	CreatedAt time.Time
+}
+
+// HandleRepo handle 仓储
+type HandleRepo interface {
+	// FindUser 查找当前使用该 handle 的用户, 不存在时返回 ErrUserNotFound
+	FindUser(ctx context.Context, handleLower string) (*User, error)
+	// FindLatestHistory 查找最近一次放弃该 handle 的记录, 不存在时返回 nil
+	FindLatestHistory(ctx context.Context, handleLower string) (*HandleHistory, error)
+	// Change 修改用户的 handle, 旧 handle 记入历史并保留到 heldUntil; 已被占用时返回 ErrHandleUnavailable
+	Change(ctx context.Context, userID int64, handle string, heldUntil time.Time) (*User, error)
+}
+
+// HandleCase 用户 @handle 的检查和修改
+type HandleCase struct {
+	repo           HandleRepo
+	userRepo       UserRepo
+	reserved       map[string]bool
+	blocked        []string
+	changeInterval time.Duration
+	holdPeriod     time.Duration
+	log            *log.Helper
+}
+
+// NewHandleCase new a HandleCase.
+func NewHandleCase(cfg *conf.User, repo HandleRepo, userRepo UserRepo, logger log.Logger) *HandleCase {
+	c := cfg.GetHandle()
+	uc := &HandleCase{
+		repo:           repo,
+		userRepo:       userRepo,
+		reserved:       make(map[string]bool),
+		changeInterval: c.GetChangeInterval().AsDuration(),
+		holdPeriod:     c.GetHoldPeriod().AsDuration(),
+		log:            log.NewHelper(logger),
+	}
+	for _, word := range append(reservedHandles, c.GetReserved()...) {
+		uc.reserved[strings.ToLower(word)] = true
+	}
+	for _, word := range append(blockedWords, c.GetBlocked()...) {
+		uc.blocked = append(uc.blocked, strings.ToLower(word))
+	}
+	if uc.changeInterval <= 0 {
+		uc.changeInterval = defaultHandleChangeInterval
+	}
+	if uc.holdPeriod <= 0 {
+		uc.holdPeriod = defaultHandleHoldPeriod
+	}
+	return uc
+}
+
+// Check 检查 handle 对该用户是否可用, 可用时返回空字符串, 否则返回不可用的原因
+func (uc *HandleCase) Check(ctx context.Context, userID int64, handle string) (string, error) {
+	if !handlePattern.MatchString(handle) {
+		return HandleInvalid, nil
+	}
+	lower := strings.ToLower(handle)
+	if uc.isReserved(lower) {
+		return HandleReserved, nil
+	}
+
+	owner, err := uc.repo.FindUser(ctx, lower)
+	if err == nil {
+		// 自己的 handle 只修改大小写
+		if owner.UserID == userID {
+			return "", nil
+		}
+		return HandleTaken, nil
+	}
+	if !errors.Is(err, ErrUserNotFound) {
+		return "", err
+	}
+
+	// 旧 handle 在保留期内只有原用户可以取回
+	history, err := uc.repo.FindLatestHistory(ctx, lower)
+	if err != nil {
+		return "", err
+	}
+	if history != nil && history.UserID != userID && history.HeldUntil.After(time.Now()) {
+		return HandleHeld, nil
+	}
+	return "", nil
+}
+
+// Change 修改用户的 handle, 受修改间隔限制, 第一次设置不受限制
+func (uc *HandleCase) Change(ctx context.Context, userID int64, handle string) (*User, error) {
+	uc.log.WithContext(ctx).Infof("ChangeHandle: %v %v", userID, handle)
+	u, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if u.Handle == handle {
+		return u, nil
+	}
+	if u.Handle != "" && u.HandleChangedAt != nil {
+		if next := u.HandleChangedAt.Add(uc.changeInterval); time.Now().Before(next) {
+			return nil, ErrHandleChangeTooSoon.WithMetadata(map[string]string{"next_change_at": next.UTC().Format(time.RFC3339)})
+		}
+	}
+
+	reason, err := uc.Check(ctx, userID, handle)
+	if err != nil {
+		return nil, err
+	}
+	switch reason {
+	case "":
+	case HandleInvalid:
+		return nil, ErrHandleInvalid
+	default:
+		return nil, ErrHandleUnavailable.WithMetadata(map[string]string{"reason": reason})
+	}
+
+	return uc.repo.Change(ctx, userID, handle, time.Now().Add(uc.holdPeriod))
+}
+
+// Resolve 按 handle 查找用户, 旧 handle 返回改名后的用户, 由调用方跳转到新 handle
+func (uc *HandleCase) Resolve(ctx context.Context, handle string) (*User, error) {
+	lower := strings.ToLower(handle)
+	u, err := uc.repo.FindUser(ctx, lower)
+	if err == nil {
+		// 待注销或已合并账号的 handle 仍被占用, 但不再展示
+		if u.DeletionScheduledAt != nil || u.MergedInto != 0 {
+			return nil, ErrUserNotFound
+		}
+		return u, nil
+	}
+	if !errors.Is(err, ErrUserNotFound) {
+		return nil, err
+	}
+
+	history, err := uc.repo.FindLatestHistory(ctx, lower)
+	if err != nil {
+		return nil, err
+	}
+	if history == nil {
+		return nil, ErrUserNotFound
+	}
+	u, err = uc.userRepo.FindByID(ctx, history.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if u.MergedInto != 0 || u.Handle == "" || u.DeletionScheduledAt != nil {
+		return nil, ErrUserNotFound
+	}
+	return u, nil
+}
+
+func (uc *HandleCase) isReserved(lower string) bool {
+	if uc.reserved[lower] {
+		return true
+	}
+	compact := strings.ReplaceAll(lower, "_", "")
+	for _, word := range uc.blocked {
+		if strings.Contains(compact, word) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"user-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeUserRepo 只实现测试用到的查询, 其他方法调用时 panic
+type fakeUserRepo struct {
+	UserRepo
+	users map[int64]*User
+}
+
+func (r *fakeUserRepo) FindByID(ctx context.Context, userID int64) (*User, error) {
+	u, ok := r.users[userID]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	copied := *u
+	return &copied, nil
+}
+
+// fakeHandleRepo 在内存中保存 handle 和历史, 与数据库的唯一约束一致
+type fakeHandleRepo struct {
+	users   map[int64]*User
+	history []*HandleHistory
+}
+
+func (r *fakeHandleRepo) FindUser(ctx context.Context, handleLower string) (*User, error) {
+	for _, u := range r.users {
+		if strings.ToLower(u.Handle) == handleLower {
+			copied := *u
+			return &copied, nil
+		}
+	}
+	return nil, ErrUserNotFound
+}
+
+func (r *fakeHandleRepo) FindLatestHistory(ctx context.Context, handleLower string) (*HandleHistory, error) {
+	for i := len(r.history) - 1; i >= 0; i-- {
+		if strings.ToLower(r.history[i].Handle) == handleLower {
+			return r.history[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeHandleRepo) Change(ctx context.Context, userID int64, handle string, heldUntil time.Time) (*User, error) {
+	lower := strings.ToLower(handle)
+	for _, u := range r.users {
+		if u.UserID != userID && strings.ToLower(u.Handle) == lower {
+			return nil, ErrHandleUnavailable
+		}
+	}
+	u := r.users[userID]
+	now := time.Now()
+	if u.Handle != "" && strings.ToLower(u.Handle) != lower {
+		r.history = append(r.history, &HandleHistory{UserID: userID, Handle: u.Handle, HeldUntil: heldUntil, CreatedAt: now})
+	}
+	u.Handle, u.HandleChangedAt = handle, &now
+	copied := *u
+	return &copied, nil
+}
+
+func newTestHandleCase() (*HandleCase, *fakeHandleRepo) {
+	users := map[int64]*User{
+		1: {UserID: 1},
+		2: {UserID: 2},
+	}
+	repo := &fakeHandleRepo{users: users}
+	return NewHandleCase(&conf.User{}, repo, &fakeUserRepo{users: users}, log.NewStdLogger(io.Discard)), repo
+}
+
+func TestHandleChangeRateLimit(t *testing.T) {
+	uc, repo := newTestHandleCase()
+	ctx := context.Background()
+
+	// 第一次设置不受限制
+	if _, err := uc.Change(ctx, 1, "Alice"); err != nil {
+		t.Fatalf("error setting handle, %s", err)
+	}
+	// 只修改大小写同样受修改间隔限制
+	_, err := uc.Change(ctx, 1, "alice")
+	if !errors.Is(err, ErrHandleChangeTooSoon) {
+		t.Fatalf("expected ErrHandleChangeTooSoon, got %v", err)
+	}
+	next, parseErr := time.Parse(time.RFC3339, errors.FromError(err).Metadata["next_change_at"])
+	if parseErr != nil || next.Before(time.Now().Add(defaultHandleChangeInterval-time.Minute)) {
+		t.Fatalf("expected next_change_at after the change interval, got %v %v", next, parseErr)
+	}
+
+	// 间隔过后可以修改
+	changedAt := time.Now().Add(-defaultHandleChangeInterval)
+	repo.users[1].HandleChangedAt = &changedAt
+	if _, err = uc.Change(ctx, 1, "alice_new"); err != nil {
+		t.Fatalf("expected change after the interval, got %v", err)
+	}
+}
+
+func TestHandleHold(t *testing.T) {
+	uc, repo := newTestHandleCase()
+	ctx := context.Background()
+
+	if _, err := uc.Change(ctx, 1, "Alice"); err != nil {
+		t.Fatalf("error setting handle, %s", err)
+	}
+	changedAt := time.Now().Add(-defaultHandleChangeInterval)
+	repo.users[1].HandleChangedAt = &changedAt
+	if _, err := uc.Change(ctx, 1, "alice_new"); err != nil {
+		t.Fatalf("error changing handle, %s", err)
+	}
+
+	// 保留期内其他用户不能使用, 旧 handle 跳转到改名后的用户
+	reason, err := uc.Check(ctx, 2, "ALICE")
+	if err != nil || reason != HandleHeld {
+		t.Fatalf("expected %q, got %q %v", HandleHeld, reason, err)
+	}
+	_, err = uc.Change(ctx, 2, "alice")
+	if !errors.Is(err, ErrHandleUnavailable) || errors.FromError(err).Metadata["reason"] != HandleHeld {
+		t.Fatalf("expected held ErrHandleUnavailable, got %v", err)
+	}
+	u, err := uc.Resolve(ctx, "alice")
+	if err != nil || u.UserID != 1 || u.Handle != "alice_new" {
+		t.Fatalf("expected alice to resolve to user 1, got %+v %v", u, err)
+	}
+	// 原用户可以取回
+	if reason, err = uc.Check(ctx, 1, "alice"); err != nil || reason != "" {
+		t.Fatalf("expected alice available to its previous owner, got %q %v", reason, err)
+	}
+
+	// 保留期过后其他用户可以使用
+	repo.history[len(repo.history)-1].HeldUntil = time.Now().Add(-time.Minute)
+	if _, err = uc.Change(ctx, 2, "alice"); err != nil {
+		t.Fatalf("expected alice available after the hold, got %v", err)
+	}
+	if u, err = uc.Resolve(ctx, "alice"); err != nil || u.UserID != 2 {
+		t.Fatalf("expected alice to resolve to user 2, got %+v %v", u, err)
+	}
+}
@@ -0,0 +1,81 @@
+package biz
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "user-service/api/auth/v1"
+	"user-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// ErrInvalidPhone 手机号格式错误
+var ErrInvalidPhone = errors.BadRequest(v1.ErrorReason_INVALID_PHONE.String(), "invalid phone number")
+
+// PhoneNormalizer 把手机号规范化为 E.164 格式 (如 +8613800138000), 保存和查找前都需要规范化,
+// 否则 "+86 138..." 和 "138..." 会被当成不同的用户
+type PhoneNormalizer struct {
+	// callingCode 没有国际区号的号码使用的区号, 为空时只接受带区号的号码
+	callingCode string
+}
+
+// NewPhoneNormalizer 按配置的默认区号创建, 区号为 1 到 3 位数字且不以 0 开头
+func NewPhoneNormalizer(cfg *conf.User) (*PhoneNormalizer, error) {
+	code := strings.TrimPrefix(cfg.GetPhone().GetDefaultCallingCode(), "+")
+	if code != "" {
+		if len(code) > 3 || code[0] == '0' || strings.Trim(code, "0123456789") != "" {
+			return nil, fmt.Errorf("invalid phone default_calling_code %q", code)
+		}
+	}
+	return &PhoneNormalizer{callingCode: code}, nil
+}
+
+// Normalize 规范化手机号, 格式错误时返回 ErrInvalidPhone
+func (n *PhoneNormalizer) Normalize(phone string) (string, error) {
+	// 去掉常见的分隔符
+	phone = strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '(', ')', '.':
+			return -1
+		}
+		return r
+	}, phone)
+
+	var digits string
+	switch {
+	case strings.HasPrefix(phone, "+"):
+		digits = phone[1:]
+	case strings.HasPrefix(phone, "00"):
+		digits = phone[2:]
+	case n.callingCode != "":
+		// 本地号码去掉长途前缀 0 后加上默认区号
+		digits = n.callingCode + strings.TrimPrefix(phone, "0")
+	default:
+		return "", ErrInvalidPhone
+	}
+
+	// E.164 最长 15 位, 区号不以 0 开头
+	if len(digits) < 8 || len(digits) > 15 || digits[0] == '0' {
+		return "", ErrInvalidPhone
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return "", ErrInvalidPhone
+		}
+	}
+	return "+" + digits, nil
+}
+
+// normalizeUser 规范化用户的手机号, 未设置手机号时不处理
+func (n *PhoneNormalizer) normalizeUser(u *User) error {
+	if u.Phone == "" {
+		return nil
+	}
+	phone, err := n.Normalize(u.Phone)
+	if err != nil {
+		return err
+	}
+	u.Phone = phone
+	return nil
+}
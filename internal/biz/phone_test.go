@@ -0,0 +1,56 @@
+package biz
+
+import (
+	"testing"
+
+	"user-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func newPhoneNormalizer(t *testing.T, callingCode string) *PhoneNormalizer {
+	t.Helper()
+	n, err := NewPhoneNormalizer(&conf.User{Phone: &conf.User_Phone{DefaultCallingCode: callingCode}})
+	if err != nil {
+		t.Fatalf("error creating phone normalizer, %s", err)
+	}
+	return n
+}
+
+func TestPhoneNormalize(t *testing.T) {
+	for _, tt := range []struct {
+		callingCode string
+		phone       string
+		want        string
+	}{
+		{"86", "13800138000", "+8613800138000"},
+		{"86", "+86 138-0013-8000", "+8613800138000"},
+		{"86", "008613800138000", "+8613800138000"},
+		{"+44", "07911 123456", "+447911123456"},
+		{"", "+1 (415) 555-0100", "+14155550100"},
+	} {
+		got, err := newPhoneNormalizer(t, tt.callingCode).Normalize(tt.phone)
+		if err != nil {
+			t.Errorf("Normalize(%q) with %q: %v", tt.phone, tt.callingCode, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Normalize(%q) with %q = %q, want %q", tt.phone, tt.callingCode, got, tt.want)
+		}
+	}
+
+	// 未配置默认区号时本地号码无法确定国家
+	for _, phone := range []string{"13800138000", "+0123456789", "+86abc"} {
+		if _, err := newPhoneNormalizer(t, "").Normalize(phone); !errors.Is(err, ErrInvalidPhone) {
+			t.Errorf("Normalize(%q) expected ErrInvalidPhone, got %v", phone, err)
+		}
+	}
+}
+
+func TestNewPhoneNormalizerInvalidCode(t *testing.T) {
+	for _, code := range []string{"0", "1234", "8a"} {
+		if _, err := NewPhoneNormalizer(&conf.User{Phone: &conf.User_Phone{DefaultCallingCode: code}}); err == nil {
+			t.Errorf("expected error for calling code %q", code)
+		}
+	}
+}
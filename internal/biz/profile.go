@@ -0,0 +1,220 @@
+package biz
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	v1 "user-service/api/user/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+
+	// 内置时区数据, 容器中没有 zoneinfo 时也能校验时区
+	_ "time/tzdata"
+)
+
+const (
+	// maxNameLength 昵称最大字符数, 与表结构一致
+	maxNameLength = 100
+	// maxBioLength 简介最大字符数
+	maxBioLength = 500
+	// maxMetadataKeys metadata 最多的键数量
+	maxMetadataKeys = 20
+	// maxMetadataValueLength metadata 值的最大字符数
+	maxMetadataValueLength = 512
+	// birthdayLayout 生日格式
+	birthdayLayout = "2006-01-02"
+)
+
+// Genders 可选的性别, 空表示未设置
+var Genders = map[string]bool{"": true, "male": true, "female": true, "other": true}
+
+var (
+	localePattern      = regexp.MustCompile(`^[A-Za-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+	countryPattern     = regexp.MustCompile(`^[A-Z]{2}$`)
+	metadataKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]{1,64}$`)
+	minBirthday        = time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+var (
+	// ErrInvalidFieldMask update_mask 为空或包含不可更新的字段
+	ErrInvalidFieldMask = errors.BadRequest(v1.ErrorReason_INVALID_FIELD_MASK.String(), "invalid update mask")
+	// ErrInvalidProfile 资料字段不符合校验规则
+	ErrInvalidProfile = errors.BadRequest(v1.ErrorReason_INVALID_PROFILE.String(), "invalid profile")
+	// ErrTooManyUsers 批量查询的 user_id 超过 maxBatchGetUsers
+	ErrTooManyUsers = errors.BadRequest(v1.ErrorReason_TOO_MANY_USERS.String(), "too many user ids")
+)
+
+// maxBatchGetUsers 批量查询公开资料的最大数量
+const maxBatchGetUsers = 500
+
+// ProfileUpdate 资料的部分更新, 字段为 nil 时保持原值
+// Birthday 为零值、Metadata 为空 map 时清空对应字段
+type ProfileUpdate struct {
+	Name     *string
+	Birthday *time.Time
+	Gender   *string
+	Locale   *string
+	Timezone *string
+	Country  *string
+	Bio      *string
+	Metadata map[string]string
+}
+
+// GetProfile 查询用户资料, 已合并的账号视为不存在
+func (uc *UserCase) GetProfile(ctx context.Context, userID int64) (*User, error) {
+	uc.log.WithContext(ctx).Infof("GetProfile: %v", userID)
+	u, err := uc.repo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	// 已合并和待注销的账号不再展示
+	if u.MergedInto != 0 || u.DeletionScheduledAt != nil {
+		return nil, ErrUserNotFound
+	}
+	return u, nil
+}
+
+// BatchGetProfiles 批量查询公开资料, 返回的用户只包含公开字段;
+// 不存在、已合并和待注销的 user_id 按请求顺序放入 missing
+func (uc *UserCase) BatchGetProfiles(ctx context.Context, userIDs []int64) (map[int64]*User, []int64, error) {
+	seen := make(map[int64]bool, len(userIDs))
+	ids := make([]int64, 0, len(userIDs))
+	for _, id := range userIDs {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) > maxBatchGetUsers {
+		return nil, nil, ErrTooManyUsers.WithMetadata(map[string]string{"max": strconv.Itoa(maxBatchGetUsers)})
+	}
+	uc.log.WithContext(ctx).Infof("BatchGetProfiles: %d users", len(ids))
+
+	users, err := uc.repo.FindPublicByIDs(ctx, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	missing := make([]int64, 0)
+	for _, id := range ids {
+		if _, ok := users[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	return users, missing, nil
+}
+
+// UpdateProfile 校验并更新用户资料, 只修改 ProfileUpdate 中设置的字段
+func (uc *UserCase) UpdateProfile(ctx context.Context, userID int64, p *ProfileUpdate) (*User, error) {
+	uc.log.WithContext(ctx).Infof("UpdateProfile: %v", userID)
+	if err := validateProfile(p); err != nil {
+		return nil, err
+	}
+	return uc.repo.UpdateProfile(ctx, userID, p)
+}
+
+// ParseBirthday 解析 YYYY-MM-DD 格式的生日, 空字符串返回零值表示清空
+func ParseBirthday(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	// 按本地时区解析, 与数据库连接的 loc 一致, 写入 date 列时不会跨天
+	t, err := time.ParseInLocation(birthdayLayout, s, time.Local)
+	if err != nil {
+		return time.Time{}, invalidProfile("birthday", "invalid format")
+	}
+	return t, nil
+}
+
+// FormatBirthday 格式化生日, 未设置时返回空字符串
+func FormatBirthday(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.Format(birthdayLayout)
+}
+
+// validateProfile 校验并规范化要更新的字段
+func validateProfile(p *ProfileUpdate) error {
+	if p.Name != nil {
+		name := strings.TrimSpace(*p.Name)
+		if err := validateName(name); err != nil {
+			return err
+		}
+		p.Name = &name
+	}
+	if p.Birthday != nil && !p.Birthday.IsZero() {
+		if p.Birthday.Before(minBirthday) || p.Birthday.After(time.Now()) {
+			return invalidProfile("birthday", "out of range")
+		}
+	}
+	if p.Gender != nil && !Genders[*p.Gender] {
+		return invalidProfile("gender", "unknown value")
+	}
+	if p.Locale != nil && *p.Locale != "" && !localePattern.MatchString(*p.Locale) {
+		return invalidProfile("locale", "invalid format")
+	}
+	if p.Timezone != nil && *p.Timezone != "" {
+		// LoadLocation 接受 Local, 这里只允许 IANA 名称
+		if _, err := time.LoadLocation(*p.Timezone); err != nil || *p.Timezone == "Local" {
+			return invalidProfile("timezone", "unknown timezone")
+		}
+	}
+	if p.Country != nil {
+		country := strings.ToUpper(*p.Country)
+		if country != "" && !countryPattern.MatchString(country) {
+			return invalidProfile("country", "invalid format")
+		}
+		p.Country = &country
+	}
+	if p.Bio != nil {
+		bio := strings.TrimSpace(*p.Bio)
+		if utf8.RuneCountInString(bio) > maxBioLength {
+			return invalidProfile("bio", "too long")
+		}
+		for _, r := range bio {
+			if unicode.IsControl(r) && r != '\n' {
+				return invalidProfile("bio", "invalid character")
+			}
+		}
+		p.Bio = &bio
+	}
+	if p.Metadata != nil {
+		if len(p.Metadata) > maxMetadataKeys {
+			return invalidProfile("metadata", "too many keys")
+		}
+		for k, v := range p.Metadata {
+			if !metadataKeyPattern.MatchString(k) {
+				return invalidProfile("metadata", "invalid key")
+			}
+			if utf8.RuneCountInString(v) > maxMetadataValueLength {
+				return invalidProfile("metadata", "value too long")
+			}
+		}
+	}
+	return nil
+}
+
+func invalidProfile(field, reason string) error {
+	return ErrInvalidProfile.WithMetadata(map[string]string{"field": field, "reason": reason})
+}
+
+// validateName 昵称不能为空, 不能超长, 不能包含控制字符
+func validateName(name string) error {
+	if name == "" {
+		return invalidProfile("name", "empty")
+	}
+	if utf8.RuneCountInString(name) > maxNameLength {
+		return invalidProfile("name", "too long")
+	}
+	for _, r := range name {
+		if unicode.IsControl(r) {
+			return invalidProfile("name", "invalid character")
+		}
+	}
+	return nil
+}
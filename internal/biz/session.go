@@ -0,0 +1,153 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"user-service/internal/conf"
+	"user-service/third_party/jwt"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Session 登录会话, 每次登录签发的 token 对应一条
+type Session struct {
+	UserID    int64
+	SessionID string
+	Provider  string
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+// SessionRepo 定义会话仓储接口
+type SessionRepo interface {
+	// Create 创建会话
+	Create(ctx context.Context, s *Session) error
+	// FindBySessionID 根据会话ID查找
+	FindBySessionID(ctx context.Context, sessionID string) (*Session, error)
+	// ListByUser 列出用户的会话, 最近的在前
+	ListByUser(ctx context.Context, userID int64) ([]*Session, error)
+	// RevokeByUser 撤销用户的全部会话
+	RevokeByUser(ctx context.Context, userID int64) error
+	// RevokeByProvider 撤销用户通过某种登录方式创建的会话
+	RevokeByProvider(ctx context.Context, userID int64, provider string) error
+}
+
+// SessionCase 签发和校验登录 token
+type SessionCase struct {
+	repo         SessionRepo
+	userRepo     UserRepo
+	deletionRepo DeletionRepo
+	jwtGen       *jwt.Generator
+	log          *log.Helper
+}
+
+// NewSessionCase 创建会话实例
+func NewSessionCase(repo SessionRepo, userRepo UserRepo, deletionRepo DeletionRepo, cfg *conf.Jwt, logger log.Logger) *SessionCase {
+	return &SessionCase{
+		repo:         repo,
+		userRepo:     userRepo,
+		deletionRepo: deletionRepo,
+		jwtGen:       jwt.NewGenerator(cfg.Secret, int(cfg.Expires)),
+		log:          log.NewHelper(logger),
+	}
+}
+
+// Issue 为用户签发登录 token 并记录会话, 所有登录方式都经过这里
+// 停用的账号不能登录; 冷静期内重新登录会取消注销
+func (uc *SessionCase) Issue(ctx context.Context, userID int64, provider string) (string, error) {
+	uc.log.WithContext(ctx).Infof("Issue: %v %v", userID, provider)
+	u, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if err = u.CheckActive(); err != nil {
+		uc.log.WithContext(ctx).Infof("Issue: blocked %v %v", userID, u.Status)
+		return "", err
+	}
+
+	cancelled, err := uc.deletionRepo.Cancel(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if cancelled {
+		uc.log.WithContext(ctx).Infof("CancelDeletion: %v", userID)
+	}
+
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	sessionID := hex.EncodeToString(buf)
+
+	token, expiresAt, err := uc.jwtGen.GenerateSessionToken(userID, sessionID)
+	if err != nil {
+		return "", err
+	}
+	err = uc.repo.Create(ctx, &Session{
+		UserID:    userID,
+		SessionID: sessionID,
+		Provider:  provider,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// Verify 校验 token 及其会话, 已合并的账号转到保留的 user_id
+func (uc *SessionCase) Verify(ctx context.Context, token string) (*jwt.Claims, error) {
+	claims, err := uc.jwtGen.ParseToken(token)
+	if err != nil {
+		return nil, ErrUnauthenticated
+	}
+
+	// 旧版 token 没有会话ID, 只校验签名和有效期
+	var provider string
+	if claims.SessionID != "" {
+		s, err := uc.repo.FindBySessionID(ctx, claims.SessionID)
+		if err != nil || s.RevokedAt != nil {
+			return nil, ErrUnauthenticated
+		}
+		// 会话可能随账号合并转移到其他用户
+		claims.UserID = s.UserID
+		provider = s.Provider
+	}
+
+	u, err := uc.userRepo.FindByID(ctx, claims.UserID)
+	if err != nil {
+		return nil, ErrUnauthenticated
+	}
+	// 待注销账号的会话已撤销, 旧版 token 也不再有效
+	if u.DeletionScheduledAt != nil {
+		return nil, ErrUnauthenticated
+	}
+	if err = u.CheckActive(); err != nil {
+		return nil, err
+	}
+	// Apple ID 已注销, 通过它登录的会话失效
+	if provider == "apple" && u.AppleAccountDeleted {
+		return nil, ErrUnauthenticated
+	}
+	if u.MergedInto != 0 {
+		claims.UserID = u.MergedInto
+		target, err := uc.userRepo.FindByID(ctx, u.MergedInto)
+		if err != nil {
+			return nil, ErrUnauthenticated
+		}
+		if err = target.CheckActive(); err != nil {
+			return nil, err
+		}
+	}
+	return claims, nil
+}
+
+// Revoke 撤销用户的全部会话
+func (uc *SessionCase) Revoke(ctx context.Context, userID int64) error {
+	uc.log.WithContext(ctx).Infof("Revoke: %v", userID)
+	return uc.repo.RevokeByUser(ctx, userID)
+}
@@ -2,6 +2,7 @@ package biz
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
@@ -20,6 +21,8 @@ type UserRepo interface {
 	FindByIDOrigin(ctx context.Context, userID int64) (*ent.User, error)
 	// FindByID 根据ID查找用户
 	FindByID(ctx context.Context, userID int64) (*User, error)
+	// FindPublicByIDs 批量查询公开资料, 已合并和待注销的账号不返回
+	FindPublicByIDs(ctx context.Context, userIDs []int64) (map[int64]*User, error)
 	// FindByPhone 根据手机号查找用户
 	FindByPhone(ctx context.Context, phone string) (*User, error)
 	// FindByEmail 根据邮箱查找用户
@@ -28,26 +31,50 @@ type UserRepo interface {
 	Create(ctx context.Context, u *User) (*User, error)
 	// Update 更新用户
 	Update(ctx context.Context, u *User) (*User, error)
+	// UpdateProfile 只更新 ProfileUpdate 中设置的字段
+	UpdateProfile(ctx context.Context, userID int64, p *ProfileUpdate) (*User, error)
+	// UpdateAvatar 只更新用户头像
+	UpdateAvatar(ctx context.Context, userID int64, avatar string) error
+	// UpdateStatus 修改账号状态
+	UpdateStatus(ctx context.Context, userID int64, s *StatusUpdate) (*User, error)
+	// Search 按条件搜索用户, 按 f.OrderBy 排序后返回 f.After 之后的最多 f.Limit 个
+	Search(ctx context.Context, f *UserFilter) ([]*User, error)
+	// SetAppleAccountDeleted 标记或清除用户的 Apple ID 已注销
+	SetAppleAccountDeleted(ctx context.Context, userID int64, deleted bool) error
+	// Merge 把 from 用户的登录方式、会话和缺失的资料转移到 to 用户, from 标记为已合并
+	Merge(ctx context.Context, fromUserID, toUserID int64) error
+	// DeleteInactiveGuests 删除 inactiveSince 之后没有登录过的游客账号, 最多 limit 个
+	DeleteInactiveGuests(ctx context.Context, inactiveSince time.Time, limit int) (int, error)
 	// FindOrCreate 查找或创建用户
 	FindOrCreate(ctx context.Context, u *User) (*User, error)
 	// FindOrCreateByPhone 根据Phone 查找或创建用户
 	FindOrCreateByPhone(ctx context.Context, phone string) (*User, bool, error)
+	// FindOrCreateByProvider 查找登录方式关联的用户, 不存在时在同一个事务中创建用户和关联;
+	// 邮箱已被其他用户占用时新用户不保存邮箱
+	FindOrCreateByProvider(ctx context.Context, login *ProviderLogin, u *User) (*User, bool, error)
 }
 
 // UserCase 用户实例的使用
 type UserCase struct {
-	repo UserRepo
-	log  *log.Helper
+	repo   UserRepo
+	phones *PhoneNormalizer
+	log    *log.Helper
 }
 
 // NewUserCase 创建新的用户实例
-func NewUserCase(repo UserRepo, logger log.Logger) *UserCase {
+func NewUserCase(repo UserRepo, phones *PhoneNormalizer, logger log.Logger) *UserCase {
 	return &UserCase{
-		repo: repo,
-		log:  log.NewHelper(logger),
+		repo:   repo,
+		phones: phones,
+		log:    log.NewHelper(logger),
 	}
 }
 
+// NormalizePhone 按配置的默认区号规范化手机号, 用于发送和校验验证码
+func (uc *UserCase) NormalizePhone(phone string) (string, error) {
+	return uc.phones.Normalize(phone)
+}
+
 // FindByID find user by id
 func (uc *UserCase) FindByID(ctx context.Context, userID int64) (*User, error) {
 	uc.log.WithContext(ctx).Infof("FindByID: %v", userID)
@@ -57,6 +84,10 @@ func (uc *UserCase) FindByID(ctx context.Context, userID int64) (*User, error) {
 // FindByPhone 根据手机号查找用户
 func (uc *UserCase) FindByPhone(ctx context.Context, phone string) (*User, error) {
 	uc.log.WithContext(ctx).Infof("FindByPhone: %v", phone)
+	phone, err := uc.phones.Normalize(phone)
+	if err != nil {
+		return nil, err
+	}
 	return uc.repo.FindByPhone(ctx, phone)
 }
 
@@ -69,23 +100,36 @@ func (uc *UserCase) FindByEmail(ctx context.Context, email string) (*User, error
 // Create 创建用户
 func (uc *UserCase) Create(ctx context.Context, u *User) (*User, error) {
 	uc.log.WithContext(ctx).Infof("Create: %v", u)
+	if err := uc.phones.normalizeUser(u); err != nil {
+		return nil, err
+	}
 	return uc.repo.Create(ctx, u)
 }
 
 // Update 更新用户
 func (uc *UserCase) Update(ctx context.Context, u *User) (*User, error) {
 	uc.log.WithContext(ctx).Infof("Update: %v", u)
+	if err := uc.phones.normalizeUser(u); err != nil {
+		return nil, err
+	}
 	return uc.repo.Update(ctx, u)
 }
 
 // FindOrCreate 查找或创建用户
 func (uc *UserCase) FindOrCreate(ctx context.Context, u *User) (*User, error) {
 	uc.log.WithContext(ctx).Infof("FindOrCreate: %v", u)
+	if err := uc.phones.normalizeUser(u); err != nil {
+		return nil, err
+	}
 	return uc.repo.FindOrCreate(ctx, u)
 }
 
 // FindOrCreateByPhone 根据Phone 查找或创建用户
 func (uc *UserCase) FindOrCreateByPhone(ctx context.Context, phone string) (*User, bool, error) {
 	uc.log.WithContext(ctx).Infof("FindOrCreateByPhone: %v", phone)
+	phone, err := uc.phones.Normalize(phone)
+	if err != nil {
+		return nil, false, err
+	}
 	return uc.repo.FindOrCreateByPhone(ctx, phone)
 }
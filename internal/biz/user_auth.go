@@ -3,22 +3,52 @@ package biz
 import (
 	"context"
 
+	"user-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
+// 第三方登录邮箱与已有用户相同时的处理策略
+const (
+	LinkingPolicyAuto     = "auto"
+	LinkingPolicyPrompt   = "prompt"
+	LinkingPolicySeparate = "separate"
+)
+
+// ProviderLogin 第三方登录的身份, 新建用户时和用户在同一个事务中创建关联
+type ProviderLogin struct {
+	ProviderType string
+	ProviderID   string
+	// Wechat 微信登录时同时记录应用下的 openid, 其他登录方式为 nil
+	Wechat *WechatIdentity
+}
+
 // UserAuthCase 用户关联授权登陆实例的使用
 type UserAuthCase struct {
-	userRepo UserRepo
-	authRepo AuthProviderRepo
-	log      *log.Helper
+	userRepo      UserRepo
+	authRepo      AuthProviderRepo
+	wechatRepo    WechatRepo
+	avatarCase    *AvatarCase
+	phones        *PhoneNormalizer
+	linkingPolicy string
+	log           *log.Helper
 }
 
 // NewUserAuthCase 创建新的用户关联授权登陆实例
-func NewUserAuthCase(userRepo UserRepo, authRepo AuthProviderRepo, logger log.Logger) *UserAuthCase {
+func NewUserAuthCase(userRepo UserRepo, authRepo AuthProviderRepo, wechatRepo WechatRepo, avatarCase *AvatarCase, phones *PhoneNormalizer, authCfg *conf.Auth, logger log.Logger) *UserAuthCase {
+	policy := authCfg.GetLinking().GetPolicy()
+	if policy != LinkingPolicyAuto && policy != LinkingPolicyPrompt {
+		policy = LinkingPolicySeparate
+	}
 	return &UserAuthCase{
-		userRepo: userRepo,
-		authRepo: authRepo,
-		log:      log.NewHelper(logger),
+		userRepo:      userRepo,
+		authRepo:      authRepo,
+		wechatRepo:    wechatRepo,
+		avatarCase:    avatarCase,
+		phones:        phones,
+		linkingPolicy: policy,
+		log:           log.NewHelper(logger),
 	}
 }
 
@@ -35,103 +65,218 @@ func (uc *UserAuthCase) LinkAuthProvider(ctx context.Context, userID int64, prov
 	return uc.authRepo.Create(ctx, providerType, providerID, userInfo)
 }
 
-// FindOrCreateByGoogleID 根据Google ID查找或创建用户
-func (uc *UserAuthCase) FindOrCreateByGoogleID(ctx context.Context, googleID, name, email string) (*User, bool, error) {
-	uc.log.WithContext(ctx).Infof("FindOrCreateByGoogleID: %v %v %v", googleID, name, email)
-	found, err := uc.authRepo.FindByGoogleID(ctx, googleID)
-	if err == nil {
-		return found, false, nil
+// linkOrFind 为已有用户关联登录方式, 并发登录已先关联时返回已关联的用户
+func (uc *UserAuthCase) linkOrFind(ctx context.Context, u *User, providerType, providerID string) (*User, error) {
+	err := uc.LinkAuthProvider(ctx, u.UserID, providerType, providerID)
+	if errors.Is(err, ErrProviderAlreadyLinked) {
+		return uc.authRepo.FindByProvider(ctx, providerType, providerID)
 	}
-
-	u := &User{Name: name, Email: email}
-	createdUser, err := uc.userRepo.Create(ctx, u)
 	if err != nil {
-		return nil, true, err
+		return nil, err
 	}
+	return u, nil
+}
 
-	err = uc.LinkAuthProvider(ctx, createdUser.UserID, "google", googleID)
-	if err != nil {
-		return nil, true, err
-	}
+// SaveRefreshToken 保存第三方登录返回的 refresh_token
+func (uc *UserAuthCase) SaveRefreshToken(ctx context.Context, providerType, providerID, refreshToken string) error {
+	uc.log.WithContext(ctx).Infof("SaveRefreshToken: %v %v", providerType, providerID)
+	return uc.authRepo.UpdateRefreshToken(ctx, providerType, providerID, refreshToken)
+}
 
-	return createdUser, true, nil
+// FindOrCreateByGoogleID 根据Google ID查找或创建用户
+func (uc *UserAuthCase) FindOrCreateByGoogleID(ctx context.Context, googleID, name, email string) (*User, bool, error) {
+	uc.log.WithContext(ctx).Infof("FindOrCreateByGoogleID: %v %v %v", googleID, name, email)
+	// Google 登录前已校验 email_verified
+	return uc.FindOrCreateByIdentity(ctx, &Identity{
+		Provider:      "google",
+		Subject:       googleID,
+		Email:         email,
+		EmailVerified: email != "",
+		Name:          name,
+	})
 }
 
 // FindOrCreateByAppleID 根据Apple ID查找或创建用户
 func (uc *UserAuthCase) FindOrCreateByAppleID(ctx context.Context, appleID string, email string) (*User, bool, error) {
 	uc.log.WithContext(ctx).Infof("FindOrCreateByAppleID: %v %v", appleID, email)
-	// 尝试根据Apple ID查找用户
-	found, err := uc.authRepo.FindByAppleID(ctx, appleID)
+	// Apple 返回的邮箱(含中转邮箱)均已验证
+	return uc.FindOrCreateByIdentity(ctx, &Identity{
+		Provider:      "apple",
+		Subject:       appleID,
+		Email:         email,
+		EmailVerified: email != "",
+	})
+}
+
+// FindOrCreateByFacebookID 根据Facebook ID查找或创建用户
+func (uc *UserAuthCase) FindOrCreateByFacebookID(ctx context.Context, facebookID, name, email string) (*User, bool, error) {
+	uc.log.WithContext(ctx).Infof("FindOrCreateByFacebookID: %v %v %v", facebookID, name, email)
+	// Graph API 不保证邮箱已确认
+	return uc.FindOrCreateByIdentity(ctx, &Identity{
+		Provider: "facebook",
+		Subject:  facebookID,
+		Email:    email,
+		Name:     name,
+	})
+}
+
+// FindOrCreateBySnapchatID 根据snap ID查找或创建用户
+func (uc *UserAuthCase) FindOrCreateBySnapchatID(ctx context.Context, snapchatID, name string) (*User, bool, error) {
+	uc.log.WithContext(ctx).Infof("FindOrCreateBySnapchatID: %v %v", snapchatID, name)
+	return uc.FindOrCreateByIdentity(ctx, &Identity{
+		Provider: "snapchat",
+		Subject:  snapchatID,
+		Name:     name,
+	})
+}
+
+// FindOrCreateByIdentity 根据认证器返回的身份查找或创建用户
+func (uc *UserAuthCase) FindOrCreateByIdentity(ctx context.Context, identity *Identity) (*User, bool, error) {
+	uc.log.WithContext(ctx).Infof("FindOrCreateByIdentity: %v %v", identity.Provider, identity.Subject)
+	found, err := uc.authRepo.FindByProvider(ctx, identity.Provider, identity.Subject)
 	if err == nil {
 		// 用户已存在，返回找到的用户
+		uc.saveIdentity(ctx, found, identity)
 		return found, false, nil
 	}
+	if !errors.Is(err, ErrUserNotFound) {
+		return nil, false, err
+	}
 
-	// 如果用户不存在，创建新用户
-	u := &User{Email: email}
-
-	createdUser, err := uc.userRepo.Create(ctx, u)
+	// 按邮箱匹配已有用户
+	found, err = uc.matchByEmail(ctx, identity)
 	if err != nil {
-		return nil, true, err
+		return nil, false, err
+	}
+	if found != nil {
+		uc.saveIdentity(ctx, found, identity)
+		return found, false, nil
 	}
 
-	// 关联Apple ID和新创建的用户
-	err = uc.LinkAuthProvider(ctx, createdUser.UserID, "apple", appleID)
+	// 如果用户不存在，在同一个事务中创建新用户和关联, 头像转存后再写入
+	u := &User{Name: identity.Name}
+	identity.applyEmail(u)
+	login := &ProviderLogin{ProviderType: identity.Provider, ProviderID: identity.Subject}
+	found, created, err := uc.userRepo.FindOrCreateByProvider(ctx, login, u)
 	if err != nil {
-		return nil, true, err
+		return nil, false, err
 	}
+	uc.saveIdentity(ctx, found, identity)
 
-	return createdUser, true, nil
+	return found, created, nil
 }
 
-// FindOrCreateByFacebookID 根据Facebook ID查找或创建用户
-func (uc *UserAuthCase) FindOrCreateByFacebookID(ctx context.Context, facebookID, name, email string) (*User, bool, error) {
-	uc.log.WithContext(ctx).Infof("FindOrCreateByFacebookID: %v %v %v", facebookID, name, email)
-	// 根据Facebook ID查找用户
-	found, err := uc.authRepo.FindByFacebookID(ctx, facebookID)
-	if err == nil {
-		// 用户已存在，返回找到的用户
-		return found, false, nil
+// applyEmail 新用户只保存已验证的邮箱, 未验证的邮箱只保存在 auth_providers
+func (i *Identity) applyEmail(u *User) {
+	if i.Email != "" && i.EmailVerified {
+		u.Email = i.Email
+		u.EmailVerified = true
 	}
+}
 
-	// 如果用户不存在，创建新用户
-	u := &User{Name: name, Email: email}
-	createdUser, err := uc.userRepo.Create(ctx, u)
-	if err != nil {
-		return nil, true, err
+// saveIdentity 保存第三方身份的资料、原始声明和令牌, 并转存头像, 失败不影响登录
+func (uc *UserAuthCase) saveIdentity(ctx context.Context, u *User, identity *Identity) {
+	if err := uc.authRepo.UpdateIdentity(ctx, identity); err != nil {
+		uc.log.WithContext(ctx).Errorf("failed to save %s identity, error: %v", identity.Provider, err)
 	}
+	// 注销时已解除旧 Apple ID 的关联, 能登录说明关联了新的 Apple ID
+	if identity.Provider == "apple" && u.AppleAccountDeleted {
+		if err := uc.userRepo.SetAppleAccountDeleted(ctx, u.UserID, false); err != nil {
+			uc.log.WithContext(ctx).Errorf("failed to clear apple account deleted, error: %v", err)
+		} else {
+			u.AppleAccountDeleted = false
+		}
+	}
+	uc.avatarCase.Ingest(ctx, u, identity.Avatar)
+}
 
-	// 关联Facebook ID和新创建的用户
-	err = uc.LinkAuthProvider(ctx, createdUser.UserID, "facebook", facebookID)
+// matchByEmail 按关联策略处理邮箱相同的已有用户
+// auto 且双方邮箱均已验证时关联并返回该用户; prompt 时返回 ErrAccountExists; 其他情况返回 nil
+func (uc *UserAuthCase) matchByEmail(ctx context.Context, identity *Identity) (*User, error) {
+	if uc.linkingPolicy == LinkingPolicySeparate || identity.Email == "" || !identity.EmailVerified {
+		return nil, nil
+	}
+	found, err := uc.userRepo.FindByEmail(ctx, identity.Email)
+	if errors.Is(err, ErrUserNotFound) {
+		return nil, nil
+	}
 	if err != nil {
-		return nil, true, err
+		return nil, err
+	}
+	if !found.EmailVerified {
+		return nil, nil
 	}
 
-	return createdUser, true, nil
+	if uc.linkingPolicy == LinkingPolicyPrompt {
+		// 客户端引导用户登录已有账号, 再通过 LinkProvider 关联
+		return nil, ErrAccountExists.WithMetadata(map[string]string{"email": identity.Email})
+	}
+	return uc.linkOrFind(ctx, found, identity.Provider, identity.Subject)
 }
 
-// FindOrCreateBySnapchatID 根据snap ID查找或创建用户
-func (uc *UserAuthCase) FindOrCreateBySnapchatID(ctx context.Context, snapchatID, name string) (*User, bool, error) {
-	uc.log.WithContext(ctx).Infof("FindOrCreateBySnapchatID: %v %v", snapchatID, name)
-	// 根据Facebook ID查找用户
-	found, err := uc.authRepo.FindBySnapchatID(ctx, snapchatID)
+// FindOrCreateByFirebase 根据 Firebase 身份查找或创建用户
+// 依次按 firebase uid、手机号、已验证邮箱匹配已有用户, 匹配到后关联 firebase uid
+func (uc *UserAuthCase) FindOrCreateByFirebase(ctx context.Context, identity *Identity) (*User, bool, error) {
+	uc.log.WithContext(ctx).Infof("FindOrCreateByFirebase: %v %v %v", identity.Subject, identity.Phone, identity.Email)
+	if identity.Phone != "" {
+		phone, err := uc.phones.Normalize(identity.Phone)
+		if err != nil {
+			// 格式错误的手机号不用于匹配和保存
+			uc.log.WithContext(ctx).Warnf("invalid firebase phone number %v", identity.Phone)
+		}
+		identity.Phone = phone
+	}
+	found, err := uc.authRepo.FindByProvider(ctx, "firebase", identity.Subject)
 	if err == nil {
 		// 用户已存在，返回找到的用户
+		uc.saveIdentity(ctx, found, identity)
 		return found, false, nil
 	}
+	if !errors.Is(err, ErrUserNotFound) {
+		return nil, false, err
+	}
 
-	// 如果用户不存在，创建新用户
-	u := &User{Name: name}
-	createdUser, err := uc.userRepo.Create(ctx, u)
-	if err != nil {
-		return nil, true, err
+	// 通过 Firebase 手机号验证注册的用户, 按手机号匹配
+	if identity.Phone != "" {
+		found, err = uc.userRepo.FindByPhone(ctx, identity.Phone)
+		if err == nil {
+			return uc.linkFirebase(ctx, found, identity)
+		}
+		if !errors.Is(err, ErrUserNotFound) {
+			return nil, false, err
+		}
+	}
+
+	// 只信任已验证的邮箱
+	if identity.Email != "" && identity.EmailVerified {
+		found, err = uc.userRepo.FindByEmail(ctx, identity.Email)
+		if err == nil {
+			return uc.linkFirebase(ctx, found, identity)
+		}
+		if !errors.Is(err, ErrUserNotFound) {
+			return nil, false, err
+		}
 	}
 
-	// 关联Facebook ID和新创建的用户
-	err = uc.LinkAuthProvider(ctx, createdUser.UserID, "snapchat", snapchatID)
+	// 如果用户不存在，在同一个事务中创建新用户和关联
+	u := &User{Name: identity.Name, Phone: identity.Phone}
+	identity.applyEmail(u)
+	login := &ProviderLogin{ProviderType: "firebase", ProviderID: identity.Subject}
+	found, created, err := uc.userRepo.FindOrCreateByProvider(ctx, login, u)
 	if err != nil {
-		return nil, true, err
+		return nil, false, err
 	}
+	uc.saveIdentity(ctx, found, identity)
 
-	return createdUser, true, nil
+	return found, created, nil
+}
+
+// linkFirebase 为已有用户关联 firebase uid 并保存身份资料
+func (uc *UserAuthCase) linkFirebase(ctx context.Context, u *User, identity *Identity) (*User, bool, error) {
+	found, err := uc.linkOrFind(ctx, u, "firebase", identity.Subject)
+	if err != nil {
+		return nil, false, err
+	}
+	uc.saveIdentity(ctx, found, identity)
+	return found, false, nil
 }
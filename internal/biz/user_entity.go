@@ -14,6 +14,32 @@ type User struct {
 	Avatar    string    `gorm:"size:255" json:"avatar"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+	// EmailVerified 邮箱是否经第三方验证
+	EmailVerified bool `json:"email_verified"`
+	// MergedInto 账号已合并到该 user_id, 0 表示未合并
+	MergedInto int64 `json:"merged_into"`
+	// AppleAccountDeleted 关联的 Apple ID 已注销, 通过 Apple 登录的会话不再有效
+	AppleAccountDeleted bool `json:"apple_account_deleted"`
+	// Birthday 生日, 只有日期部分有效
+	Birthday *time.Time        `json:"birthday"`
+	Gender   string            `json:"gender"`
+	Locale   string            `json:"locale"`
+	Timezone string            `json:"timezone"`
+	Country  string            `json:"country"`
+	Bio      string            `json:"bio"`
+	Metadata map[string]string `json:"metadata"`
+	// Handle @handle, 空表示未设置
+	Handle          string     `json:"handle"`
+	HandleChangedAt *time.Time `json:"handle_changed_at"`
+	// DeletionScheduledAt 已申请注销, 到期后清除账号; nil 表示正常
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at"`
+	// Status 账号状态, 见 UserActive 等常量
+	Status          string     `json:"status"`
+	StatusReason    string     `json:"status_reason"`
+	StatusChangedBy int64      `json:"status_changed_by"`
+	StatusChangedAt *time.Time `json:"status_changed_at"`
+	// StatusExpiresAt 停用结束的时间, nil 表示永久
+	StatusExpiresAt *time.Time `json:"status_expires_at"`
 }
 
 // AuthProvider 认证提供者
@@ -0,0 +1,111 @@
+package biz
+
+import (
+	"context"
+
+	v1 "user-service/api/auth/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+var (
+	// ErrProviderAlreadyLinked 第三方账号已关联其他用户, 或当前用户已关联同类账号
+	ErrProviderAlreadyLinked = errors.Conflict(v1.ErrorReason_PROVIDER_ALREADY_LINKED.String(), "provider already linked")
+	// ErrLastLoginMethod 不能解除最后一个登录方式
+	ErrLastLoginMethod = errors.BadRequest(v1.ErrorReason_LAST_LOGIN_METHOD.String(), "cannot unlink the last login method")
+	// ErrProviderNotLinked 当前用户未关联该登录方式
+	ErrProviderNotLinked = errors.NotFound(v1.ErrorReason_PROVIDER_NOT_LINKED.String(), "provider not linked")
+	// ErrAccountExists 已有用户使用相同的已验证邮箱, 需要用户确认关联
+	ErrAccountExists = errors.Conflict(v1.ErrorReason_ACCOUNT_EXISTS.String(), "an account with this email already exists")
+	// ErrMergeNotAllowed 不能合并同一个账号、已合并的账号或关联了同类登录方式的账号
+	ErrMergeNotAllowed = errors.BadRequest(v1.ErrorReason_MERGE_NOT_ALLOWED.String(), "accounts cannot be merged")
+)
+
+// ListLinkedProviders 列出用户关联的登录方式
+func (uc *UserAuthCase) ListLinkedProviders(ctx context.Context, userID int64) ([]*LinkedProvider, error) {
+	uc.log.WithContext(ctx).Infof("ListLinkedProviders: %v", userID)
+	return uc.authRepo.ListByUser(ctx, userID)
+}
+
+// LinkIdentity 为已登录用户关联新的第三方账号, 每种登录方式只能关联一个
+func (uc *UserAuthCase) LinkIdentity(ctx context.Context, userID int64, identity *Identity) (*LinkedProvider, error) {
+	uc.log.WithContext(ctx).Infof("LinkIdentity: %v %v %v", userID, identity.Provider, identity.Subject)
+	// 第三方账号已被关联
+	found, err := uc.authRepo.FindByProvider(ctx, identity.Provider, identity.Subject)
+	if err == nil {
+		if found.UserID != userID {
+			return nil, ErrProviderAlreadyLinked
+		}
+		return uc.findLinked(ctx, userID, identity.Provider)
+	}
+	if !errors.Is(err, ErrUserNotFound) {
+		return nil, err
+	}
+
+	// 当前用户已关联同类账号
+	if _, err := uc.findLinked(ctx, userID, identity.Provider); err == nil {
+		return nil, ErrProviderAlreadyLinked
+	}
+
+	u, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err = uc.LinkAuthProvider(ctx, userID, identity.Provider, identity.Subject); err != nil {
+		return nil, err
+	}
+	uc.saveIdentity(ctx, u, identity)
+	// 游客关联第三方账号后升级为正式账号, user_id 不变
+	if err = uc.upgradeGuest(ctx, userID); err != nil {
+		return nil, err
+	}
+	return uc.findLinked(ctx, userID, identity.Provider)
+}
+
+// UnlinkProvider 解除用户关联的登录方式, 至少保留一个登录方式
+func (uc *UserAuthCase) UnlinkProvider(ctx context.Context, userID int64, providerType string) error {
+	uc.log.WithContext(ctx).Infof("UnlinkProvider: %v %v", userID, providerType)
+	// 计数和删除在同一个事务中完成, 避免并发解除时删掉最后一个登录方式
+	return uc.authRepo.Unlink(ctx, userID, providerType)
+}
+
+func (uc *UserAuthCase) findLinked(ctx context.Context, userID int64, providerType string) (*LinkedProvider, error) {
+	providers, err := uc.authRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range providers {
+		if p.ProviderType == providerType {
+			return p, nil
+		}
+	}
+	return nil, ErrProviderNotLinked
+}
+
+// MergeAccounts 把 source 账号的登录方式、资料和会话合并到 survivor, source 保留为指向 survivor 的墓碑
+func (uc *UserAuthCase) MergeAccounts(ctx context.Context, survivorID, sourceID int64) (*User, error) {
+	uc.log.WithContext(ctx).Infof("MergeAccounts: %v <- %v", survivorID, sourceID)
+	if survivorID == sourceID {
+		return nil, ErrMergeNotAllowed
+	}
+	survivor, err := uc.userRepo.FindByID(ctx, survivorID)
+	if err != nil {
+		return nil, err
+	}
+	source, err := uc.userRepo.FindByID(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	if survivor.MergedInto != 0 || source.MergedInto != 0 {
+		return nil, ErrMergeNotAllowed
+	}
+	// 不能通过合并绕过停用
+	if err = source.CheckActive(); err != nil {
+		return nil, err
+	}
+
+	if err = uc.userRepo.Merge(ctx, sourceID, survivorID); err != nil {
+		return nil, err
+	}
+	return uc.userRepo.FindByID(ctx, survivorID)
+}
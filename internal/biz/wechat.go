@@ -0,0 +1,89 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// WechatRepo 定义微信 openid 关联仓储接口
+type WechatRepo interface {
+	// FindByOpenID 根据应用 appid 和 openid 查找用户
+	FindByOpenID(ctx context.Context, appID, openID string) (*User, error)
+	// Create 记录用户在某个微信应用下的 openid
+	Create(ctx context.Context, u *User, appID, openID, unionID string) error
+	// BindUnionID 为之前没有 unionid 的 openid 补充 unionid, 并把以 openid 记录的关联改为 unionid
+	BindUnionID(ctx context.Context, userID int64, appID, openID, unionID string) error
+}
+
+// WechatIdentity 微信登录后的身份
+type WechatIdentity struct {
+	AppID   string
+	OpenID  string
+	UnionID string
+	Name    string
+	Avatar  string
+}
+
+// providerID 关联使用的第三方ID, 未绑定开放平台的应用拿不到 unionid, 退化为使用 openid
+func (w *WechatIdentity) providerID() string {
+	if w.UnionID != "" {
+		return w.UnionID
+	}
+	return w.OpenID
+}
+
+// identity 转换为通用身份, 用于保存关联账号的资料
+func (w *WechatIdentity) identity() *Identity {
+	return &Identity{
+		Provider: "wechat",
+		Subject:  w.providerID(),
+		Name:     w.Name,
+		Avatar:   w.Avatar,
+	}
+}
+
+// FindOrCreateByWechat 根据微信身份查找或创建用户
+// unionid 是同一开放平台下跨应用的身份, 优先用它识别用户; openid 按应用单独记录
+func (uc *UserAuthCase) FindOrCreateByWechat(ctx context.Context, identity *WechatIdentity) (*User, bool, error) {
+	uc.log.WithContext(ctx).Infof("FindOrCreateByWechat: %v %v %v", identity.AppID, identity.OpenID, identity.UnionID)
+	// 同一应用下已经登录过, 之前没有拿到 unionid 时补充, 否则其他应用登录会创建新用户
+	found, err := uc.wechatRepo.FindByOpenID(ctx, identity.AppID, identity.OpenID)
+	if err == nil {
+		if identity.UnionID != "" {
+			if err = uc.wechatRepo.BindUnionID(ctx, found.UserID, identity.AppID, identity.OpenID, identity.UnionID); err != nil {
+				uc.log.WithContext(ctx).Warnf("failed to bind wechat unionid %v to user %v, error: %v", identity.UnionID, found.UserID, err)
+			}
+		}
+		uc.saveIdentity(ctx, found, identity.identity())
+		return found, false, nil
+	}
+	if !errors.Is(err, ErrUserNotFound) {
+		return nil, false, err
+	}
+
+	// 其他应用登录过, 通过 unionid 关联到同一个用户
+	if identity.UnionID != "" {
+		found, err = uc.authRepo.FindByProvider(ctx, "wechat", identity.UnionID)
+		if err == nil {
+			if err = uc.wechatRepo.Create(ctx, found, identity.AppID, identity.OpenID, identity.UnionID); err != nil {
+				return nil, false, err
+			}
+			uc.saveIdentity(ctx, found, identity.identity())
+			return found, false, nil
+		}
+		if !errors.Is(err, ErrUserNotFound) {
+			return nil, false, err
+		}
+	}
+
+	// 如果用户不存在，在同一个事务中创建新用户、关联和 openid, 头像转存后再写入
+	login := &ProviderLogin{ProviderType: "wechat", ProviderID: identity.providerID(), Wechat: identity}
+	found, created, err := uc.userRepo.FindOrCreateByProvider(ctx, login, &User{Name: identity.Name})
+	if err != nil {
+		return nil, false, err
+	}
+	uc.saveIdentity(ctx, found, identity.identity())
+
+	return found, created, nil
+}
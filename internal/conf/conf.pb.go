@@ -30,6 +30,8 @@ type Bootstrap struct {
 	Auth          *Auth                  `protobuf:"bytes,4,opt,name=auth,proto3" json:"auth,omitempty"`
 	Data          *Data                  `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
 	NodeId        int64                  `protobuf:"varint,6,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Avatar        *Avatar                `protobuf:"bytes,7,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	User          *User                  `protobuf:"bytes,8,opt,name=user,proto3" json:"user,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -106,6 +108,20 @@ func (x *Bootstrap) GetNodeId() int64 {
 	return 0
 }
 
+func (x *Bootstrap) GetAvatar() *Avatar {
+	if x != nil {
+		return x.Avatar
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
 type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
@@ -277,6 +293,14 @@ type Auth struct {
 	Apple         *Auth_Apple            `protobuf:"bytes,3,opt,name=apple,proto3" json:"apple,omitempty"`
 	Snapchat      *Auth_SnapChat         `protobuf:"bytes,4,opt,name=snapchat,proto3" json:"snapchat,omitempty"`
 	Sms           *Auth_Sms              `protobuf:"bytes,5,opt,name=sms,proto3" json:"sms,omitempty"`
+	Oidc          []*Auth_OIDC           `protobuf:"bytes,6,rep,name=oidc,proto3" json:"oidc,omitempty"`
+	Wechat        *Auth_Wechat           `protobuf:"bytes,7,opt,name=wechat,proto3" json:"wechat,omitempty"`
+	Microsoft     *Auth_Microsoft        `protobuf:"bytes,8,opt,name=microsoft,proto3" json:"microsoft,omitempty"`
+	X             *Auth_X                `protobuf:"bytes,9,opt,name=x,proto3" json:"x,omitempty"`
+	Tiktok        *Auth_TikTok           `protobuf:"bytes,10,opt,name=tiktok,proto3" json:"tiktok,omitempty"`
+	Firebase      *Auth_Firebase         `protobuf:"bytes,11,opt,name=firebase,proto3" json:"firebase,omitempty"`
+	Linking       *Auth_Linking          `protobuf:"bytes,12,opt,name=linking,proto3" json:"linking,omitempty"`
+	Guest         *Auth_Guest            `protobuf:"bytes,13,opt,name=guest,proto3" json:"guest,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -346,10 +370,70 @@ func (x *Auth) GetSms() *Auth_Sms {
 	return nil
 }
 
+func (x *Auth) GetOidc() []*Auth_OIDC {
+	if x != nil {
+		return x.Oidc
+	}
+	return nil
+}
+
+func (x *Auth) GetWechat() *Auth_Wechat {
+	if x != nil {
+		return x.Wechat
+	}
+	return nil
+}
+
+func (x *Auth) GetMicrosoft() *Auth_Microsoft {
+	if x != nil {
+		return x.Microsoft
+	}
+	return nil
+}
+
+func (x *Auth) GetX() *Auth_X {
+	if x != nil {
+		return x.X
+	}
+	return nil
+}
+
+func (x *Auth) GetTiktok() *Auth_TikTok {
+	if x != nil {
+		return x.Tiktok
+	}
+	return nil
+}
+
+func (x *Auth) GetFirebase() *Auth_Firebase {
+	if x != nil {
+		return x.Firebase
+	}
+	return nil
+}
+
+func (x *Auth) GetLinking() *Auth_Linking {
+	if x != nil {
+		return x.Linking
+	}
+	return nil
+}
+
+func (x *Auth) GetGuest() *Auth_Guest {
+	if x != nil {
+		return x.Guest
+	}
+	return nil
+}
+
 type Data struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Database *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Redis    *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	Crypto   *Data_Crypto           `protobuf:"bytes,3,opt,name=crypto,proto3" json:"crypto,omitempty"`
+	Storage  *Data_Storage          `protobuf:"bytes,4,opt,name=storage,proto3" json:"storage,omitempty"`
+	// 私有对象存储, 用于保存数据导出, 不能配置为公开访问
+	ExportStorage *Data_Storage `protobuf:"bytes,5,opt,name=export_storage,json=exportStorage,proto3" json:"export_storage,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -398,6 +482,195 @@ func (x *Data) GetRedis() *Data_Redis {
 	return nil
 }
 
+func (x *Data) GetCrypto() *Data_Crypto {
+	if x != nil {
+		return x.Crypto
+	}
+	return nil
+}
+
+func (x *Data) GetStorage() *Data_Storage {
+	if x != nil {
+		return x.Storage
+	}
+	return nil
+}
+
+func (x *Data) GetExportStorage() *Data_Storage {
+	if x != nil {
+		return x.ExportStorage
+	}
+	return nil
+}
+
+// 头像处理
+type Avatar struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 生成的正方形尺寸, 默认 64/256/512, user.avatar 指向最大尺寸
+	Sizes []int32 `protobuf:"varint,1,rep,packed,name=sizes,proto3" json:"sizes,omitempty"`
+	// 下载或上传图片的最大字节数, 默认 5MB
+	MaxBytes int64 `protobuf:"varint,2,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	// 原图最大边长, 默认 4096
+	MaxDimension int32 `protobuf:"varint,3,opt,name=max_dimension,json=maxDimension,proto3" json:"max_dimension,omitempty"`
+	// 后台处理协程数, 默认 2
+	Workers int32 `protobuf:"varint,4,opt,name=workers,proto3" json:"workers,omitempty"`
+	// 待处理队列长度, 默认 100, 队列满时丢弃
+	QueueSize     int32                `protobuf:"varint,5,opt,name=queue_size,json=queueSize,proto3" json:"queue_size,omitempty"`
+	FetchTimeout  *durationpb.Duration `protobuf:"bytes,6,opt,name=fetch_timeout,json=fetchTimeout,proto3" json:"fetch_timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Avatar) Reset() {
+	*x = Avatar{}
+	mi := &file_conf_conf_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Avatar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Avatar) ProtoMessage() {}
+
+func (x *Avatar) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Avatar.ProtoReflect.Descriptor instead.
+func (*Avatar) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Avatar) GetSizes() []int32 {
+	if x != nil {
+		return x.Sizes
+	}
+	return nil
+}
+
+func (x *Avatar) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *Avatar) GetMaxDimension() int32 {
+	if x != nil {
+		return x.MaxDimension
+	}
+	return 0
+}
+
+func (x *Avatar) GetWorkers() int32 {
+	if x != nil {
+		return x.Workers
+	}
+	return 0
+}
+
+func (x *Avatar) GetQueueSize() int32 {
+	if x != nil {
+		return x.QueueSize
+	}
+	return 0
+}
+
+func (x *Avatar) GetFetchTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.FetchTimeout
+	}
+	return nil
+}
+
+// 用户账号规则
+type User struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Handle   *User_Handle           `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
+	Deletion *User_Deletion         `protobuf:"bytes,2,opt,name=deletion,proto3" json:"deletion,omitempty"`
+	Export   *User_Export           `protobuf:"bytes,3,opt,name=export,proto3" json:"export,omitempty"`
+	// 可以调用管理接口的 user_id
+	Admins        []int64     `protobuf:"varint,4,rep,packed,name=admins,proto3" json:"admins,omitempty"`
+	Phone         *User_Phone `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_conf_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *User) GetHandle() *User_Handle {
+	if x != nil {
+		return x.Handle
+	}
+	return nil
+}
+
+func (x *User) GetDeletion() *User_Deletion {
+	if x != nil {
+		return x.Deletion
+	}
+	return nil
+}
+
+func (x *User) GetExport() *User_Export {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+func (x *User) GetAdmins() []int64 {
+	if x != nil {
+		return x.Admins
+	}
+	return nil
+}
+
+func (x *User) GetPhone() *User_Phone {
+	if x != nil {
+		return x.Phone
+	}
+	return nil
+}
+
 type Server_HTTP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -409,7 +682,7 @@ type Server_HTTP struct {
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -421,7 +694,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -469,7 +742,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -481,7 +754,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -528,7 +801,7 @@ type Auth_FaceBook struct {
 
 func (x *Auth_FaceBook) Reset() {
 	*x = Auth_FaceBook{}
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -540,7 +813,7 @@ func (x *Auth_FaceBook) String() string {
 func (*Auth_FaceBook) ProtoMessage() {}
 
 func (x *Auth_FaceBook) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,7 +852,7 @@ type Auth_Google struct {
 
 func (x *Auth_Google) Reset() {
 	*x = Auth_Google{}
-	mi := &file_conf_conf_proto_msgTypes[9]
+	mi := &file_conf_conf_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -591,7 +864,7 @@ func (x *Auth_Google) String() string {
 func (*Auth_Google) ProtoMessage() {}
 
 func (x *Auth_Google) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[9]
+	mi := &file_conf_conf_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -619,13 +892,14 @@ type Auth_Apple struct {
 	TeamId         string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
 	KeyId          string                 `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
 	PrivateKeyPath string                 `protobuf:"bytes,3,opt,name=private_key_path,json=privateKeyPath,proto3" json:"private_key_path,omitempty"`
+	ClientId       string                 `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Auth_Apple) Reset() {
 	*x = Auth_Apple{}
-	mi := &file_conf_conf_proto_msgTypes[10]
+	mi := &file_conf_conf_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -637,7 +911,7 @@ func (x *Auth_Apple) String() string {
 func (*Auth_Apple) ProtoMessage() {}
 
 func (x *Auth_Apple) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[10]
+	mi := &file_conf_conf_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -674,6 +948,13 @@ func (x *Auth_Apple) GetPrivateKeyPath() string {
 	return ""
 }
 
+func (x *Auth_Apple) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
 type Auth_SnapChat struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
@@ -684,7 +965,7 @@ type Auth_SnapChat struct {
 
 func (x *Auth_SnapChat) Reset() {
 	*x = Auth_SnapChat{}
-	mi := &file_conf_conf_proto_msgTypes[11]
+	mi := &file_conf_conf_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -696,7 +977,7 @@ func (x *Auth_SnapChat) String() string {
 func (*Auth_SnapChat) ProtoMessage() {}
 
 func (x *Auth_SnapChat) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[11]
+	mi := &file_conf_conf_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -736,7 +1017,7 @@ type Auth_Sms struct {
 
 func (x *Auth_Sms) Reset() {
 	*x = Auth_Sms{}
-	mi := &file_conf_conf_proto_msgTypes[12]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -748,7 +1029,7 @@ func (x *Auth_Sms) String() string {
 func (*Auth_Sms) ProtoMessage() {}
 
 func (x *Auth_Sms) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[12]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -778,29 +1059,33 @@ func (x *Auth_Sms) GetApiKey() string {
 	return ""
 }
 
-type Data_Database struct {
+// 通用 OIDC issuer, 只需配置即可接入
+type Auth_OIDC struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Driver        string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
-	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Issuer        string                 `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	ClientIds     []string               `protobuf:"bytes,3,rep,name=client_ids,json=clientIds,proto3" json:"client_ids,omitempty"`
+	JwksUri       string                 `protobuf:"bytes,4,opt,name=jwks_uri,json=jwksUri,proto3" json:"jwks_uri,omitempty"`
+	Claims        *Auth_OIDC_Claims      `protobuf:"bytes,5,opt,name=claims,proto3" json:"claims,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Data_Database) Reset() {
-	*x = Data_Database{}
-	mi := &file_conf_conf_proto_msgTypes[13]
+func (x *Auth_OIDC) Reset() {
+	*x = Auth_OIDC{}
+	mi := &file_conf_conf_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Data_Database) String() string {
+func (x *Auth_OIDC) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Data_Database) ProtoMessage() {}
+func (*Auth_OIDC) ProtoMessage() {}
 
-func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[13]
+func (x *Auth_OIDC) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -811,53 +1096,73 @@ func (x *Data_Database) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data_Database.ProtoReflect.Descriptor instead.
-func (*Data_Database) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{5, 0}
+// Deprecated: Use Auth_OIDC.ProtoReflect.Descriptor instead.
+func (*Auth_OIDC) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 5}
 }
 
-func (x *Data_Database) GetDriver() string {
+func (x *Auth_OIDC) GetName() string {
 	if x != nil {
-		return x.Driver
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Data_Database) GetSource() string {
+func (x *Auth_OIDC) GetIssuer() string {
 	if x != nil {
-		return x.Source
+		return x.Issuer
 	}
 	return ""
 }
 
-type Data_Redis struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	Db            int32                  `protobuf:"varint,4,opt,name=db,proto3" json:"db,omitempty"`
-	DialTimeout   *durationpb.Duration   `protobuf:"bytes,5,opt,name=dial_timeout,json=dialTimeout,proto3" json:"dial_timeout,omitempty"`
-	ReadTimeout   *durationpb.Duration   `protobuf:"bytes,6,opt,name=read_timeout,json=readTimeout,proto3" json:"read_timeout,omitempty"`
-	WriteTimeout  *durationpb.Duration   `protobuf:"bytes,7,opt,name=write_timeout,json=writeTimeout,proto3" json:"write_timeout,omitempty"`
+func (x *Auth_OIDC) GetClientIds() []string {
+	if x != nil {
+		return x.ClientIds
+	}
+	return nil
+}
+
+func (x *Auth_OIDC) GetJwksUri() string {
+	if x != nil {
+		return x.JwksUri
+	}
+	return ""
+}
+
+func (x *Auth_OIDC) GetClaims() *Auth_OIDC_Claims {
+	if x != nil {
+		return x.Claims
+	}
+	return nil
+}
+
+type Auth_Wechat struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 移动应用
+	App *Auth_Wechat_App `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
+	// 小程序
+	MiniProgram *Auth_Wechat_App `protobuf:"bytes,2,opt,name=mini_program,json=miniProgram,proto3" json:"mini_program,omitempty"`
+	// 接口地址, 为空时使用 https://api.weixin.qq.com, 测试时可指向本地服务
+	BaseUrl       string `protobuf:"bytes,3,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Data_Redis) Reset() {
-	*x = Data_Redis{}
-	mi := &file_conf_conf_proto_msgTypes[14]
+func (x *Auth_Wechat) Reset() {
+	*x = Auth_Wechat{}
+	mi := &file_conf_conf_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Data_Redis) String() string {
+func (x *Auth_Wechat) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Data_Redis) ProtoMessage() {}
+func (*Auth_Wechat) ProtoMessage() {}
 
-func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[14]
+func (x *Auth_Wechat) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -868,73 +1173,1202 @@ func (x *Data_Redis) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data_Redis.ProtoReflect.Descriptor instead.
+// Deprecated: Use Auth_Wechat.ProtoReflect.Descriptor instead.
+func (*Auth_Wechat) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 6}
+}
+
+func (x *Auth_Wechat) GetApp() *Auth_Wechat_App {
+	if x != nil {
+		return x.App
+	}
+	return nil
+}
+
+func (x *Auth_Wechat) GetMiniProgram() *Auth_Wechat_App {
+	if x != nil {
+		return x.MiniProgram
+	}
+	return nil
+}
+
+func (x *Auth_Wechat) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+// Microsoft / Entra ID, 使用 common 或 organizations 多租户端点
+type Auth_Microsoft struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ClientIds []string               `protobuf:"bytes,1,rep,name=client_ids,json=clientIds,proto3" json:"client_ids,omitempty"`
+	// common: 工作账号和个人账号, organizations: 仅工作账号
+	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// 允许登录的租户 tid, 为空时不限制
+	AllowedTenants []string `protobuf:"bytes,3,rep,name=allowed_tenants,json=allowedTenants,proto3" json:"allowed_tenants,omitempty"`
+	// 禁止登录的租户 tid
+	DeniedTenants []string `protobuf:"bytes,4,rep,name=denied_tenants,json=deniedTenants,proto3" json:"denied_tenants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth_Microsoft) Reset() {
+	*x = Auth_Microsoft{}
+	mi := &file_conf_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_Microsoft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_Microsoft) ProtoMessage() {}
+
+func (x *Auth_Microsoft) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_Microsoft.ProtoReflect.Descriptor instead.
+func (*Auth_Microsoft) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 7}
+}
+
+func (x *Auth_Microsoft) GetClientIds() []string {
+	if x != nil {
+		return x.ClientIds
+	}
+	return nil
+}
+
+func (x *Auth_Microsoft) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *Auth_Microsoft) GetAllowedTenants() []string {
+	if x != nil {
+		return x.AllowedTenants
+	}
+	return nil
+}
+
+func (x *Auth_Microsoft) GetDeniedTenants() []string {
+	if x != nil {
+		return x.DeniedTenants
+	}
+	return nil
+}
+
+// X (Twitter) OAuth 2.0 授权码 + PKCE
+type Auth_X struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ClientId string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// 机密客户端才需要, 公共客户端留空
+	ClientSecret string `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	// 接口地址, 为空时使用正式地址, 测试时可替换
+	TokenUrl      string `protobuf:"bytes,3,opt,name=token_url,json=tokenUrl,proto3" json:"token_url,omitempty"`
+	UserinfoUrl   string `protobuf:"bytes,4,opt,name=userinfo_url,json=userinfoUrl,proto3" json:"userinfo_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth_X) Reset() {
+	*x = Auth_X{}
+	mi := &file_conf_conf_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_X) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_X) ProtoMessage() {}
+
+func (x *Auth_X) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_X.ProtoReflect.Descriptor instead.
+func (*Auth_X) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 8}
+}
+
+func (x *Auth_X) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *Auth_X) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *Auth_X) GetTokenUrl() string {
+	if x != nil {
+		return x.TokenUrl
+	}
+	return ""
+}
+
+func (x *Auth_X) GetUserinfoUrl() string {
+	if x != nil {
+		return x.UserinfoUrl
+	}
+	return ""
+}
+
+// TikTok Login Kit OAuth 2.0 授权码 + PKCE
+type Auth_TikTok struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ClientKey    string                 `protobuf:"bytes,1,opt,name=client_key,json=clientKey,proto3" json:"client_key,omitempty"`
+	ClientSecret string                 `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	// 接口地址, 为空时使用正式地址, 测试时可替换
+	TokenUrl      string `protobuf:"bytes,3,opt,name=token_url,json=tokenUrl,proto3" json:"token_url,omitempty"`
+	UserinfoUrl   string `protobuf:"bytes,4,opt,name=userinfo_url,json=userinfoUrl,proto3" json:"userinfo_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth_TikTok) Reset() {
+	*x = Auth_TikTok{}
+	mi := &file_conf_conf_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_TikTok) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_TikTok) ProtoMessage() {}
+
+func (x *Auth_TikTok) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_TikTok.ProtoReflect.Descriptor instead.
+func (*Auth_TikTok) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 9}
+}
+
+func (x *Auth_TikTok) GetClientKey() string {
+	if x != nil {
+		return x.ClientKey
+	}
+	return ""
+}
+
+func (x *Auth_TikTok) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *Auth_TikTok) GetTokenUrl() string {
+	if x != nil {
+		return x.TokenUrl
+	}
+	return ""
+}
+
+func (x *Auth_TikTok) GetUserinfoUrl() string {
+	if x != nil {
+		return x.UserinfoUrl
+	}
+	return ""
+}
+
+// Firebase Authentication, 用于迁移 Firebase 存量用户
+type Auth_Firebase struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth_Firebase) Reset() {
+	*x = Auth_Firebase{}
+	mi := &file_conf_conf_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_Firebase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_Firebase) ProtoMessage() {}
+
+func (x *Auth_Firebase) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_Firebase.ProtoReflect.Descriptor instead.
+func (*Auth_Firebase) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 10}
+}
+
+func (x *Auth_Firebase) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+// 第三方登录邮箱与已有用户相同时的处理策略
+type Auth_Linking struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// auto: 双方邮箱均已验证时自动关联; prompt: 提示用户登录已有账号后手动关联; separate(默认): 创建新用户
+	Policy        string `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth_Linking) Reset() {
+	*x = Auth_Linking{}
+	mi := &file_conf_conf_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_Linking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_Linking) ProtoMessage() {}
+
+func (x *Auth_Linking) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_Linking.ProtoReflect.Descriptor instead.
+func (*Auth_Linking) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 11}
+}
+
+func (x *Auth_Linking) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+// 游客账号
+type Auth_Guest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 超过该时长未登录且未升级的游客账号会被清理, 为空时不清理
+	Ttl *durationpb.Duration `protobuf:"bytes,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// 清理任务执行间隔, 默认 1 小时
+	CleanupInterval *durationpb.Duration `protobuf:"bytes,2,opt,name=cleanup_interval,json=cleanupInterval,proto3" json:"cleanup_interval,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Auth_Guest) Reset() {
+	*x = Auth_Guest{}
+	mi := &file_conf_conf_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_Guest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_Guest) ProtoMessage() {}
+
+func (x *Auth_Guest) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_Guest.ProtoReflect.Descriptor instead.
+func (*Auth_Guest) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 12}
+}
+
+func (x *Auth_Guest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *Auth_Guest) GetCleanupInterval() *durationpb.Duration {
+	if x != nil {
+		return x.CleanupInterval
+	}
+	return nil
+}
+
+// id_token 声明到用户字段的映射, 为空时使用标准声明名
+type Auth_OIDC_Claims struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subject       string                 `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	EmailVerified string                 `protobuf:"bytes,3,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Picture       string                 `protobuf:"bytes,5,opt,name=picture,proto3" json:"picture,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth_OIDC_Claims) Reset() {
+	*x = Auth_OIDC_Claims{}
+	mi := &file_conf_conf_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_OIDC_Claims) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_OIDC_Claims) ProtoMessage() {}
+
+func (x *Auth_OIDC_Claims) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_OIDC_Claims.ProtoReflect.Descriptor instead.
+func (*Auth_OIDC_Claims) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 5, 0}
+}
+
+func (x *Auth_OIDC_Claims) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Auth_OIDC_Claims) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Auth_OIDC_Claims) GetEmailVerified() string {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return ""
+}
+
+func (x *Auth_OIDC_Claims) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Auth_OIDC_Claims) GetPicture() string {
+	if x != nil {
+		return x.Picture
+	}
+	return ""
+}
+
+type Auth_Wechat_App struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	AppSecret     string                 `protobuf:"bytes,2,opt,name=app_secret,json=appSecret,proto3" json:"app_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth_Wechat_App) Reset() {
+	*x = Auth_Wechat_App{}
+	mi := &file_conf_conf_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_Wechat_App) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_Wechat_App) ProtoMessage() {}
+
+func (x *Auth_Wechat_App) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_Wechat_App.ProtoReflect.Descriptor instead.
+func (*Auth_Wechat_App) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 6, 0}
+}
+
+func (x *Auth_Wechat_App) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *Auth_Wechat_App) GetAppSecret() string {
+	if x != nil {
+		return x.AppSecret
+	}
+	return ""
+}
+
+type Data_Database struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Database) Reset() {
+	*x = Data_Database{}
+	mi := &file_conf_conf_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Database) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Database) ProtoMessage() {}
+
+func (x *Data_Database) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Database.ProtoReflect.Descriptor instead.
+func (*Data_Database) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *Data_Database) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Data_Database) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type Data_Redis struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Db            int32                  `protobuf:"varint,4,opt,name=db,proto3" json:"db,omitempty"`
+	DialTimeout   *durationpb.Duration   `protobuf:"bytes,5,opt,name=dial_timeout,json=dialTimeout,proto3" json:"dial_timeout,omitempty"`
+	ReadTimeout   *durationpb.Duration   `protobuf:"bytes,6,opt,name=read_timeout,json=readTimeout,proto3" json:"read_timeout,omitempty"`
+	WriteTimeout  *durationpb.Duration   `protobuf:"bytes,7,opt,name=write_timeout,json=writeTimeout,proto3" json:"write_timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Redis) Reset() {
+	*x = Data_Redis{}
+	mi := &file_conf_conf_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Redis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Redis) ProtoMessage() {}
+
+func (x *Data_Redis) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Redis.ProtoReflect.Descriptor instead.
 func (*Data_Redis) Descriptor() ([]byte, []int) {
 	return file_conf_conf_proto_rawDescGZIP(), []int{5, 1}
 }
 
-func (x *Data_Redis) GetNetwork() string {
+func (x *Data_Redis) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Data_Redis) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Data_Redis) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *Data_Redis) GetDb() int32 {
+	if x != nil {
+		return x.Db
+	}
+	return 0
+}
+
+func (x *Data_Redis) GetDialTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.DialTimeout
+	}
+	return nil
+}
+
+func (x *Data_Redis) GetReadTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ReadTimeout
+	}
+	return nil
+}
+
+func (x *Data_Redis) GetWriteTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.WriteTimeout
+	}
+	return nil
+}
+
+// 敏感数据加密
+type Data_Crypto struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// base64 编码的 32 字节 AES 密钥, 为空时不保存第三方令牌; 配置了 Apple 签名私钥时必填
+	Key           string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Crypto) Reset() {
+	*x = Data_Crypto{}
+	mi := &file_conf_conf_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Crypto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Crypto) ProtoMessage() {}
+
+func (x *Data_Crypto) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Crypto.ProtoReflect.Descriptor instead.
+func (*Data_Crypto) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5, 2}
+}
+
+func (x *Data_Crypto) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// 对象存储, 用于保存头像等文件
+type Data_Storage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// local 或 s3, 为空时不启用
+	Driver        string              `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	Local         *Data_Storage_Local `protobuf:"bytes,2,opt,name=local,proto3" json:"local,omitempty"`
+	S3            *Data_Storage_S3    `protobuf:"bytes,3,opt,name=s3,proto3" json:"s3,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Storage) Reset() {
+	*x = Data_Storage{}
+	mi := &file_conf_conf_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Storage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Storage) ProtoMessage() {}
+
+func (x *Data_Storage) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Storage.ProtoReflect.Descriptor instead.
+func (*Data_Storage) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5, 3}
+}
+
+func (x *Data_Storage) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Data_Storage) GetLocal() *Data_Storage_Local {
+	if x != nil {
+		return x.Local
+	}
+	return nil
+}
+
+func (x *Data_Storage) GetS3() *Data_Storage_S3 {
 	if x != nil {
-		return x.Network
+		return x.S3
+	}
+	return nil
+}
+
+type Data_Storage_Local struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Dir   string                 `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
+	// 对外访问地址前缀, 由 nginx 等静态服务提供
+	BaseUrl       string `protobuf:"bytes,2,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Storage_Local) Reset() {
+	*x = Data_Storage_Local{}
+	mi := &file_conf_conf_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Storage_Local) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Storage_Local) ProtoMessage() {}
+
+func (x *Data_Storage_Local) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Storage_Local.ProtoReflect.Descriptor instead.
+func (*Data_Storage_Local) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5, 3, 0}
+}
+
+func (x *Data_Storage_Local) GetDir() string {
+	if x != nil {
+		return x.Dir
 	}
 	return ""
 }
 
-func (x *Data_Redis) GetAddr() string {
+func (x *Data_Storage_Local) GetBaseUrl() string {
 	if x != nil {
-		return x.Addr
+		return x.BaseUrl
 	}
 	return ""
 }
 
-func (x *Data_Redis) GetPassword() string {
+type Data_Storage_S3 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Bucket        string                 `protobuf:"bytes,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	AccessKey     string                 `protobuf:"bytes,4,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
+	SecretKey     string                 `protobuf:"bytes,5,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	PathStyle     bool                   `protobuf:"varint,6,opt,name=path_style,json=pathStyle,proto3" json:"path_style,omitempty"`
+	BaseUrl       string                 `protobuf:"bytes,7,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Storage_S3) Reset() {
+	*x = Data_Storage_S3{}
+	mi := &file_conf_conf_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Storage_S3) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Storage_S3) ProtoMessage() {}
+
+func (x *Data_Storage_S3) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[30]
 	if x != nil {
-		return x.Password
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Storage_S3.ProtoReflect.Descriptor instead.
+func (*Data_Storage_S3) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5, 3, 1}
+}
+
+func (x *Data_Storage_S3) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
 	}
 	return ""
 }
 
-func (x *Data_Redis) GetDb() int32 {
+func (x *Data_Storage_S3) GetRegion() string {
 	if x != nil {
-		return x.Db
+		return x.Region
 	}
-	return 0
+	return ""
 }
 
-func (x *Data_Redis) GetDialTimeout() *durationpb.Duration {
+func (x *Data_Storage_S3) GetBucket() string {
 	if x != nil {
-		return x.DialTimeout
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *Data_Storage_S3) GetAccessKey() string {
+	if x != nil {
+		return x.AccessKey
+	}
+	return ""
+}
+
+func (x *Data_Storage_S3) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+func (x *Data_Storage_S3) GetPathStyle() bool {
+	if x != nil {
+		return x.PathStyle
+	}
+	return false
+}
+
+func (x *Data_Storage_S3) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+type User_Handle struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 额外的保留字, 与内置列表合并
+	Reserved []string `protobuf:"bytes,1,rep,name=reserved,proto3" json:"reserved,omitempty"`
+	// 额外的屏蔽词, 包含即不可用
+	Blocked []string `protobuf:"bytes,2,rep,name=blocked,proto3" json:"blocked,omitempty"`
+	// 两次修改的最短间隔, 默认 720h
+	ChangeInterval *durationpb.Duration `protobuf:"bytes,3,opt,name=change_interval,json=changeInterval,proto3" json:"change_interval,omitempty"`
+	// 旧 handle 保留给原用户的时长, 期间可跳转且他人不可使用, 默认 2160h
+	HoldPeriod    *durationpb.Duration `protobuf:"bytes,4,opt,name=hold_period,json=holdPeriod,proto3" json:"hold_period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User_Handle) Reset() {
+	*x = User_Handle{}
+	mi := &file_conf_conf_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User_Handle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User_Handle) ProtoMessage() {}
+
+func (x *User_Handle) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User_Handle.ProtoReflect.Descriptor instead.
+func (*User_Handle) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *User_Handle) GetReserved() []string {
+	if x != nil {
+		return x.Reserved
 	}
 	return nil
 }
 
-func (x *Data_Redis) GetReadTimeout() *durationpb.Duration {
+func (x *User_Handle) GetBlocked() []string {
 	if x != nil {
-		return x.ReadTimeout
+		return x.Blocked
 	}
 	return nil
 }
 
-func (x *Data_Redis) GetWriteTimeout() *durationpb.Duration {
+func (x *User_Handle) GetChangeInterval() *durationpb.Duration {
 	if x != nil {
-		return x.WriteTimeout
+		return x.ChangeInterval
+	}
+	return nil
+}
+
+func (x *User_Handle) GetHoldPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.HoldPeriod
+	}
+	return nil
+}
+
+type User_Deletion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 申请注销后的冷静期, 期间重新登录即取消注销, 默认 720h
+	GracePeriod *durationpb.Duration `protobuf:"bytes,1,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`
+	// 注销前要求最近登录过的时长, 默认 10m
+	RecentAuth *durationpb.Duration `protobuf:"bytes,2,opt,name=recent_auth,json=recentAuth,proto3" json:"recent_auth,omitempty"`
+	// 清理到期账号的执行间隔, 默认 1h
+	PurgeInterval *durationpb.Duration `protobuf:"bytes,3,opt,name=purge_interval,json=purgeInterval,proto3" json:"purge_interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User_Deletion) Reset() {
+	*x = User_Deletion{}
+	mi := &file_conf_conf_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User_Deletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User_Deletion) ProtoMessage() {}
+
+func (x *User_Deletion) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User_Deletion.ProtoReflect.Descriptor instead.
+func (*User_Deletion) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{7, 1}
+}
+
+func (x *User_Deletion) GetGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return nil
+}
+
+func (x *User_Deletion) GetRecentAuth() *durationpb.Duration {
+	if x != nil {
+		return x.RecentAuth
+	}
+	return nil
+}
+
+func (x *User_Deletion) GetPurgeInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PurgeInterval
+	}
+	return nil
+}
+
+type User_Export struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 下载链接的签名密钥, 为空时不提供数据导出
+	SigningKey string `protobuf:"bytes,1,opt,name=signing_key,json=signingKey,proto3" json:"signing_key,omitempty"`
+	// 下载链接有效期, 默认 15m
+	LinkTtl *durationpb.Duration `protobuf:"bytes,2,opt,name=link_ttl,json=linkTtl,proto3" json:"link_ttl,omitempty"`
+	// 导出文件保留时长, 之后删除, 默认 168h
+	Retention *durationpb.Duration `protobuf:"bytes,3,opt,name=retention,proto3" json:"retention,omitempty"`
+	// 两次申请导出的最短间隔, 默认 24h
+	RequestInterval *durationpb.Duration `protobuf:"bytes,4,opt,name=request_interval,json=requestInterval,proto3" json:"request_interval,omitempty"`
+	// 处理导出任务的执行间隔, 默认 1m
+	Interval *durationpb.Duration `protobuf:"bytes,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	// 下载链接的地址前缀, 如 https://api.example.com, 为空时返回相对路径
+	BaseUrl       string `protobuf:"bytes,6,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User_Export) Reset() {
+	*x = User_Export{}
+	mi := &file_conf_conf_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User_Export) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User_Export) ProtoMessage() {}
+
+func (x *User_Export) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User_Export.ProtoReflect.Descriptor instead.
+func (*User_Export) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{7, 2}
+}
+
+func (x *User_Export) GetSigningKey() string {
+	if x != nil {
+		return x.SigningKey
+	}
+	return ""
+}
+
+func (x *User_Export) GetLinkTtl() *durationpb.Duration {
+	if x != nil {
+		return x.LinkTtl
+	}
+	return nil
+}
+
+func (x *User_Export) GetRetention() *durationpb.Duration {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *User_Export) GetRequestInterval() *durationpb.Duration {
+	if x != nil {
+		return x.RequestInterval
 	}
 	return nil
 }
 
+func (x *User_Export) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *User_Export) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+type User_Phone struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 没有国际区号的号码使用的区号, 如 86; 为空时只接受 + 或 00 开头的号码
+	DefaultCallingCode string `protobuf:"bytes,1,opt,name=default_calling_code,json=defaultCallingCode,proto3" json:"default_calling_code,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *User_Phone) Reset() {
+	*x = User_Phone{}
+	mi := &file_conf_conf_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User_Phone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User_Phone) ProtoMessage() {}
+
+func (x *User_Phone) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User_Phone.ProtoReflect.Descriptor instead.
+func (*User_Phone) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{7, 3}
+}
+
+func (x *User_Phone) GetDefaultCallingCode() string {
+	if x != nil {
+		return x.DefaultCallingCode
+	}
+	return ""
+}
+
 var File_conf_conf_proto protoreflect.FileDescriptor
 
 const file_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"\x0fconf/conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xeb\x01\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xbd\x02\n" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12*\n" +
 	"\x06logger\x18\x02 \x01(\v2\x12.kratos.api.LoggerR\x06logger\x12!\n" +
 	"\x03jwt\x18\x03 \x01(\v2\x0f.kratos.api.JwtR\x03jwt\x12$\n" +
 	"\x04auth\x18\x04 \x01(\v2\x10.kratos.api.AuthR\x04auth\x12$\n" +
 	"\x04data\x18\x05 \x01(\v2\x10.kratos.api.DataR\x04data\x12\x17\n" +
-	"\anode_id\x18\x06 \x01(\x03R\x06nodeId\"\xb8\x02\n" +
+	"\anode_id\x18\x06 \x01(\x03R\x06nodeId\x12*\n" +
+	"\x06avatar\x18\a \x01(\v2\x12.kratos.api.AvatarR\x06avatar\x12$\n" +
+	"\x04user\x18\b \x01(\v2\x10.kratos.api.UserR\x04user\"\xb8\x02\n" +
 	"\x06Server\x12+\n" +
 	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
 	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
@@ -952,32 +2386,91 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\tfile_path\x18\x03 \x01(\tR\bfilePath\"7\n" +
 	"\x03Jwt\x12\x16\n" +
 	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x18\n" +
-	"\aexpires\x18\x02 \x01(\x05R\aexpires\"\xd1\x04\n" +
+	"\aexpires\x18\x02 \x01(\x05R\aexpires\"\xed\x10\n" +
 	"\x04Auth\x125\n" +
 	"\bfacebook\x18\x01 \x01(\v2\x19.kratos.api.Auth.FaceBookR\bfacebook\x12/\n" +
 	"\x06google\x18\x02 \x01(\v2\x17.kratos.api.Auth.GoogleR\x06google\x12,\n" +
 	"\x05apple\x18\x03 \x01(\v2\x16.kratos.api.Auth.AppleR\x05apple\x125\n" +
 	"\bsnapchat\x18\x04 \x01(\v2\x19.kratos.api.Auth.SnapChatR\bsnapchat\x12&\n" +
-	"\x03sms\x18\x05 \x01(\v2\x14.kratos.api.Auth.SmsR\x03sms\x1a@\n" +
+	"\x03sms\x18\x05 \x01(\v2\x14.kratos.api.Auth.SmsR\x03sms\x12)\n" +
+	"\x04oidc\x18\x06 \x03(\v2\x15.kratos.api.Auth.OIDCR\x04oidc\x12/\n" +
+	"\x06wechat\x18\a \x01(\v2\x17.kratos.api.Auth.WechatR\x06wechat\x128\n" +
+	"\tmicrosoft\x18\b \x01(\v2\x1a.kratos.api.Auth.MicrosoftR\tmicrosoft\x12 \n" +
+	"\x01x\x18\t \x01(\v2\x12.kratos.api.Auth.XR\x01x\x12/\n" +
+	"\x06tiktok\x18\n" +
+	" \x01(\v2\x17.kratos.api.Auth.TikTokR\x06tiktok\x125\n" +
+	"\bfirebase\x18\v \x01(\v2\x19.kratos.api.Auth.FirebaseR\bfirebase\x122\n" +
+	"\alinking\x18\f \x01(\v2\x18.kratos.api.Auth.LinkingR\alinking\x12,\n" +
+	"\x05guest\x18\r \x01(\v2\x16.kratos.api.Auth.GuestR\x05guest\x1a@\n" +
 	"\bFaceBook\x12\x15\n" +
 	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x1d\n" +
 	"\n" +
 	"app_secret\x18\x02 \x01(\tR\tappSecret\x1a%\n" +
 	"\x06Google\x12\x1b\n" +
-	"\tclient_id\x18\x01 \x01(\tR\bclientId\x1aa\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x1a~\n" +
 	"\x05Apple\x12\x17\n" +
 	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x12\x15\n" +
 	"\x06key_id\x18\x02 \x01(\tR\x05keyId\x12(\n" +
-	"\x10private_key_path\x18\x03 \x01(\tR\x0eprivateKeyPath\x1aL\n" +
+	"\x10private_key_path\x18\x03 \x01(\tR\x0eprivateKeyPath\x12\x1b\n" +
+	"\tclient_id\x18\x04 \x01(\tR\bclientId\x1aL\n" +
 	"\bSnapChat\x12\x1b\n" +
 	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12#\n" +
 	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\x1a:\n" +
 	"\x03Sms\x12\x1a\n" +
 	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x17\n" +
-	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\"\xc7\x03\n" +
+	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\x1a\xb2\x02\n" +
+	"\x04OIDC\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06issuer\x18\x02 \x01(\tR\x06issuer\x12\x1d\n" +
+	"\n" +
+	"client_ids\x18\x03 \x03(\tR\tclientIds\x12\x19\n" +
+	"\bjwks_uri\x18\x04 \x01(\tR\ajwksUri\x124\n" +
+	"\x06claims\x18\x05 \x01(\v2\x1c.kratos.api.Auth.OIDC.ClaimsR\x06claims\x1a\x8d\x01\n" +
+	"\x06Claims\x12\x18\n" +
+	"\asubject\x18\x01 \x01(\tR\asubject\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12%\n" +
+	"\x0eemail_verified\x18\x03 \x01(\tR\remailVerified\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x18\n" +
+	"\apicture\x18\x05 \x01(\tR\apicture\x1a\xcf\x01\n" +
+	"\x06Wechat\x12-\n" +
+	"\x03app\x18\x01 \x01(\v2\x1b.kratos.api.Auth.Wechat.AppR\x03app\x12>\n" +
+	"\fmini_program\x18\x02 \x01(\v2\x1b.kratos.api.Auth.Wechat.AppR\vminiProgram\x12\x19\n" +
+	"\bbase_url\x18\x03 \x01(\tR\abaseUrl\x1a;\n" +
+	"\x03App\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x1d\n" +
+	"\n" +
+	"app_secret\x18\x02 \x01(\tR\tappSecret\x1a\x92\x01\n" +
+	"\tMicrosoft\x12\x1d\n" +
+	"\n" +
+	"client_ids\x18\x01 \x03(\tR\tclientIds\x12\x16\n" +
+	"\x06tenant\x18\x02 \x01(\tR\x06tenant\x12'\n" +
+	"\x0fallowed_tenants\x18\x03 \x03(\tR\x0eallowedTenants\x12%\n" +
+	"\x0edenied_tenants\x18\x04 \x03(\tR\rdeniedTenants\x1a\x85\x01\n" +
+	"\x01X\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12#\n" +
+	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\x12\x1b\n" +
+	"\ttoken_url\x18\x03 \x01(\tR\btokenUrl\x12!\n" +
+	"\fuserinfo_url\x18\x04 \x01(\tR\vuserinfoUrl\x1a\x8c\x01\n" +
+	"\x06TikTok\x12\x1d\n" +
+	"\n" +
+	"client_key\x18\x01 \x01(\tR\tclientKey\x12#\n" +
+	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\x12\x1b\n" +
+	"\ttoken_url\x18\x03 \x01(\tR\btokenUrl\x12!\n" +
+	"\fuserinfo_url\x18\x04 \x01(\tR\vuserinfoUrl\x1a)\n" +
+	"\bFirebase\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x1a!\n" +
+	"\aLinking\x12\x16\n" +
+	"\x06policy\x18\x01 \x01(\tR\x06policy\x1az\n" +
+	"\x05Guest\x12+\n" +
+	"\x03ttl\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\x12D\n" +
+	"\x10cleanup_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0fcleanupInterval\"\x91\b\n" +
 	"\x04Data\x125\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
-	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x1a:\n" +
+	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x12/\n" +
+	"\x06crypto\x18\x03 \x01(\v2\x17.kratos.api.Data.CryptoR\x06crypto\x122\n" +
+	"\astorage\x18\x04 \x01(\v2\x18.kratos.api.Data.StorageR\astorage\x12?\n" +
+	"\x0eexport_storage\x18\x05 \x01(\v2\x18.kratos.api.Data.StorageR\rexportStorage\x1a:\n" +
 	"\bDatabase\x12\x16\n" +
 	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x1a\x9d\x02\n" +
@@ -988,7 +2481,62 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x02db\x18\x04 \x01(\x05R\x02db\x12<\n" +
 	"\fdial_timeout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\vdialTimeout\x12<\n" +
 	"\fread_timeout\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
-	"\rwrite_timeout\x18\a \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeoutB!Z\x1fuser-service/internal/conf;confb\x06proto3"
+	"\rwrite_timeout\x18\a \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x1a\x1a\n" +
+	"\x06Crypto\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x1a\x85\x03\n" +
+	"\aStorage\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x124\n" +
+	"\x05local\x18\x02 \x01(\v2\x1e.kratos.api.Data.Storage.LocalR\x05local\x12+\n" +
+	"\x02s3\x18\x03 \x01(\v2\x1b.kratos.api.Data.Storage.S3R\x02s3\x1a4\n" +
+	"\x05Local\x12\x10\n" +
+	"\x03dir\x18\x01 \x01(\tR\x03dir\x12\x19\n" +
+	"\bbase_url\x18\x02 \x01(\tR\abaseUrl\x1a\xc8\x01\n" +
+	"\x02S3\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x16\n" +
+	"\x06bucket\x18\x03 \x01(\tR\x06bucket\x12\x1d\n" +
+	"\n" +
+	"access_key\x18\x04 \x01(\tR\taccessKey\x12\x1d\n" +
+	"\n" +
+	"secret_key\x18\x05 \x01(\tR\tsecretKey\x12\x1d\n" +
+	"\n" +
+	"path_style\x18\x06 \x01(\bR\tpathStyle\x12\x19\n" +
+	"\bbase_url\x18\a \x01(\tR\abaseUrl\"\xd9\x01\n" +
+	"\x06Avatar\x12\x14\n" +
+	"\x05sizes\x18\x01 \x03(\x05R\x05sizes\x12\x1b\n" +
+	"\tmax_bytes\x18\x02 \x01(\x03R\bmaxBytes\x12#\n" +
+	"\rmax_dimension\x18\x03 \x01(\x05R\fmaxDimension\x12\x18\n" +
+	"\aworkers\x18\x04 \x01(\x05R\aworkers\x12\x1d\n" +
+	"\n" +
+	"queue_size\x18\x05 \x01(\x05R\tqueueSize\x12>\n" +
+	"\rfetch_timeout\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\ffetchTimeout\"\xdd\a\n" +
+	"\x04User\x12/\n" +
+	"\x06handle\x18\x01 \x01(\v2\x17.kratos.api.User.HandleR\x06handle\x125\n" +
+	"\bdeletion\x18\x02 \x01(\v2\x19.kratos.api.User.DeletionR\bdeletion\x12/\n" +
+	"\x06export\x18\x03 \x01(\v2\x17.kratos.api.User.ExportR\x06export\x12\x16\n" +
+	"\x06admins\x18\x04 \x03(\x03R\x06admins\x12,\n" +
+	"\x05phone\x18\x05 \x01(\v2\x16.kratos.api.User.PhoneR\x05phone\x1a\xbe\x01\n" +
+	"\x06Handle\x12\x1a\n" +
+	"\breserved\x18\x01 \x03(\tR\breserved\x12\x18\n" +
+	"\ablocked\x18\x02 \x03(\tR\ablocked\x12B\n" +
+	"\x0fchange_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x0echangeInterval\x12:\n" +
+	"\vhold_period\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"holdPeriod\x1a\xc6\x01\n" +
+	"\bDeletion\x12<\n" +
+	"\fgrace_period\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\vgracePeriod\x12:\n" +
+	"\vrecent_auth\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"recentAuth\x12@\n" +
+	"\x0epurge_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\rpurgeInterval\x1a\xb0\x02\n" +
+	"\x06Export\x12\x1f\n" +
+	"\vsigning_key\x18\x01 \x01(\tR\n" +
+	"signingKey\x124\n" +
+	"\blink_ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\alinkTtl\x127\n" +
+	"\tretention\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\tretention\x12D\n" +
+	"\x10request_interval\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x0frequestInterval\x125\n" +
+	"\binterval\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x19\n" +
+	"\bbase_url\x18\x06 \x01(\tR\abaseUrl\x1a9\n" +
+	"\x05Phone\x120\n" +
+	"\x14default_calling_code\x18\x01 \x01(\tR\x12defaultCallingCodeB!Z\x1fuser-service/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_conf_proto_rawDescOnce sync.Once
@@ -1002,7 +2550,7 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_conf_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
 	(*Server)(nil),              // 1: kratos.api.Server
@@ -1010,16 +2558,36 @@ var file_conf_conf_proto_goTypes = []any{
 	(*Jwt)(nil),                 // 3: kratos.api.Jwt
 	(*Auth)(nil),                // 4: kratos.api.Auth
 	(*Data)(nil),                // 5: kratos.api.Data
-	(*Server_HTTP)(nil),         // 6: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 7: kratos.api.Server.GRPC
-	(*Auth_FaceBook)(nil),       // 8: kratos.api.Auth.FaceBook
-	(*Auth_Google)(nil),         // 9: kratos.api.Auth.Google
-	(*Auth_Apple)(nil),          // 10: kratos.api.Auth.Apple
-	(*Auth_SnapChat)(nil),       // 11: kratos.api.Auth.SnapChat
-	(*Auth_Sms)(nil),            // 12: kratos.api.Auth.Sms
-	(*Data_Database)(nil),       // 13: kratos.api.Data.Database
-	(*Data_Redis)(nil),          // 14: kratos.api.Data.Redis
-	(*durationpb.Duration)(nil), // 15: google.protobuf.Duration
+	(*Avatar)(nil),              // 6: kratos.api.Avatar
+	(*User)(nil),                // 7: kratos.api.User
+	(*Server_HTTP)(nil),         // 8: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),         // 9: kratos.api.Server.GRPC
+	(*Auth_FaceBook)(nil),       // 10: kratos.api.Auth.FaceBook
+	(*Auth_Google)(nil),         // 11: kratos.api.Auth.Google
+	(*Auth_Apple)(nil),          // 12: kratos.api.Auth.Apple
+	(*Auth_SnapChat)(nil),       // 13: kratos.api.Auth.SnapChat
+	(*Auth_Sms)(nil),            // 14: kratos.api.Auth.Sms
+	(*Auth_OIDC)(nil),           // 15: kratos.api.Auth.OIDC
+	(*Auth_Wechat)(nil),         // 16: kratos.api.Auth.Wechat
+	(*Auth_Microsoft)(nil),      // 17: kratos.api.Auth.Microsoft
+	(*Auth_X)(nil),              // 18: kratos.api.Auth.X
+	(*Auth_TikTok)(nil),         // 19: kratos.api.Auth.TikTok
+	(*Auth_Firebase)(nil),       // 20: kratos.api.Auth.Firebase
+	(*Auth_Linking)(nil),        // 21: kratos.api.Auth.Linking
+	(*Auth_Guest)(nil),          // 22: kratos.api.Auth.Guest
+	(*Auth_OIDC_Claims)(nil),    // 23: kratos.api.Auth.OIDC.Claims
+	(*Auth_Wechat_App)(nil),     // 24: kratos.api.Auth.Wechat.App
+	(*Data_Database)(nil),       // 25: kratos.api.Data.Database
+	(*Data_Redis)(nil),          // 26: kratos.api.Data.Redis
+	(*Data_Crypto)(nil),         // 27: kratos.api.Data.Crypto
+	(*Data_Storage)(nil),        // 28: kratos.api.Data.Storage
+	(*Data_Storage_Local)(nil),  // 29: kratos.api.Data.Storage.Local
+	(*Data_Storage_S3)(nil),     // 30: kratos.api.Data.Storage.S3
+	(*User_Handle)(nil),         // 31: kratos.api.User.Handle
+	(*User_Deletion)(nil),       // 32: kratos.api.User.Deletion
+	(*User_Export)(nil),         // 33: kratos.api.User.Export
+	(*User_Phone)(nil),          // 34: kratos.api.User.Phone
+	(*durationpb.Duration)(nil), // 35: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
@@ -1027,25 +2595,59 @@ var file_conf_conf_proto_depIdxs = []int32{
 	3,  // 2: kratos.api.Bootstrap.jwt:type_name -> kratos.api.Jwt
 	4,  // 3: kratos.api.Bootstrap.auth:type_name -> kratos.api.Auth
 	5,  // 4: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
-	6,  // 5: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	7,  // 6: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	8,  // 7: kratos.api.Auth.facebook:type_name -> kratos.api.Auth.FaceBook
-	9,  // 8: kratos.api.Auth.google:type_name -> kratos.api.Auth.Google
-	10, // 9: kratos.api.Auth.apple:type_name -> kratos.api.Auth.Apple
-	11, // 10: kratos.api.Auth.snapchat:type_name -> kratos.api.Auth.SnapChat
-	12, // 11: kratos.api.Auth.sms:type_name -> kratos.api.Auth.Sms
-	13, // 12: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	14, // 13: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	15, // 14: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	15, // 15: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	15, // 16: kratos.api.Data.Redis.dial_timeout:type_name -> google.protobuf.Duration
-	15, // 17: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	15, // 18: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	6,  // 5: kratos.api.Bootstrap.avatar:type_name -> kratos.api.Avatar
+	7,  // 6: kratos.api.Bootstrap.user:type_name -> kratos.api.User
+	8,  // 7: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	9,  // 8: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	10, // 9: kratos.api.Auth.facebook:type_name -> kratos.api.Auth.FaceBook
+	11, // 10: kratos.api.Auth.google:type_name -> kratos.api.Auth.Google
+	12, // 11: kratos.api.Auth.apple:type_name -> kratos.api.Auth.Apple
+	13, // 12: kratos.api.Auth.snapchat:type_name -> kratos.api.Auth.SnapChat
+	14, // 13: kratos.api.Auth.sms:type_name -> kratos.api.Auth.Sms
+	15, // 14: kratos.api.Auth.oidc:type_name -> kratos.api.Auth.OIDC
+	16, // 15: kratos.api.Auth.wechat:type_name -> kratos.api.Auth.Wechat
+	17, // 16: kratos.api.Auth.microsoft:type_name -> kratos.api.Auth.Microsoft
+	18, // 17: kratos.api.Auth.x:type_name -> kratos.api.Auth.X
+	19, // 18: kratos.api.Auth.tiktok:type_name -> kratos.api.Auth.TikTok
+	20, // 19: kratos.api.Auth.firebase:type_name -> kratos.api.Auth.Firebase
+	21, // 20: kratos.api.Auth.linking:type_name -> kratos.api.Auth.Linking
+	22, // 21: kratos.api.Auth.guest:type_name -> kratos.api.Auth.Guest
+	25, // 22: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	26, // 23: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	27, // 24: kratos.api.Data.crypto:type_name -> kratos.api.Data.Crypto
+	28, // 25: kratos.api.Data.storage:type_name -> kratos.api.Data.Storage
+	28, // 26: kratos.api.Data.export_storage:type_name -> kratos.api.Data.Storage
+	35, // 27: kratos.api.Avatar.fetch_timeout:type_name -> google.protobuf.Duration
+	31, // 28: kratos.api.User.handle:type_name -> kratos.api.User.Handle
+	32, // 29: kratos.api.User.deletion:type_name -> kratos.api.User.Deletion
+	33, // 30: kratos.api.User.export:type_name -> kratos.api.User.Export
+	34, // 31: kratos.api.User.phone:type_name -> kratos.api.User.Phone
+	35, // 32: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	35, // 33: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	23, // 34: kratos.api.Auth.OIDC.claims:type_name -> kratos.api.Auth.OIDC.Claims
+	24, // 35: kratos.api.Auth.Wechat.app:type_name -> kratos.api.Auth.Wechat.App
+	24, // 36: kratos.api.Auth.Wechat.mini_program:type_name -> kratos.api.Auth.Wechat.App
+	35, // 37: kratos.api.Auth.Guest.ttl:type_name -> google.protobuf.Duration
+	35, // 38: kratos.api.Auth.Guest.cleanup_interval:type_name -> google.protobuf.Duration
+	35, // 39: kratos.api.Data.Redis.dial_timeout:type_name -> google.protobuf.Duration
+	35, // 40: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	35, // 41: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	29, // 42: kratos.api.Data.Storage.local:type_name -> kratos.api.Data.Storage.Local
+	30, // 43: kratos.api.Data.Storage.s3:type_name -> kratos.api.Data.Storage.S3
+	35, // 44: kratos.api.User.Handle.change_interval:type_name -> google.protobuf.Duration
+	35, // 45: kratos.api.User.Handle.hold_period:type_name -> google.protobuf.Duration
+	35, // 46: kratos.api.User.Deletion.grace_period:type_name -> google.protobuf.Duration
+	35, // 47: kratos.api.User.Deletion.recent_auth:type_name -> google.protobuf.Duration
+	35, // 48: kratos.api.User.Deletion.purge_interval:type_name -> google.protobuf.Duration
+	35, // 49: kratos.api.User.Export.link_ttl:type_name -> google.protobuf.Duration
+	35, // 50: kratos.api.User.Export.retention:type_name -> google.protobuf.Duration
+	35, // 51: kratos.api.User.Export.request_interval:type_name -> google.protobuf.Duration
+	35, // 52: kratos.api.User.Export.interval:type_name -> google.protobuf.Duration
+	53, // [53:53] is the sub-list for method output_type
+	53, // [53:53] is the sub-list for method input_type
+	53, // [53:53] is the sub-list for extension type_name
+	53, // [53:53] is the sub-list for extension extendee
+	0,  // [0:53] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -1059,7 +2661,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,62 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"user-service/internal/biz"
+	"user-service/internal/data/ent"
+	"user-service/internal/data/ent/applenotification"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// appleNotificationRepo 实现 Apple 通知审计仓储
+type appleNotificationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewAppleNotificationRepo 创建新的 Apple 通知审计仓储
+func NewAppleNotificationRepo(data *Data, logger log.Logger) biz.AppleNotificationRepo {
+	return &appleNotificationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Save 记录收到的事件及处理结果
+func (r *appleNotificationRepo) Save(ctx context.Context, event *biz.AppleEvent, payload, result string) error {
+	create := r.data.db.AppleNotification.Create().
+		SetEventType(event.Type).
+		SetSubject(event.Subject).
+		SetEmail(event.Email).
+		SetIsPrivateEmail(event.IsPrivateEmail).
+		SetPayload(payload).
+		SetResult(result)
+	if !event.EventTime.IsZero() {
+		create.SetEventTime(event.EventTime)
+	}
+	_, err := create.Save(ctx)
+
+	return err
+}
+
+// LatestEventTime 返回该 Apple ID 最近一次处理成功的事件时间, 没有时返回零值
+func (r *appleNotificationRepo) LatestEventTime(ctx context.Context, subject string) (time.Time, error) {
+	n, err := r.data.db.AppleNotification.Query().
+		Where(
+			applenotification.Subject(subject),
+			applenotification.Result(biz.AppleResultOK),
+			applenotification.EventTimeNotNil(),
+		).
+		Order(ent.Desc(applenotification.FieldEventTime)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return *n.EventTime, nil
+}
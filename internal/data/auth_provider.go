@@ -2,10 +2,13 @@ package data
 
 import (
 	"context"
+	"fmt"
 
 	"user-service/internal/biz"
 	"user-service/internal/data/ent"
 	"user-service/internal/data/ent/authprovider"
+	"user-service/internal/data/ent/user"
+	"user-service/internal/data/ent/wechataccount"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -32,110 +35,261 @@ func (r *authProviderRepo) Create(ctx context.Context, proType, id string, userI
 		SetProviderID(id).
 		SetUser(userInfo).
 		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return biz.ErrProviderAlreadyLinked
+	}
+	if err != nil {
+		return err
+	}
+	// 清除之前缓存的不存在结果
+	r.data.delCache(ctx, userProviderKey(proType, id))
 
-	return err
+	return nil
 }
 
-// FindByGoogleID 根据Google ID查找用户
-func (r *authProviderRepo) FindByGoogleID(ctx context.Context, googleID string) (*biz.User, error) {
-	authProvider, err := r.data.db.AuthProvider.Query().
+// Delete 删除第三方登录关联
+func (r *authProviderRepo) Delete(ctx context.Context, providerType, providerID string) error {
+	_, err := r.data.db.AuthProvider.Delete().
 		Where(
-			authprovider.ProviderType("google"),
-			authprovider.ProviderID(googleID),
+			authprovider.ProviderType(providerType),
+			authprovider.ProviderID(providerID),
 		).
-		WithUser().
-		First(ctx)
+		Exec(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
+	r.data.delCache(ctx, userProviderKey(providerType, providerID))
 
-	userInfo := authProvider.Edges.User
-	return &biz.User{
-		ID:        userInfo.ID,
-		UserID:    userInfo.UserID,
-		Name:      userInfo.Name,
-		Email:     userInfo.Email,
-		Phone:     userInfo.Phone,
-		Avatar:    userInfo.Avatar,
-		CreatedAt: userInfo.CreatedAt,
-		UpdatedAt: userInfo.UpdatedAt,
-	}, nil
+	return nil
 }
 
-// FindByAppleID 根据Apple ID查找用户
-func (r *authProviderRepo) FindByAppleID(ctx context.Context, appleID string) (*biz.User, error) {
-	authProvider, err := r.data.db.AuthProvider.Query().
-		Where(
-			authprovider.ProviderType("apple"),
-			authprovider.ProviderID(appleID),
-		).
-		WithUser().
-		First(ctx)
+// Unlink 锁定用户后解除某种登录方式, 不能解除最后一个登录方式
+func (r *authProviderRepo) Unlink(ctx context.Context, userID int64, providerType string) error {
+	tx, err := r.data.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	providerID, err := unlink(ctx, tx, userID, providerType)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	r.data.delCache(ctx, userProviderKey(providerType, providerID))
+
+	return nil
+}
+
+func unlink(ctx context.Context, tx *ent.Tx, userID int64, providerType string) (string, error) {
+	u, err := tx.User.Query().Where(user.UserID(userID)).ForUpdate().Only(ctx)
+	if ent.IsNotFound(err) {
+		return "", biz.ErrUserNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+	providers, err := tx.AuthProvider.Query().Where(authprovider.UID(u.ID)).All(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var target *ent.AuthProvider
+	for _, p := range providers {
+		if p.ProviderType == providerType {
+			target = p
+			break
+		}
+	}
+	if target == nil {
+		return "", biz.ErrProviderNotLinked
+	}
+	// 手机号验证码登录也算一个登录方式
+	methods := len(providers)
+	if u.Phone != nil {
+		methods++
+	}
+	if methods <= 1 {
+		return "", biz.ErrLastLoginMethod
+	}
+
+	if err = tx.AuthProvider.DeleteOne(target).Exec(ctx); err != nil {
+		return "", err
+	}
+	if providerType == "wechat" {
+		if _, err = tx.WechatAccount.Delete().Where(wechataccount.UID(u.ID)).Exec(ctx); err != nil {
+			return "", err
+		}
+	}
+	return target.ProviderID, nil
+}
+
+// ListByUser 列出用户关联的第三方登录方式
+func (r *authProviderRepo) ListByUser(ctx context.Context, userID int64) ([]*biz.LinkedProvider, error) {
+	rows, err := r.data.db.AuthProvider.Query().
+		Where(authprovider.HasUserWith(user.UserID(userID))).
+		Order(ent.Asc(authprovider.FieldID)).
+		All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	userInfo := authProvider.Edges.User
-	return &biz.User{
-		ID:        userInfo.ID,
-		UserID:    userInfo.UserID,
-		Name:      userInfo.Name,
-		Email:     userInfo.Email,
-		Phone:     userInfo.Phone,
-		Avatar:    userInfo.Avatar,
-		CreatedAt: userInfo.CreatedAt,
-		UpdatedAt: userInfo.UpdatedAt,
-	}, nil
+	providers := make([]*biz.LinkedProvider, 0, len(rows))
+	for _, row := range rows {
+		providers = append(providers, r.toLinkedProvider(row))
+	}
+	return providers, nil
 }
 
-// FindByFacebookID 根据Facebook ID查找用户
-func (r *authProviderRepo) FindByFacebookID(ctx context.Context, facebookID string) (*biz.User, error) {
-	authProvider, err := r.data.db.AuthProvider.Query().
-		Where(
-			authprovider.ProviderType("facebook"),
-			authprovider.ProviderID(facebookID),
-		).
+// ListByUsers 批量列出关联的第三方登录方式, 按 user_id 分组
+func (r *authProviderRepo) ListByUsers(ctx context.Context, userIDs []int64) (map[int64][]*biz.LinkedProvider, error) {
+	providers := make(map[int64][]*biz.LinkedProvider, len(userIDs))
+	if len(userIDs) == 0 {
+		return providers, nil
+	}
+	rows, err := r.data.db.AuthProvider.Query().
+		Where(authprovider.HasUserWith(user.UserIDIn(userIDs...))).
+		Order(ent.Asc(authprovider.FieldID)).
 		WithUser().
-		First(ctx)
+		All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	userInfo := authProvider.Edges.User
-	return &biz.User{
-		ID:        userInfo.ID,
-		UserID:    userInfo.UserID,
-		Name:      userInfo.Name,
-		Email:     userInfo.Email,
-		Phone:     userInfo.Phone,
-		Avatar:    userInfo.Avatar,
-		CreatedAt: userInfo.CreatedAt,
-		UpdatedAt: userInfo.UpdatedAt,
-	}, nil
+	for _, row := range rows {
+		userID := row.Edges.User.UserID
+		providers[userID] = append(providers[userID], r.toLinkedProvider(row))
+	}
+	return providers, nil
+}
+
+// toLinkedProvider 转换为业务层实体, 并解密令牌
+func (r *authProviderRepo) toLinkedProvider(row *ent.AuthProvider) *biz.LinkedProvider {
+	return &biz.LinkedProvider{
+		ProviderType:   row.ProviderType,
+		ProviderID:     row.ProviderID,
+		Email:          row.Email,
+		EmailVerified:  row.EmailVerified,
+		Name:           row.Name,
+		Avatar:         row.Avatar,
+		RawClaims:      row.RawClaims,
+		AccessToken:    r.data.decrypt(row.AccessToken),
+		RefreshToken:   r.data.decrypt(row.RefreshToken),
+		TokenExpiresAt: row.TokenExpiresAt,
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+	}
 }
 
-// FindBySnapchatID 根据Snapchat ID查找用户
-func (r *authProviderRepo) FindBySnapchatID(ctx context.Context, snapchatID string) (*biz.User, error) {
-	authProvider, err := r.data.db.AuthProvider.Query().
+// UpdateIdentity 保存关联账号的资料、原始声明和令牌
+func (r *authProviderRepo) UpdateIdentity(ctx context.Context, identity *biz.Identity) error {
+	update := r.data.db.AuthProvider.Update().
 		Where(
-			authprovider.ProviderType("snapchat"),
-			authprovider.ProviderID(snapchatID),
+			authprovider.ProviderType(identity.Provider),
+			authprovider.ProviderID(identity.Subject),
 		).
-		WithUser().
-		First(ctx)
+		SetEmail(identity.Email).
+		SetEmailVerified(identity.EmailVerified).
+		SetName(identity.Name).
+		SetAvatar(identity.Avatar)
+	if identity.RawClaims != nil {
+		update.SetRawClaims(identity.RawClaims)
+	}
+
+	// 令牌只在配置了加密密钥时保存, 空值不覆盖已有令牌
+	if identity.AccessToken != "" {
+		if token, ok := r.encrypt(identity.AccessToken); ok {
+			update.SetAccessToken(token)
+			if !identity.TokenExpiresAt.IsZero() {
+				update.SetTokenExpiresAt(identity.TokenExpiresAt)
+			}
+		}
+	}
+	if identity.RefreshToken != "" {
+		if token, ok := r.encrypt(identity.RefreshToken); ok {
+			update.SetRefreshToken(token)
+		}
+	}
+
+	_, err := update.Save(ctx)
+	return err
+}
+
+// encrypt 加密令牌, 未配置密钥或加密失败时返回 false
+func (r *authProviderRepo) encrypt(token string) (string, bool) {
+	if r.data.cipher == nil {
+		return "", false
+	}
+	encrypted, err := r.data.cipher.Encrypt(token)
 	if err != nil {
-		return nil, err
+		r.log.Errorf("failed to encrypt provider token, error: %v", err)
+		return "", false
 	}
+	return encrypted, true
+}
 
-	userInfo := authProvider.Edges.User
-	return &biz.User{
-		ID:        userInfo.ID,
-		UserID:    userInfo.UserID,
-		Name:      userInfo.Name,
-		Email:     userInfo.Email,
-		Phone:     userInfo.Phone,
-		Avatar:    userInfo.Avatar,
-		CreatedAt: userInfo.CreatedAt,
-		UpdatedAt: userInfo.UpdatedAt,
-	}, nil
+// UpdateRefreshToken 保存第三方 refresh_token
+func (r *authProviderRepo) UpdateRefreshToken(ctx context.Context, providerType, providerID, refreshToken string) error {
+	// 空值用于撤销后清除令牌
+	if refreshToken != "" {
+		// 不保存的话注销账号和解除关联时无法撤销授权, 由调用方处理
+		encrypted, ok := r.encrypt(refreshToken)
+		if !ok {
+			return fmt.Errorf("failed to encrypt %s refresh token, check data.crypto.key", providerType)
+		}
+		refreshToken = encrypted
+	}
+
+	_, err := r.data.db.AuthProvider.Update().
+		Where(
+			authprovider.ProviderType(providerType),
+			authprovider.ProviderID(providerID),
+		).
+		SetRefreshToken(refreshToken).
+		Save(ctx)
+
+	return err
+}
+
+// FindByProvider 根据登录方式和第三方ID查找用户, 优先读缓存
+func (r *authProviderRepo) FindByProvider(ctx context.Context, providerType, providerID string) (*biz.User, error) {
+	// 合并账号时登录方式转移到目标账号, 旧账号上的缓存视为失效
+	valid := func(u *biz.User) bool {
+		return u.MergedInto == 0
+	}
+	key := userProviderKey(providerType, providerID)
+	return r.data.cachedUserBy(ctx, cacheUserProvider, key, valid, func(ctx context.Context) (*ent.User, error) {
+		authProvider, err := r.data.db.AuthProvider.Query().
+			Where(
+				authprovider.ProviderType(providerType),
+				authprovider.ProviderID(providerID),
+			).
+			WithUser().
+			First(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return authProvider.Edges.User, nil
+	})
+}
+
+// FindByGoogleID 根据Google ID查找用户
+func (r *authProviderRepo) FindByGoogleID(ctx context.Context, googleID string) (*biz.User, error) {
+	return r.FindByProvider(ctx, "google", googleID)
+}
+
+// FindByAppleID 根据Apple ID查找用户
+func (r *authProviderRepo) FindByAppleID(ctx context.Context, appleID string) (*biz.User, error) {
+	return r.FindByProvider(ctx, "apple", appleID)
+}
+
+// FindByFacebookID 根据Facebook ID查找用户
+func (r *authProviderRepo) FindByFacebookID(ctx context.Context, facebookID string) (*biz.User, error) {
+	return r.FindByProvider(ctx, "facebook", facebookID)
+}
+
+// FindBySnapchatID 根据Snapchat ID查找用户
+func (r *authProviderRepo) FindBySnapchatID(ctx context.Context, snapchatID string) (*biz.User, error) {
+	return r.FindByProvider(ctx, "snapchat", snapchatID)
 }
@@ -0,0 +1,96 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"user-service/internal/biz"
+	"user-service/internal/data/ent"
+	"user-service/internal/data/ent/authprovider"
+)
+
+func TestUnlinkLastLoginMethod(t *testing.T) {
+	td := newTestData(t)
+	users := newTestUserRepo(td)
+	repo := NewAuthProviderRepo(td.Data, td.logger)
+	ctx := context.Background()
+
+	created, err := users.Create(ctx, &biz.User{Name: "user", Phone: "+8613800138000"})
+	if err != nil {
+		t.Fatalf("error creating user, %s", err)
+	}
+	u, err := users.FindByIDOrigin(ctx, created.UserID)
+	if err != nil {
+		t.Fatalf("error finding user, %s", err)
+	}
+	for _, p := range []string{"google", "apple"} {
+		if err = repo.Create(ctx, p, p+"-id", u); err != nil {
+			t.Fatalf("error linking %s, %s", p, err)
+		}
+	}
+
+	if err = repo.Unlink(ctx, u.UserID, "wechat"); err != biz.ErrProviderNotLinked {
+		t.Fatalf("expected ErrProviderNotLinked, got %v", err)
+	}
+	// 两个第三方加手机号, 可以依次解除到只剩手机号
+	for _, p := range []string{"google", "apple"} {
+		if err = repo.Unlink(ctx, u.UserID, p); err != nil {
+			t.Fatalf("error unlinking %s, %s", p, err)
+		}
+	}
+
+	// 只剩一个第三方登录方式时不能解除
+	if _, err = td.db.User.UpdateOne(u).ClearPhone().Save(ctx); err != nil {
+		t.Fatalf("error clearing phone, %s", err)
+	}
+	if err = repo.Create(ctx, "google", "google-id", u); err != nil {
+		t.Fatalf("error relinking google, %s", err)
+	}
+	if err = repo.Unlink(ctx, u.UserID, "google"); err != biz.ErrLastLoginMethod {
+		t.Fatalf("expected ErrLastLoginMethod, got %v", err)
+	}
+	exists, err := td.db.AuthProvider.Query().Where(authprovider.UID(u.ID), authprovider.ProviderType("google")).Exist(ctx)
+	if err != nil || !exists {
+		t.Fatalf("expected the last provider to be kept, got %v %v", exists, err)
+	}
+}
+
+func TestUnlinkKeepsConcurrentLink(t *testing.T) {
+	td := newTestData(t)
+	users := newTestUserRepo(td)
+	repo := NewAuthProviderRepo(td.Data, td.logger)
+	ctx := context.Background()
+
+	created, err := users.Create(ctx, &biz.User{Name: "user"})
+	if err != nil {
+		t.Fatalf("error creating user, %s", err)
+	}
+	u, err := users.FindByIDOrigin(ctx, created.UserID)
+	if err != nil {
+		t.Fatalf("error finding user, %s", err)
+	}
+	if err = repo.Create(ctx, "google", "google-id", u); err != nil {
+		t.Fatalf("error linking google, %s", err)
+	}
+	// 解除前另一个请求刚关联了新的登录方式, 锁定后按最新的登录方式计数
+	td.drv.onNextTx(func() {
+		if err := repo.Create(ctx, "apple", "apple-id", u); err != nil {
+			t.Errorf("error linking apple, %s", err)
+		}
+	})
+	if err = repo.Unlink(ctx, u.UserID, "google"); err != nil {
+		t.Fatalf("expected unlink to succeed with the new provider, got %v", err)
+	}
+	left := td.db.AuthProvider.Query().Where(authprovider.UID(u.ID)).AllX(ctx)
+	if len(left) != 1 || left[0].ProviderType != "apple" {
+		t.Fatalf("expected only apple to be left, got %v", providerTypes(left))
+	}
+}
+
+func providerTypes(providers []*ent.AuthProvider) []string {
+	types := make([]string, 0, len(providers))
+	for _, p := range providers {
+		types = append(types, p.ProviderType)
+	}
+	return types
+}
@@ -0,0 +1,131 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"user-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// errForbiddenAddress 拒绝访问内网地址, 防止通过头像地址探测内部服务
+var errForbiddenAddress = errors.New("forbidden address")
+
+// avatarRepo 实现头像下载和存储
+type avatarRepo struct {
+	data       *Data
+	httpClient *http.Client
+	log        *log.Helper
+}
+
+// NewAvatarRepo 创建新的头像仓储
+func NewAvatarRepo(data *Data, logger log.Logger) biz.AvatarRepo {
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+				ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsMulticast() {
+				return fmt.Errorf("%w: %s", errForbiddenAddress, host)
+			}
+			return nil
+		},
+	}
+	return &avatarRepo{
+		data: data,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+			// 不走环境变量中的代理, 保证连接地址经过上面的检查
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: 5 * time.Second,
+				MaxIdleConns:        10,
+			},
+		},
+		log: log.NewHelper(logger),
+	}
+}
+
+// Enabled 是否配置了对象存储
+func (r *avatarRepo) Enabled() bool {
+	return r.data.blob != nil
+}
+
+// Fetch 下载第三方头像, 超过 maxBytes 时返回 biz.ErrAvatarTooLarge
+func (r *avatarRepo) Fetch(ctx context.Context, rawURL string, maxBytes int64) ([]byte, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") {
+		return nil, fmt.Errorf("invalid avatar url: %s", rawURL)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("avatar returned status code: %d", resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "" && !strings.HasPrefix(ct, "image/") {
+		return nil, fmt.Errorf("avatar returned content type: %s", ct)
+	}
+	if resp.ContentLength > maxBytes {
+		return nil, biz.ErrAvatarTooLarge
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, biz.ErrAvatarTooLarge
+	}
+	return data, nil
+}
+
+// Put 写入对象存储并返回访问地址
+func (r *avatarRepo) Put(ctx context.Context, key, contentType string, data []byte) (string, error) {
+	if r.data.blob == nil {
+		return "", biz.ErrAvatarStorageDisabled
+	}
+	if err := r.data.blob.Put(ctx, key, contentType, data); err != nil {
+		return "", err
+	}
+	return r.data.blob.URL(key), nil
+}
+
+// IsStored 头像地址是否指向我们自己的存储
+func (r *avatarRepo) IsStored(avatarURL string) bool {
+	if r.data.blob == nil || avatarURL == "" {
+		return false
+	}
+	return strings.HasPrefix(avatarURL, r.data.blob.URL(""))
+}
+
+// Delete 删除存储中的头像
+func (r *avatarRepo) Delete(ctx context.Context, avatarURL string) error {
+	if !r.IsStored(avatarURL) {
+		return nil
+	}
+	return r.data.blob.Delete(ctx, strings.TrimPrefix(avatarURL, r.data.blob.URL("")))
+}
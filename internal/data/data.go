@@ -6,6 +6,8 @@ import (
 
 	"user-service/internal/conf"
 	"user-service/internal/data/ent"
+	"user-service/third_party/blob"
+	"user-service/third_party/secret"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -16,18 +18,28 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/singleflight"
 
 	// init mysql driver
 	_ "github.com/go-sql-driver/mysql"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewUserRepo, NewAuthProviderRepo, NewGreeterRepo)
+var ProviderSet = wire.NewSet(NewData, NewUserRepo, NewAuthProviderRepo, NewWechatRepo, NewAppleNotificationRepo, NewSessionRepo, NewAvatarRepo, NewHandleRepo, NewDeletionRepo, NewExportRepo, NewGreeterRepo)
 
 // Data .
 type Data struct {
 	db  *ent.Client
 	rdb *redis.Client
+	// cipher 加密第三方令牌, 未配置密钥时为 nil
+	cipher *secret.Cipher
+	// blob 对象存储, 未配置时为 nil
+	blob blob.Store
+	// exportBlob 保存数据导出的私有存储, 未配置时为 nil
+	exportBlob blob.Store
+	// sf 合并缓存未命中时对同一个 key 的并发查询
+	sf  singleflight.Group
+	log *log.Helper
 }
 
 // NewData .
@@ -55,9 +67,13 @@ func NewData(conf *conf.Data, logger log.Logger) (*Data, func(), error) {
 		logInfo.Errorf("failed opening connection to sqlite: %v", err)
 		return nil, nil, err
 	}
-	// Run the auto migration tool.
-	if err := client.Schema.Create(context.Background()); err != nil {
-		logInfo.Errorf("failed creating schema resources: %v", err)
+	// 表结构由 migrate 子命令维护, 启动时只检查版本和结构
+	if err := checkSchemaVersion(context.Background(), drv.DB()); err != nil {
+		logInfo.Errorf("failed checking schema version: %v", err)
+		return nil, nil, err
+	}
+	if err := checkSchema(context.Background(), drv.DB()); err != nil {
+		logInfo.Errorf("failed checking schema: %v", err)
 		return nil, nil, err
 	}
 
@@ -70,9 +86,33 @@ func NewData(conf *conf.Data, logger log.Logger) (*Data, func(), error) {
 		ReadTimeout:  conf.Redis.ReadTimeout.AsDuration(),
 	})
 	rdb.AddHook(redisotel.TracingHook{})
+
+	var cipher *secret.Cipher
+	if key := conf.GetCrypto().GetKey(); key != "" {
+		if cipher, err = secret.NewCipher(key); err != nil {
+			logInfo.Errorf("failed creating cipher: %v", err)
+			return nil, nil, err
+		}
+	}
+
+	store, err := newBlobStore(conf.GetStorage())
+	if err != nil {
+		logInfo.Errorf("failed creating blob store: %v", err)
+		return nil, nil, err
+	}
+	exportStore, err := newBlobStore(conf.GetExportStorage())
+	if err != nil {
+		logInfo.Errorf("failed creating export blob store: %v", err)
+		return nil, nil, err
+	}
+
 	d := &Data{
-		db:  client,
-		rdb: rdb,
+		db:         client,
+		rdb:        rdb,
+		cipher:     cipher,
+		blob:       store,
+		exportBlob: exportStore,
+		log:        logInfo,
 	}
 	return d, func() {
 		logInfo.Info("message", "closing the data resources")
@@ -84,3 +124,45 @@ func NewData(conf *conf.Data, logger log.Logger) (*Data, func(), error) {
 		}
 	}, nil
 }
+
+// newBlobStore 按配置创建对象存储, 未配置时返回 nil
+func newBlobStore(c *conf.Data_Storage) (blob.Store, error) {
+	switch c.GetDriver() {
+	case "":
+		return nil, nil
+	case "local":
+		if c.GetLocal().GetDir() == "" {
+			return nil, fmt.Errorf("storage local dir is required")
+		}
+		return blob.NewLocalStore(c.GetLocal().GetDir(), c.GetLocal().GetBaseUrl()), nil
+	case "s3":
+		s3 := c.GetS3()
+		return blob.NewS3Store(blob.S3Config{
+			Endpoint:  s3.GetEndpoint(),
+			Region:    s3.GetRegion(),
+			Bucket:    s3.GetBucket(),
+			AccessKey: s3.GetAccessKey(),
+			SecretKey: s3.GetSecretKey(),
+			PathStyle: s3.GetPathStyle(),
+			BaseURL:   s3.GetBaseUrl(),
+		}, nil)
+	default:
+		return nil, fmt.Errorf("unknown storage driver: %s", c.GetDriver())
+	}
+}
+
+// decrypt 解密第三方令牌, 兼容加密之前保存的明文
+func (d *Data) decrypt(token string) string {
+	if !secret.IsEncrypted(token) {
+		return token
+	}
+	if d.cipher == nil {
+		return ""
+	}
+	plaintext, err := d.cipher.Decrypt(token)
+	if err != nil {
+		d.log.Errorf("failed to decrypt provider token, error: %v", err)
+		return ""
+	}
+	return plaintext
+}
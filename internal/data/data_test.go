@@ -0,0 +1,309 @@
+package data
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"user-service/internal/data/ent"
+	"user-service/third_party/snowflake"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+
+	// init sqlite driver
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// testData 测试用的 Data, 数据库是临时目录中的 SQLite, Redis 是进程内的 fakeRedis
+type testData struct {
+	*Data
+	drv    *testDriver
+	redis  *fakeRedis
+	logger log.Logger
+	uidGen *snowflake.Node
+}
+
+func newTestData(t *testing.T) *testData {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?_fk=1&_journal_mode=WAL&_busy_timeout=5000", filepath.Join(t.TempDir(), "test.db"))
+	sqlDrv, err := entsql.Open(dialect.SQLite, dsn)
+	if err != nil {
+		t.Fatalf("error opening sqlite, %s", err)
+	}
+	t.Cleanup(func() { _ = sqlDrv.Close() })
+	if err = ent.NewClient(ent.Driver(sqlDrv)).Schema.Create(context.Background()); err != nil {
+		t.Fatalf("error creating schema, %s", err)
+	}
+	drv := &testDriver{Driver: sqlDrv}
+	client := ent.NewClient(ent.Driver(drv))
+
+	fake := newFakeRedis(t)
+	rdb := redis.NewClient(&redis.Options{Addr: fake.addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	uidGen, err := snowflake.NewNode(1)
+	if err != nil {
+		t.Fatalf("error creating snowflake node, %s", err)
+	}
+	logger := log.NewStdLogger(io.Discard)
+	return &testData{
+		Data: &Data{
+			db:  client,
+			rdb: rdb,
+			log: log.NewHelper(logger),
+		},
+		drv:    drv,
+		redis:  fake,
+		logger: logger,
+		uidGen: uidGen,
+	}
+}
+
+// testDriver 让 ent 按 MySQL 生成 SQL 并去掉 SQLite 不支持的行锁, 与线上的语句一致;
+// 同时允许在下一个事务开始前插入并发写入
+type testDriver struct {
+	*entsql.Driver
+
+	mu       sync.Mutex
+	beforeTx func()
+}
+
+// onNextTx 在下一个事务开始前执行一次 f, 模拟先提交的并发请求
+func (d *testDriver) onNextTx(f func()) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.beforeTx = f
+}
+
+func (d *testDriver) Dialect() string {
+	return dialect.MySQL
+}
+
+func (d *testDriver) Exec(ctx context.Context, query string, args, v any) error {
+	return d.Driver.Exec(ctx, withoutLock(query), args, v)
+}
+
+func (d *testDriver) Query(ctx context.Context, query string, args, v any) error {
+	return d.Driver.Query(ctx, withoutLock(query), args, v)
+}
+
+func (d *testDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	d.mu.Lock()
+	f := d.beforeTx
+	d.beforeTx = nil
+	d.mu.Unlock()
+	if f != nil {
+		f()
+	}
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &testTx{Tx: tx}, nil
+}
+
+type testTx struct {
+	dialect.Tx
+}
+
+func (tx *testTx) Exec(ctx context.Context, query string, args, v any) error {
+	return tx.Tx.Exec(ctx, withoutLock(query), args, v)
+}
+
+func (tx *testTx) Query(ctx context.Context, query string, args, v any) error {
+	return tx.Tx.Query(ctx, withoutLock(query), args, v)
+}
+
+func withoutLock(query string) string {
+	if i := strings.Index(query, " FOR UPDATE"); i >= 0 {
+		return query[:i]
+	}
+	return query
+}
+
+// fakeRedis 进程内的最小 Redis, 只支持数据层用到的 GET、MGET、SET、DEL 和 XADD, 不处理过期时间
+type fakeRedis struct {
+	ln net.Listener
+
+	mu      sync.Mutex
+	values  map[string]string
+	streams map[string][]map[string]string
+	// failStreams 为 true 时 XADD 返回错误, 模拟 Redis 不可用
+	failStreams bool
+}
+
+func newFakeRedis(t *testing.T) *fakeRedis {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error listening, %s", err)
+	}
+	r := &fakeRedis{
+		ln:      ln,
+		values:  make(map[string]string),
+		streams: make(map[string][]map[string]string),
+	}
+	t.Cleanup(func() { _ = ln.Close() })
+	go r.serve()
+	return r
+}
+
+func (r *fakeRedis) addr() string {
+	return r.ln.Addr().String()
+}
+
+// has 判断 key 是否存在
+func (r *fakeRedis) has(key string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, ok := r.values[key]
+	return ok
+}
+
+// setFailStreams 设置 XADD 是否返回错误
+func (r *fakeRedis) setFailStreams(fail bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failStreams = fail
+}
+
+// stream 返回写入 Stream 的全部消息
+func (r *fakeRedis) stream(key string) []map[string]string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]map[string]string(nil), r.streams[key]...)
+}
+
+func (r *fakeRedis) serve() {
+	for {
+		conn, err := r.ln.Accept()
+		if err != nil {
+			return
+		}
+		go r.handle(conn)
+	}
+}
+
+func (r *fakeRedis) handle(conn net.Conn) {
+	defer conn.Close()
+	rd := bufio.NewReader(conn)
+	for {
+		args, err := readCommand(rd)
+		if err != nil {
+			return
+		}
+		if _, err = conn.Write([]byte(r.exec(args))); err != nil {
+			return
+		}
+	}
+}
+
+func (r *fakeRedis) exec(args []string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	switch strings.ToLower(args[0]) {
+	case "ping":
+		return "+PONG\r\n"
+	case "get":
+		v, ok := r.values[args[1]]
+		if !ok {
+			return "$-1\r\n"
+		}
+		return bulk(v)
+	case "mget":
+		reply := fmt.Sprintf("*%d\r\n", len(args)-1)
+		for _, key := range args[1:] {
+			if v, ok := r.values[key]; ok {
+				reply += bulk(v)
+			} else {
+				reply += "$-1\r\n"
+			}
+		}
+		return reply
+	case "set":
+		r.values[args[1]] = args[2]
+		return "+OK\r\n"
+	case "del":
+		n := 0
+		for _, key := range args[1:] {
+			if _, ok := r.values[key]; ok {
+				delete(r.values, key)
+				n++
+			}
+		}
+		return fmt.Sprintf(":%d\r\n", n)
+	case "xadd":
+		if r.failStreams {
+			return "-ERR stream unavailable\r\n"
+		}
+		// XADD key [MAXLEN [~] n] id field value ...
+		i := 2
+		if strings.EqualFold(args[i], "maxlen") {
+			i += 2
+			if args[i-1] == "~" || args[i-1] == "=" {
+				i++
+			}
+		}
+		values := make(map[string]string)
+		for j := i + 1; j+1 < len(args); j += 2 {
+			values[args[j]] = args[j+1]
+		}
+		r.streams[args[1]] = append(r.streams[args[1]], values)
+		return bulk(fmt.Sprintf("%d-0", len(r.streams[args[1]])))
+	}
+	return fmt.Sprintf("-ERR unknown command '%s'\r\n", args[0])
+}
+
+func bulk(s string) string {
+	return fmt.Sprintf("$%d\r\n%s\r\n", len(s), s)
+}
+
+// readCommand 读取一条 RESP 数组格式的命令
+func readCommand(rd *bufio.Reader) ([]string, error) {
+	line, err := readLine(rd)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(line, "*") {
+		return nil, fmt.Errorf("unexpected command %q", line)
+	}
+	n, err := strconv.Atoi(line[1:])
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		line, err = readLine(rd)
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimPrefix(line, "$"))
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, size+2)
+		if _, err = io.ReadFull(rd, buf); err != nil {
+			return nil, err
+		}
+		args = append(args, string(buf[:size]))
+	}
+	return args, nil
+}
+
+func readLine(rd *bufio.Reader) (string, error) {
+	line, err := rd.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(line, "\r\n"), nil
+}
@@ -0,0 +1,239 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"user-service/internal/biz"
+	"user-service/internal/data/ent"
+	"user-service/internal/data/ent/authprovider"
+	"user-service/internal/data/ent/dataexport"
+	"user-service/internal/data/ent/user"
+	"user-service/internal/data/ent/userevent"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// userEventStream 用户事件的 Redis Stream, 下游服务按 consumer group 消费
+	userEventStream = "user:events"
+	// userEventStreamMaxLen Stream 保留的大致长度
+	userEventStreamMaxLen = 100000
+	// userDeletedEvent 账号已删除事件
+	userDeletedEvent = "user.deleted"
+)
+
+type deletionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewDeletionRepo 创建账号注销仓储
+func NewDeletionRepo(data *Data, logger log.Logger) biz.DeletionRepo {
+	return &deletionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Schedule 标记用户待注销
+func (r *deletionRepo) Schedule(ctx context.Context, userID int64, scheduledAt time.Time) error {
+	n, err := r.data.db.User.Update().
+		Where(user.UserID(userID), user.MergedIntoIsNil()).
+		SetDeletionRequestedAt(time.Now()).
+		SetDeletionScheduledAt(scheduledAt).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return biz.ErrUserNotFound
+	}
+	r.data.delUserCache(ctx, userID)
+	return nil
+}
+
+// Cancel 取消待注销状态
+func (r *deletionRepo) Cancel(ctx context.Context, userID int64) (bool, error) {
+	n, err := r.data.db.User.Update().
+		Where(user.UserID(userID), user.DeletionScheduledAtNotNil()).
+		ClearDeletionRequestedAt().
+		ClearDeletionScheduledAt().
+		Save(ctx)
+	if err != nil || n == 0 {
+		return false, err
+	}
+	r.data.delUserCache(ctx, userID)
+	return true, nil
+}
+
+// ListDue 列出冷静期已结束的用户
+func (r *deletionRepo) ListDue(ctx context.Context, before time.Time, limit int) ([]*biz.User, error) {
+	users, err := r.data.db.User.Query().
+		Where(user.DeletionScheduledAtLTE(before)).
+		Order(ent.Asc(user.FieldDeletionScheduledAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*biz.User, 0, len(users))
+	for _, u := range users {
+		result = append(result, toBizUser(u))
+	}
+	return result, nil
+}
+
+// Purge 在事务中重新确认待注销状态后删除用户及合并到该用户的旧账号, 写入事件, 之后删除缓存
+func (r *deletionRepo) Purge(ctx context.Context, userID int64, before time.Time) (*biz.PurgedAccount, error) {
+	tx, err := r.data.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	account, err := r.purgeUser(ctx, tx, userID, before)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if account == nil {
+		return nil, tx.Rollback()
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	r.data.delUserCache(ctx, account.UserIDs...)
+	return account, nil
+}
+
+// purgeUser 删除用户及合并到该用户的旧账号, 返回删除前的授权和文件; 不再满足删除条件时返回 nil
+func (r *deletionRepo) purgeUser(ctx context.Context, tx *ent.Tx, userID int64, before time.Time) (*biz.PurgedAccount, error) {
+	u, err := tx.User.Query().
+		Where(user.UserID(userID)).
+		ForUpdate().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// 冷静期内重新登录会清空计划时间
+	if u.DeletionScheduledAt == nil || u.DeletionScheduledAt.After(before) {
+		return nil, nil
+	}
+
+	merged, err := tx.User.Query().
+		Where(user.MergedInto(u.UserID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	account := &biz.PurgedAccount{}
+	var ids []int64
+	for _, m := range append([]*ent.User{u}, merged...) {
+		ids = append(ids, m.ID)
+		account.UserIDs = append(account.UserIDs, m.UserID)
+		if m.Avatar != "" {
+			account.Avatars = append(account.Avatars, m.Avatar)
+		}
+	}
+
+	providers, err := tx.AuthProvider.Query().
+		Where(authprovider.UIDIn(ids...), authprovider.RefreshTokenNEQ("")).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range providers {
+		account.Providers = append(account.Providers, &biz.LinkedProvider{
+			ProviderType: p.ProviderType,
+			ProviderID:   p.ProviderID,
+			RefreshToken: r.data.decrypt(p.RefreshToken),
+		})
+	}
+	exports, err := tx.DataExport.Query().
+		Where(dataexport.UIDIn(ids...), dataexport.ObjectKeyNEQ("")).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range exports {
+		account.ExportKeys = append(account.ExportKeys, e.ObjectKey)
+	}
+
+	if _, err = deleteUsers(ctx, tx, ids); err != nil {
+		return nil, err
+	}
+	// 合并过来的旧账号也发布删除事件, 下游服务可能仍保存其数据
+	now := time.Now()
+	events := make([]*ent.UserEventCreate, 0, len(account.UserIDs))
+	for _, id := range account.UserIDs {
+		events = append(events, tx.UserEvent.Create().
+			SetEventType(userDeletedEvent).
+			SetUserID(id).
+			SetOccurredAt(now))
+	}
+	if err = tx.UserEvent.CreateBulk(events...).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// PublishEvents 把待发布的事件写入 Stream 后标记已发布
+// 写入 Stream 后标记失败时会重复发布, 下游按 event_id 去重
+func (r *deletionRepo) PublishEvents(ctx context.Context, limit int) (int, error) {
+	tx, err := r.data.db.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	// 多个实例同时执行时跳过其他实例正在发布的事件
+	events, err := tx.UserEvent.Query().
+		Where(userevent.PublishedAtIsNil()).
+		Order(ent.Asc(userevent.FieldID)).
+		Limit(limit).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		All(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+	if len(events) == 0 {
+		return 0, tx.Rollback()
+	}
+
+	var published []int64
+	for _, e := range events {
+		err = r.data.rdb.XAdd(ctx, &redis.XAddArgs{
+			Stream: userEventStream,
+			MaxLen: userEventStreamMaxLen,
+			Approx: true,
+			Values: map[string]interface{}{
+				"event_id":   e.ID,
+				"type":       e.EventType,
+				"user_id":    e.UserID,
+				"deleted_at": e.OccurredAt.Unix(),
+			},
+		}).Err()
+		if err != nil {
+			break
+		}
+		published = append(published, e.ID)
+	}
+	// 已写入的部分照常标记, 其余下次重试
+	if len(published) > 0 {
+		if _, uerr := tx.UserEvent.Update().
+			Where(userevent.IDIn(published...)).
+			SetPublishedAt(time.Now()).
+			Save(ctx); uerr != nil {
+			_ = tx.Rollback()
+			return 0, uerr
+		}
+	}
+	if cerr := tx.Commit(); cerr != nil {
+		return 0, cerr
+	}
+	return len(published), err
+}
@@ -0,0 +1,142 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"user-service/internal/biz"
+	"user-service/internal/data/ent/user"
+	"user-service/internal/data/ent/userevent"
+)
+
+func TestPurgeSkipsCancelledDeletion(t *testing.T) {
+	td := newTestData(t)
+	repo := NewDeletionRepo(td.Data, td.logger)
+	ctx := context.Background()
+
+	u, err := newTestUserRepo(td).Create(ctx, &biz.User{Name: "user"})
+	if err != nil {
+		t.Fatalf("error creating user, %s", err)
+	}
+	if err = repo.Schedule(ctx, u.UserID, time.Now().Add(-time.Minute)); err != nil {
+		t.Fatalf("error scheduling deletion, %s", err)
+	}
+	due, err := repo.ListDue(ctx, time.Now(), 10)
+	if err != nil || len(due) != 1 {
+		t.Fatalf("expected 1 due user, got %d %v", len(due), err)
+	}
+
+	// 列出之后、清除之前用户重新登录取消了注销
+	td.drv.onNextTx(func() {
+		if _, err := repo.Cancel(ctx, u.UserID); err != nil {
+			t.Errorf("error cancelling deletion, %s", err)
+		}
+	})
+	account, err := repo.Purge(ctx, u.UserID, time.Now())
+	if err != nil {
+		t.Fatalf("error purging, %s", err)
+	}
+	if account != nil {
+		t.Fatalf("expected cancelled user not to be purged, got %+v", account)
+	}
+	if !td.db.User.Query().Where(user.UserID(u.UserID)).ExistX(ctx) {
+		t.Fatal("expected the user to be kept")
+	}
+	if n := td.db.UserEvent.Query().CountX(ctx); n != 0 {
+		t.Fatalf("expected no events, got %d", n)
+	}
+}
+
+func TestPurgeMergedAccountsPublishesEvents(t *testing.T) {
+	td := newTestData(t)
+	repo := NewDeletionRepo(td.Data, td.logger)
+	users := newTestUserRepo(td)
+	ctx := context.Background()
+
+	target, err := users.Create(ctx, &biz.User{Name: "target", Avatar: "https://cdn.example.com/avatars/1_512.jpg"})
+	if err != nil {
+		t.Fatalf("error creating user, %s", err)
+	}
+	merged, err := users.Create(ctx, &biz.User{Name: "merged", Avatar: "https://cdn.example.com/avatars/2_512.jpg"})
+	if err != nil {
+		t.Fatalf("error creating user, %s", err)
+	}
+	if _, err = td.db.User.UpdateOneID(merged.ID).SetMergedInto(target.UserID).Save(ctx); err != nil {
+		t.Fatalf("error marking merged, %s", err)
+	}
+	err = td.db.AuthProvider.Create().
+		SetProviderType("apple").
+		SetProviderID("apple-sub").
+		SetRefreshToken("refresh-token").
+		SetUID(merged.ID).
+		Exec(ctx)
+	if err != nil {
+		t.Fatalf("error linking apple, %s", err)
+	}
+	err = td.db.DataExport.Create().
+		SetExportID("export-1").
+		SetStatus(biz.ExportReady).
+		SetObjectKey("exports/1/export-1.zip").
+		SetUID(target.ID).
+		Exec(ctx)
+	if err != nil {
+		t.Fatalf("error creating export, %s", err)
+	}
+	if err = repo.Schedule(ctx, target.UserID, time.Now().Add(-time.Minute)); err != nil {
+		t.Fatalf("error scheduling deletion, %s", err)
+	}
+
+	account, err := repo.Purge(ctx, target.UserID, time.Now())
+	if err != nil || account == nil {
+		t.Fatalf("expected the user to be purged, got %+v %v", account, err)
+	}
+	// 返回提交后清理外部数据需要的信息
+	if len(account.UserIDs) != 2 || len(account.Avatars) != 2 {
+		t.Fatalf("expected target and merged account, got %+v", account)
+	}
+	if len(account.Providers) != 1 || account.Providers[0].RefreshToken != "refresh-token" {
+		t.Fatalf("expected the merged account's apple token, got %+v", account.Providers)
+	}
+	if len(account.ExportKeys) != 1 || account.ExportKeys[0] != "exports/1/export-1.zip" {
+		t.Fatalf("expected the export key, got %v", account.ExportKeys)
+	}
+	if n := td.db.User.Query().CountX(ctx); n != 0 {
+		t.Fatalf("expected both accounts deleted, got %d users", n)
+	}
+
+	// 事件随删除一起提交, 由任务发布到 Stream; 发布失败时保留, 下次重试
+	td.redis.setFailStreams(true)
+	if n, err := repo.PublishEvents(ctx, 10); err == nil || n != 0 {
+		t.Fatalf("expected publish to fail, got %d %v", n, err)
+	}
+	if n := td.db.UserEvent.Query().Where(userevent.PublishedAtIsNil()).CountX(ctx); n != 2 {
+		t.Fatalf("expected 2 pending events after the failure, got %d", n)
+	}
+	td.redis.setFailStreams(false)
+	if n, err := repo.PublishEvents(ctx, 10); err != nil || n != 2 {
+		t.Fatalf("expected 2 events published, got %d %v", n, err)
+	}
+	var published []int64
+	for _, msg := range td.redis.stream(userEventStream) {
+		if msg["type"] != userDeletedEvent || msg["event_id"] == "" || msg["deleted_at"] == "" {
+			t.Fatalf("unexpected event %v", msg)
+		}
+		id, _ := strconv.ParseInt(msg["user_id"], 10, 64)
+		published = append(published, id)
+	}
+	want := []int64{target.UserID, merged.UserID}
+	sort.Slice(published, func(i, j int) bool { return published[i] < published[j] })
+	sort.Slice(want, func(i, j int) bool { return want[i] < want[j] })
+	if len(published) != 2 || published[0] != want[0] || published[1] != want[1] {
+		t.Fatalf("expected deleted events for %v, got %v", want, published)
+	}
+	if n := td.db.UserEvent.Query().Where(userevent.PublishedAtIsNil()).CountX(ctx); n != 0 {
+		t.Fatalf("expected all events marked published, got %d pending", n)
+	}
+	if n, err := repo.PublishEvents(ctx, 10); err != nil || n != 0 {
+		t.Fatalf("expected nothing left to publish, got %d %v", n, err)
+	}
+}
@@ -0,0 +1,122 @@
+// Code generated by ent, DO NOT EDIT.
+
+package applenotification
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the applenotification type in the database.
+	Label = "apple_notification"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEventType holds the string denoting the event_type field in the database.
+	FieldEventType = "event_type"
+	// FieldSubject holds the string denoting the subject field in the database.
+	FieldSubject = "subject"
+	// FieldEmail holds the string denoting the email field in the database.
+	FieldEmail = "email"
+	// FieldIsPrivateEmail holds the string denoting the is_private_email field in the database.
+	FieldIsPrivateEmail = "is_private_email"
+	// FieldEventTime holds the string denoting the event_time field in the database.
+	FieldEventTime = "event_time"
+	// FieldPayload holds the string denoting the payload field in the database.
+	FieldPayload = "payload"
+	// FieldResult holds the string denoting the result field in the database.
+	FieldResult = "result"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the applenotification in the database.
+	Table = "apple_notifications"
+)
+
+// Columns holds all SQL columns for applenotification fields.
+var Columns = []string{
+	FieldID,
+	FieldEventType,
+	FieldSubject,
+	FieldEmail,
+	FieldIsPrivateEmail,
+	FieldEventTime,
+	FieldPayload,
+	FieldResult,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	EventTypeValidator func(string) error
+	// SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	SubjectValidator func(string) error
+	// DefaultEmail holds the default value on creation for the "email" field.
+	DefaultEmail string
+	// EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	EmailValidator func(string) error
+	// DefaultIsPrivateEmail holds the default value on creation for the "is_private_email" field.
+	DefaultIsPrivateEmail bool
+	// ResultValidator is a validator for the "result" field. It is called by the builders before save.
+	ResultValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the AppleNotification queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByEventType orders the results by the event_type field.
+func ByEventType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventType, opts...).ToFunc()
+}
+
+// BySubject orders the results by the subject field.
+func BySubject(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubject, opts...).ToFunc()
+}
+
+// ByEmail orders the results by the email field.
+func ByEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmail, opts...).ToFunc()
+}
+
+// ByIsPrivateEmail orders the results by the is_private_email field.
+func ByIsPrivateEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsPrivateEmail, opts...).ToFunc()
+}
+
+// ByEventTime orders the results by the event_time field.
+func ByEventTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventTime, opts...).ToFunc()
+}
+
+// ByPayload orders the results by the payload field.
+func ByPayload(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayload, opts...).ToFunc()
+}
+
+// ByResult orders the results by the result field.
+func ByResult(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResult, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
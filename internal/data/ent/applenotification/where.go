@@ -0,0 +1,535 @@
+// Code generated by ent, DO NOT EDIT.
+
+package applenotification
+
+import (
+	"time"
+	"user-service/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldLTE(FieldID, id))
+}
+
+// EventType applies equality check predicate on the "event_type" field. It's identical to EventTypeEQ.
+func EventType(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldEQ(FieldEventType, v))
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldEQ(FieldSubject, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldEQ(FieldEmail, v))
+}
+
+// IsPrivateEmail applies equality check predicate on the "is_private_email" field. It's identical to IsPrivateEmailEQ.
+func IsPrivateEmail(v bool) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldEQ(FieldIsPrivateEmail, v))
+}
+
+// EventTime applies equality check predicate on the "event_time" field. It's identical to EventTimeEQ.
+func EventTime(v time.Time) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldEQ(FieldEventTime, v))
+}
+
+// Payload applies equality check predicate on the "payload" field. It's identical to PayloadEQ.
+func Payload(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldEQ(FieldPayload, v))
+}
+
+// Result applies equality check predicate on the "result" field. It's identical to ResultEQ.
+func Result(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldEQ(FieldResult, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// EventTypeEQ applies the EQ predicate on the "event_type" field.
+func EventTypeEQ(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldEQ(FieldEventType, v))
+}
+
+// EventTypeNEQ applies the NEQ predicate on the "event_type" field.
+func EventTypeNEQ(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldNEQ(FieldEventType, v))
+}
+
+// EventTypeIn applies the In predicate on the "event_type" field.
+func EventTypeIn(vs ...string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldIn(FieldEventType, vs...))
+}
+
+// EventTypeNotIn applies the NotIn predicate on the "event_type" field.
+func EventTypeNotIn(vs ...string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldNotIn(FieldEventType, vs...))
+}
+
+// EventTypeGT applies the GT predicate on the "event_type" field.
+func EventTypeGT(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldGT(FieldEventType, v))
+}
+
+// EventTypeGTE applies the GTE predicate on the "event_type" field.
+func EventTypeGTE(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldGTE(FieldEventType, v))
+}
+
+// EventTypeLT applies the LT predicate on the "event_type" field.
+func EventTypeLT(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldLT(FieldEventType, v))
+}
+
+// EventTypeLTE applies the LTE predicate on the "event_type" field.
+func EventTypeLTE(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldLTE(FieldEventType, v))
+}
+
+// EventTypeContains applies the Contains predicate on the "event_type" field.
+func EventTypeContains(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldContains(FieldEventType, v))
+}
+
+// EventTypeHasPrefix applies the HasPrefix predicate on the "event_type" field.
+func EventTypeHasPrefix(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldHasPrefix(FieldEventType, v))
+}
+
+// EventTypeHasSuffix applies the HasSuffix predicate on the "event_type" field.
+func EventTypeHasSuffix(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldHasSuffix(FieldEventType, v))
+}
+
+// EventTypeEqualFold applies the EqualFold predicate on the "event_type" field.
+func EventTypeEqualFold(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldEqualFold(FieldEventType, v))
+}
+
+// EventTypeContainsFold applies the ContainsFold predicate on the "event_type" field.
+func EventTypeContainsFold(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldContainsFold(FieldEventType, v))
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// IsPrivateEmailEQ applies the EQ predicate on the "is_private_email" field.
+func IsPrivateEmailEQ(v bool) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldEQ(FieldIsPrivateEmail, v))
+}
+
+// IsPrivateEmailNEQ applies the NEQ predicate on the "is_private_email" field.
+func IsPrivateEmailNEQ(v bool) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldNEQ(FieldIsPrivateEmail, v))
+}
+
+// EventTimeEQ applies the EQ predicate on the "event_time" field.
+func EventTimeEQ(v time.Time) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldEQ(FieldEventTime, v))
+}
+
+// EventTimeNEQ applies the NEQ predicate on the "event_time" field.
+func EventTimeNEQ(v time.Time) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldNEQ(FieldEventTime, v))
+}
+
+// EventTimeIn applies the In predicate on the "event_time" field.
+func EventTimeIn(vs ...time.Time) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldIn(FieldEventTime, vs...))
+}
+
+// EventTimeNotIn applies the NotIn predicate on the "event_time" field.
+func EventTimeNotIn(vs ...time.Time) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldNotIn(FieldEventTime, vs...))
+}
+
+// EventTimeGT applies the GT predicate on the "event_time" field.
+func EventTimeGT(v time.Time) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldGT(FieldEventTime, v))
+}
+
+// EventTimeGTE applies the GTE predicate on the "event_time" field.
+func EventTimeGTE(v time.Time) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldGTE(FieldEventTime, v))
+}
+
+// EventTimeLT applies the LT predicate on the "event_time" field.
+func EventTimeLT(v time.Time) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldLT(FieldEventTime, v))
+}
+
+// EventTimeLTE applies the LTE predicate on the "event_time" field.
+func EventTimeLTE(v time.Time) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldLTE(FieldEventTime, v))
+}
+
+// EventTimeIsNil applies the IsNil predicate on the "event_time" field.
+func EventTimeIsNil() predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldIsNull(FieldEventTime))
+}
+
+// EventTimeNotNil applies the NotNil predicate on the "event_time" field.
+func EventTimeNotNil() predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldNotNull(FieldEventTime))
+}
+
+// PayloadEQ applies the EQ predicate on the "payload" field.
+func PayloadEQ(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldEQ(FieldPayload, v))
+}
+
+// PayloadNEQ applies the NEQ predicate on the "payload" field.
+func PayloadNEQ(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldNEQ(FieldPayload, v))
+}
+
+// PayloadIn applies the In predicate on the "payload" field.
+func PayloadIn(vs ...string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldIn(FieldPayload, vs...))
+}
+
+// PayloadNotIn applies the NotIn predicate on the "payload" field.
+func PayloadNotIn(vs ...string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldNotIn(FieldPayload, vs...))
+}
+
+// PayloadGT applies the GT predicate on the "payload" field.
+func PayloadGT(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldGT(FieldPayload, v))
+}
+
+// PayloadGTE applies the GTE predicate on the "payload" field.
+func PayloadGTE(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldGTE(FieldPayload, v))
+}
+
+// PayloadLT applies the LT predicate on the "payload" field.
+func PayloadLT(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldLT(FieldPayload, v))
+}
+
+// PayloadLTE applies the LTE predicate on the "payload" field.
+func PayloadLTE(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldLTE(FieldPayload, v))
+}
+
+// PayloadContains applies the Contains predicate on the "payload" field.
+func PayloadContains(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldContains(FieldPayload, v))
+}
+
+// PayloadHasPrefix applies the HasPrefix predicate on the "payload" field.
+func PayloadHasPrefix(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldHasPrefix(FieldPayload, v))
+}
+
+// PayloadHasSuffix applies the HasSuffix predicate on the "payload" field.
+func PayloadHasSuffix(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldHasSuffix(FieldPayload, v))
+}
+
+// PayloadEqualFold applies the EqualFold predicate on the "payload" field.
+func PayloadEqualFold(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldEqualFold(FieldPayload, v))
+}
+
+// PayloadContainsFold applies the ContainsFold predicate on the "payload" field.
+func PayloadContainsFold(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldContainsFold(FieldPayload, v))
+}
+
+// ResultEQ applies the EQ predicate on the "result" field.
+func ResultEQ(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldEQ(FieldResult, v))
+}
+
+// ResultNEQ applies the NEQ predicate on the "result" field.
+func ResultNEQ(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldNEQ(FieldResult, v))
+}
+
+// ResultIn applies the In predicate on the "result" field.
+func ResultIn(vs ...string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldIn(FieldResult, vs...))
+}
+
+// ResultNotIn applies the NotIn predicate on the "result" field.
+func ResultNotIn(vs ...string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldNotIn(FieldResult, vs...))
+}
+
+// ResultGT applies the GT predicate on the "result" field.
+func ResultGT(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldGT(FieldResult, v))
+}
+
+// ResultGTE applies the GTE predicate on the "result" field.
+func ResultGTE(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldGTE(FieldResult, v))
+}
+
+// ResultLT applies the LT predicate on the "result" field.
+func ResultLT(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldLT(FieldResult, v))
+}
+
+// ResultLTE applies the LTE predicate on the "result" field.
+func ResultLTE(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldLTE(FieldResult, v))
+}
+
+// ResultContains applies the Contains predicate on the "result" field.
+func ResultContains(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldContains(FieldResult, v))
+}
+
+// ResultHasPrefix applies the HasPrefix predicate on the "result" field.
+func ResultHasPrefix(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldHasPrefix(FieldResult, v))
+}
+
+// ResultHasSuffix applies the HasSuffix predicate on the "result" field.
+func ResultHasSuffix(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldHasSuffix(FieldResult, v))
+}
+
+// ResultEqualFold applies the EqualFold predicate on the "result" field.
+func ResultEqualFold(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldEqualFold(FieldResult, v))
+}
+
+// ResultContainsFold applies the ContainsFold predicate on the "result" field.
+func ResultContainsFold(v string) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldContainsFold(FieldResult, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AppleNotification) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AppleNotification) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AppleNotification) predicate.AppleNotification {
+	return predicate.AppleNotification(sql.NotPredicates(p))
+}
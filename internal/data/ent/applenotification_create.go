@@ -0,0 +1,354 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"user-service/internal/data/ent/applenotification"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AppleNotificationCreate is the builder for creating a AppleNotification entity.
+type AppleNotificationCreate struct {
+	config
+	mutation *AppleNotificationMutation
+	hooks    []Hook
+}
+
+// SetEventType sets the "event_type" field.
+func (_c *AppleNotificationCreate) SetEventType(v string) *AppleNotificationCreate {
+	_c.mutation.SetEventType(v)
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *AppleNotificationCreate) SetSubject(v string) *AppleNotificationCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetEmail sets the "email" field.
+func (_c *AppleNotificationCreate) SetEmail(v string) *AppleNotificationCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_c *AppleNotificationCreate) SetNillableEmail(v *string) *AppleNotificationCreate {
+	if v != nil {
+		_c.SetEmail(*v)
+	}
+	return _c
+}
+
+// SetIsPrivateEmail sets the "is_private_email" field.
+func (_c *AppleNotificationCreate) SetIsPrivateEmail(v bool) *AppleNotificationCreate {
+	_c.mutation.SetIsPrivateEmail(v)
+	return _c
+}
+
+// SetNillableIsPrivateEmail sets the "is_private_email" field if the given value is not nil.
+func (_c *AppleNotificationCreate) SetNillableIsPrivateEmail(v *bool) *AppleNotificationCreate {
+	if v != nil {
+		_c.SetIsPrivateEmail(*v)
+	}
+	return _c
+}
+
+// SetEventTime sets the "event_time" field.
+func (_c *AppleNotificationCreate) SetEventTime(v time.Time) *AppleNotificationCreate {
+	_c.mutation.SetEventTime(v)
+	return _c
+}
+
+// SetNillableEventTime sets the "event_time" field if the given value is not nil.
+func (_c *AppleNotificationCreate) SetNillableEventTime(v *time.Time) *AppleNotificationCreate {
+	if v != nil {
+		_c.SetEventTime(*v)
+	}
+	return _c
+}
+
+// SetPayload sets the "payload" field.
+func (_c *AppleNotificationCreate) SetPayload(v string) *AppleNotificationCreate {
+	_c.mutation.SetPayload(v)
+	return _c
+}
+
+// SetResult sets the "result" field.
+func (_c *AppleNotificationCreate) SetResult(v string) *AppleNotificationCreate {
+	_c.mutation.SetResult(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AppleNotificationCreate) SetCreatedAt(v time.Time) *AppleNotificationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AppleNotificationCreate) SetNillableCreatedAt(v *time.Time) *AppleNotificationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AppleNotificationCreate) SetID(v int64) *AppleNotificationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the AppleNotificationMutation object of the builder.
+func (_c *AppleNotificationCreate) Mutation() *AppleNotificationMutation {
+	return _c.mutation
+}
+
+// Save creates the AppleNotification in the database.
+func (_c *AppleNotificationCreate) Save(ctx context.Context) (*AppleNotification, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AppleNotificationCreate) SaveX(ctx context.Context) *AppleNotification {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AppleNotificationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AppleNotificationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AppleNotificationCreate) defaults() {
+	if _, ok := _c.mutation.Email(); !ok {
+		v := applenotification.DefaultEmail
+		_c.mutation.SetEmail(v)
+	}
+	if _, ok := _c.mutation.IsPrivateEmail(); !ok {
+		v := applenotification.DefaultIsPrivateEmail
+		_c.mutation.SetIsPrivateEmail(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := applenotification.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AppleNotificationCreate) check() error {
+	if _, ok := _c.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New(`ent: missing required field "AppleNotification.event_type"`)}
+	}
+	if v, ok := _c.mutation.EventType(); ok {
+		if err := applenotification.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "AppleNotification.event_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "AppleNotification.subject"`)}
+	}
+	if v, ok := _c.mutation.Subject(); ok {
+		if err := applenotification.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "AppleNotification.subject": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "AppleNotification.email"`)}
+	}
+	if v, ok := _c.mutation.Email(); ok {
+		if err := applenotification.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "AppleNotification.email": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsPrivateEmail(); !ok {
+		return &ValidationError{Name: "is_private_email", err: errors.New(`ent: missing required field "AppleNotification.is_private_email"`)}
+	}
+	if _, ok := _c.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "AppleNotification.payload"`)}
+	}
+	if _, ok := _c.mutation.Result(); !ok {
+		return &ValidationError{Name: "result", err: errors.New(`ent: missing required field "AppleNotification.result"`)}
+	}
+	if v, ok := _c.mutation.Result(); ok {
+		if err := applenotification.ResultValidator(v); err != nil {
+			return &ValidationError{Name: "result", err: fmt.Errorf(`ent: validator failed for field "AppleNotification.result": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AppleNotification.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *AppleNotificationCreate) sqlSave(ctx context.Context) (*AppleNotification, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AppleNotificationCreate) createSpec() (*AppleNotification, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AppleNotification{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(applenotification.Table, sqlgraph.NewFieldSpec(applenotification.FieldID, field.TypeInt64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.EventType(); ok {
+		_spec.SetField(applenotification.FieldEventType, field.TypeString, value)
+		_node.EventType = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(applenotification.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(applenotification.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := _c.mutation.IsPrivateEmail(); ok {
+		_spec.SetField(applenotification.FieldIsPrivateEmail, field.TypeBool, value)
+		_node.IsPrivateEmail = value
+	}
+	if value, ok := _c.mutation.EventTime(); ok {
+		_spec.SetField(applenotification.FieldEventTime, field.TypeTime, value)
+		_node.EventTime = &value
+	}
+	if value, ok := _c.mutation.Payload(); ok {
+		_spec.SetField(applenotification.FieldPayload, field.TypeString, value)
+		_node.Payload = value
+	}
+	if value, ok := _c.mutation.Result(); ok {
+		_spec.SetField(applenotification.FieldResult, field.TypeString, value)
+		_node.Result = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(applenotification.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AppleNotificationCreateBulk is the builder for creating many AppleNotification entities in bulk.
+type AppleNotificationCreateBulk struct {
+	config
+	err      error
+	builders []*AppleNotificationCreate
+}
+
+// Save creates the AppleNotification entities in the database.
+func (_c *AppleNotificationCreateBulk) Save(ctx context.Context) ([]*AppleNotification, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AppleNotification, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AppleNotificationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AppleNotificationCreateBulk) SaveX(ctx context.Context) []*AppleNotification {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AppleNotificationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AppleNotificationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
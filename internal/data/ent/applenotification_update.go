@@ -0,0 +1,522 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"user-service/internal/data/ent/applenotification"
+	"user-service/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AppleNotificationUpdate is the builder for updating AppleNotification entities.
+type AppleNotificationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AppleNotificationMutation
+}
+
+// Where appends a list predicates to the AppleNotificationUpdate builder.
+func (_u *AppleNotificationUpdate) Where(ps ...predicate.AppleNotification) *AppleNotificationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *AppleNotificationUpdate) SetEventType(v string) *AppleNotificationUpdate {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *AppleNotificationUpdate) SetNillableEventType(v *string) *AppleNotificationUpdate {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *AppleNotificationUpdate) SetSubject(v string) *AppleNotificationUpdate {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *AppleNotificationUpdate) SetNillableSubject(v *string) *AppleNotificationUpdate {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *AppleNotificationUpdate) SetEmail(v string) *AppleNotificationUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *AppleNotificationUpdate) SetNillableEmail(v *string) *AppleNotificationUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetIsPrivateEmail sets the "is_private_email" field.
+func (_u *AppleNotificationUpdate) SetIsPrivateEmail(v bool) *AppleNotificationUpdate {
+	_u.mutation.SetIsPrivateEmail(v)
+	return _u
+}
+
+// SetNillableIsPrivateEmail sets the "is_private_email" field if the given value is not nil.
+func (_u *AppleNotificationUpdate) SetNillableIsPrivateEmail(v *bool) *AppleNotificationUpdate {
+	if v != nil {
+		_u.SetIsPrivateEmail(*v)
+	}
+	return _u
+}
+
+// SetEventTime sets the "event_time" field.
+func (_u *AppleNotificationUpdate) SetEventTime(v time.Time) *AppleNotificationUpdate {
+	_u.mutation.SetEventTime(v)
+	return _u
+}
+
+// SetNillableEventTime sets the "event_time" field if the given value is not nil.
+func (_u *AppleNotificationUpdate) SetNillableEventTime(v *time.Time) *AppleNotificationUpdate {
+	if v != nil {
+		_u.SetEventTime(*v)
+	}
+	return _u
+}
+
+// ClearEventTime clears the value of the "event_time" field.
+func (_u *AppleNotificationUpdate) ClearEventTime() *AppleNotificationUpdate {
+	_u.mutation.ClearEventTime()
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *AppleNotificationUpdate) SetPayload(v string) *AppleNotificationUpdate {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (_u *AppleNotificationUpdate) SetNillablePayload(v *string) *AppleNotificationUpdate {
+	if v != nil {
+		_u.SetPayload(*v)
+	}
+	return _u
+}
+
+// SetResult sets the "result" field.
+func (_u *AppleNotificationUpdate) SetResult(v string) *AppleNotificationUpdate {
+	_u.mutation.SetResult(v)
+	return _u
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (_u *AppleNotificationUpdate) SetNillableResult(v *string) *AppleNotificationUpdate {
+	if v != nil {
+		_u.SetResult(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *AppleNotificationUpdate) SetCreatedAt(v time.Time) *AppleNotificationUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *AppleNotificationUpdate) SetNillableCreatedAt(v *time.Time) *AppleNotificationUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AppleNotificationMutation object of the builder.
+func (_u *AppleNotificationUpdate) Mutation() *AppleNotificationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AppleNotificationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AppleNotificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AppleNotificationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AppleNotificationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AppleNotificationUpdate) check() error {
+	if v, ok := _u.mutation.EventType(); ok {
+		if err := applenotification.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "AppleNotification.event_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Subject(); ok {
+		if err := applenotification.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "AppleNotification.subject": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Email(); ok {
+		if err := applenotification.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "AppleNotification.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Result(); ok {
+		if err := applenotification.ResultValidator(v); err != nil {
+			return &ValidationError{Name: "result", err: fmt.Errorf(`ent: validator failed for field "AppleNotification.result": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AppleNotificationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(applenotification.Table, applenotification.Columns, sqlgraph.NewFieldSpec(applenotification.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(applenotification.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(applenotification.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(applenotification.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IsPrivateEmail(); ok {
+		_spec.SetField(applenotification.FieldIsPrivateEmail, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EventTime(); ok {
+		_spec.SetField(applenotification.FieldEventTime, field.TypeTime, value)
+	}
+	if _u.mutation.EventTimeCleared() {
+		_spec.ClearField(applenotification.FieldEventTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(applenotification.FieldPayload, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Result(); ok {
+		_spec.SetField(applenotification.FieldResult, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(applenotification.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{applenotification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AppleNotificationUpdateOne is the builder for updating a single AppleNotification entity.
+type AppleNotificationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AppleNotificationMutation
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *AppleNotificationUpdateOne) SetEventType(v string) *AppleNotificationUpdateOne {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *AppleNotificationUpdateOne) SetNillableEventType(v *string) *AppleNotificationUpdateOne {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *AppleNotificationUpdateOne) SetSubject(v string) *AppleNotificationUpdateOne {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *AppleNotificationUpdateOne) SetNillableSubject(v *string) *AppleNotificationUpdateOne {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *AppleNotificationUpdateOne) SetEmail(v string) *AppleNotificationUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *AppleNotificationUpdateOne) SetNillableEmail(v *string) *AppleNotificationUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetIsPrivateEmail sets the "is_private_email" field.
+func (_u *AppleNotificationUpdateOne) SetIsPrivateEmail(v bool) *AppleNotificationUpdateOne {
+	_u.mutation.SetIsPrivateEmail(v)
+	return _u
+}
+
+// SetNillableIsPrivateEmail sets the "is_private_email" field if the given value is not nil.
+func (_u *AppleNotificationUpdateOne) SetNillableIsPrivateEmail(v *bool) *AppleNotificationUpdateOne {
+	if v != nil {
+		_u.SetIsPrivateEmail(*v)
+	}
+	return _u
+}
+
+// SetEventTime sets the "event_time" field.
+func (_u *AppleNotificationUpdateOne) SetEventTime(v time.Time) *AppleNotificationUpdateOne {
+	_u.mutation.SetEventTime(v)
+	return _u
+}
+
+// SetNillableEventTime sets the "event_time" field if the given value is not nil.
+func (_u *AppleNotificationUpdateOne) SetNillableEventTime(v *time.Time) *AppleNotificationUpdateOne {
+	if v != nil {
+		_u.SetEventTime(*v)
+	}
+	return _u
+}
+
+// ClearEventTime clears the value of the "event_time" field.
+func (_u *AppleNotificationUpdateOne) ClearEventTime() *AppleNotificationUpdateOne {
+	_u.mutation.ClearEventTime()
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *AppleNotificationUpdateOne) SetPayload(v string) *AppleNotificationUpdateOne {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (_u *AppleNotificationUpdateOne) SetNillablePayload(v *string) *AppleNotificationUpdateOne {
+	if v != nil {
+		_u.SetPayload(*v)
+	}
+	return _u
+}
+
+// SetResult sets the "result" field.
+func (_u *AppleNotificationUpdateOne) SetResult(v string) *AppleNotificationUpdateOne {
+	_u.mutation.SetResult(v)
+	return _u
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (_u *AppleNotificationUpdateOne) SetNillableResult(v *string) *AppleNotificationUpdateOne {
+	if v != nil {
+		_u.SetResult(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *AppleNotificationUpdateOne) SetCreatedAt(v time.Time) *AppleNotificationUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *AppleNotificationUpdateOne) SetNillableCreatedAt(v *time.Time) *AppleNotificationUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the AppleNotificationMutation object of the builder.
+func (_u *AppleNotificationUpdateOne) Mutation() *AppleNotificationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AppleNotificationUpdate builder.
+func (_u *AppleNotificationUpdateOne) Where(ps ...predicate.AppleNotification) *AppleNotificationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AppleNotificationUpdateOne) Select(field string, fields ...string) *AppleNotificationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AppleNotification entity.
+func (_u *AppleNotificationUpdateOne) Save(ctx context.Context) (*AppleNotification, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AppleNotificationUpdateOne) SaveX(ctx context.Context) *AppleNotification {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AppleNotificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AppleNotificationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AppleNotificationUpdateOne) check() error {
+	if v, ok := _u.mutation.EventType(); ok {
+		if err := applenotification.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "AppleNotification.event_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Subject(); ok {
+		if err := applenotification.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "AppleNotification.subject": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Email(); ok {
+		if err := applenotification.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "AppleNotification.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Result(); ok {
+		if err := applenotification.ResultValidator(v); err != nil {
+			return &ValidationError{Name: "result", err: fmt.Errorf(`ent: validator failed for field "AppleNotification.result": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AppleNotificationUpdateOne) sqlSave(ctx context.Context) (_node *AppleNotification, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(applenotification.Table, applenotification.Columns, sqlgraph.NewFieldSpec(applenotification.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AppleNotification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, applenotification.FieldID)
+		for _, f := range fields {
+			if !applenotification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != applenotification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(applenotification.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(applenotification.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(applenotification.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IsPrivateEmail(); ok {
+		_spec.SetField(applenotification.FieldIsPrivateEmail, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EventTime(); ok {
+		_spec.SetField(applenotification.FieldEventTime, field.TypeTime, value)
+	}
+	if _u.mutation.EventTimeCleared() {
+		_spec.ClearField(applenotification.FieldEventTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(applenotification.FieldPayload, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Result(); ok {
+		_spec.SetField(applenotification.FieldResult, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(applenotification.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &AppleNotification{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{applenotification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -39,6 +39,110 @@ func (_c *AuthProviderCreate) SetProviderID(v string) *AuthProviderCreate {
 	return _c
 }
 
+// SetEmail sets the "email" field.
+func (_c *AuthProviderCreate) SetEmail(v string) *AuthProviderCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_c *AuthProviderCreate) SetNillableEmail(v *string) *AuthProviderCreate {
+	if v != nil {
+		_c.SetEmail(*v)
+	}
+	return _c
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (_c *AuthProviderCreate) SetEmailVerified(v bool) *AuthProviderCreate {
+	_c.mutation.SetEmailVerified(v)
+	return _c
+}
+
+// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
+func (_c *AuthProviderCreate) SetNillableEmailVerified(v *bool) *AuthProviderCreate {
+	if v != nil {
+		_c.SetEmailVerified(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *AuthProviderCreate) SetName(v string) *AuthProviderCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *AuthProviderCreate) SetNillableName(v *string) *AuthProviderCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetAvatar sets the "avatar" field.
+func (_c *AuthProviderCreate) SetAvatar(v string) *AuthProviderCreate {
+	_c.mutation.SetAvatar(v)
+	return _c
+}
+
+// SetNillableAvatar sets the "avatar" field if the given value is not nil.
+func (_c *AuthProviderCreate) SetNillableAvatar(v *string) *AuthProviderCreate {
+	if v != nil {
+		_c.SetAvatar(*v)
+	}
+	return _c
+}
+
+// SetRawClaims sets the "raw_claims" field.
+func (_c *AuthProviderCreate) SetRawClaims(v map[string]interface{}) *AuthProviderCreate {
+	_c.mutation.SetRawClaims(v)
+	return _c
+}
+
+// SetAccessToken sets the "access_token" field.
+func (_c *AuthProviderCreate) SetAccessToken(v string) *AuthProviderCreate {
+	_c.mutation.SetAccessToken(v)
+	return _c
+}
+
+// SetNillableAccessToken sets the "access_token" field if the given value is not nil.
+func (_c *AuthProviderCreate) SetNillableAccessToken(v *string) *AuthProviderCreate {
+	if v != nil {
+		_c.SetAccessToken(*v)
+	}
+	return _c
+}
+
+// SetRefreshToken sets the "refresh_token" field.
+func (_c *AuthProviderCreate) SetRefreshToken(v string) *AuthProviderCreate {
+	_c.mutation.SetRefreshToken(v)
+	return _c
+}
+
+// SetNillableRefreshToken sets the "refresh_token" field if the given value is not nil.
+func (_c *AuthProviderCreate) SetNillableRefreshToken(v *string) *AuthProviderCreate {
+	if v != nil {
+		_c.SetRefreshToken(*v)
+	}
+	return _c
+}
+
+// SetTokenExpiresAt sets the "token_expires_at" field.
+func (_c *AuthProviderCreate) SetTokenExpiresAt(v time.Time) *AuthProviderCreate {
+	_c.mutation.SetTokenExpiresAt(v)
+	return _c
+}
+
+// SetNillableTokenExpiresAt sets the "token_expires_at" field if the given value is not nil.
+func (_c *AuthProviderCreate) SetNillableTokenExpiresAt(v *time.Time) *AuthProviderCreate {
+	if v != nil {
+		_c.SetTokenExpiresAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *AuthProviderCreate) SetCreatedAt(v time.Time) *AuthProviderCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -53,6 +157,20 @@ func (_c *AuthProviderCreate) SetNillableCreatedAt(v *time.Time) *AuthProviderCr
 	return _c
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AuthProviderCreate) SetUpdatedAt(v time.Time) *AuthProviderCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AuthProviderCreate) SetNillableUpdatedAt(v *time.Time) *AuthProviderCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *AuthProviderCreate) SetID(v int64) *AuthProviderCreate {
 	_c.mutation.SetID(v)
@@ -105,10 +223,38 @@ func (_c *AuthProviderCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *AuthProviderCreate) defaults() {
+	if _, ok := _c.mutation.Email(); !ok {
+		v := authprovider.DefaultEmail
+		_c.mutation.SetEmail(v)
+	}
+	if _, ok := _c.mutation.EmailVerified(); !ok {
+		v := authprovider.DefaultEmailVerified
+		_c.mutation.SetEmailVerified(v)
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		v := authprovider.DefaultName
+		_c.mutation.SetName(v)
+	}
+	if _, ok := _c.mutation.Avatar(); !ok {
+		v := authprovider.DefaultAvatar
+		_c.mutation.SetAvatar(v)
+	}
+	if _, ok := _c.mutation.AccessToken(); !ok {
+		v := authprovider.DefaultAccessToken
+		_c.mutation.SetAccessToken(v)
+	}
+	if _, ok := _c.mutation.RefreshToken(); !ok {
+		v := authprovider.DefaultRefreshToken
+		_c.mutation.SetRefreshToken(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := authprovider.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := authprovider.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -137,9 +283,55 @@ func (_c *AuthProviderCreate) check() error {
 			return &ValidationError{Name: "provider_id", err: fmt.Errorf(`ent: validator failed for field "AuthProvider.provider_id": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "AuthProvider.email"`)}
+	}
+	if v, ok := _c.mutation.Email(); ok {
+		if err := authprovider.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "AuthProvider.email": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EmailVerified(); !ok {
+		return &ValidationError{Name: "email_verified", err: errors.New(`ent: missing required field "AuthProvider.email_verified"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AuthProvider.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := authprovider.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AuthProvider.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Avatar(); !ok {
+		return &ValidationError{Name: "avatar", err: errors.New(`ent: missing required field "AuthProvider.avatar"`)}
+	}
+	if v, ok := _c.mutation.Avatar(); ok {
+		if err := authprovider.AvatarValidator(v); err != nil {
+			return &ValidationError{Name: "avatar", err: fmt.Errorf(`ent: validator failed for field "AuthProvider.avatar": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccessToken(); !ok {
+		return &ValidationError{Name: "access_token", err: errors.New(`ent: missing required field "AuthProvider.access_token"`)}
+	}
+	if v, ok := _c.mutation.AccessToken(); ok {
+		if err := authprovider.AccessTokenValidator(v); err != nil {
+			return &ValidationError{Name: "access_token", err: fmt.Errorf(`ent: validator failed for field "AuthProvider.access_token": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RefreshToken(); !ok {
+		return &ValidationError{Name: "refresh_token", err: errors.New(`ent: missing required field "AuthProvider.refresh_token"`)}
+	}
+	if v, ok := _c.mutation.RefreshToken(); ok {
+		if err := authprovider.RefreshTokenValidator(v); err != nil {
+			return &ValidationError{Name: "refresh_token", err: fmt.Errorf(`ent: validator failed for field "AuthProvider.refresh_token": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuthProvider.created_at"`)}
 	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AuthProvider.updated_at"`)}
+	}
 	if len(_c.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "AuthProvider.user"`)}
 	}
@@ -183,10 +375,46 @@ func (_c *AuthProviderCreate) createSpec() (*AuthProvider, *sqlgraph.CreateSpec)
 		_spec.SetField(authprovider.FieldProviderID, field.TypeString, value)
 		_node.ProviderID = value
 	}
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(authprovider.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := _c.mutation.EmailVerified(); ok {
+		_spec.SetField(authprovider.FieldEmailVerified, field.TypeBool, value)
+		_node.EmailVerified = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(authprovider.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Avatar(); ok {
+		_spec.SetField(authprovider.FieldAvatar, field.TypeString, value)
+		_node.Avatar = value
+	}
+	if value, ok := _c.mutation.RawClaims(); ok {
+		_spec.SetField(authprovider.FieldRawClaims, field.TypeJSON, value)
+		_node.RawClaims = value
+	}
+	if value, ok := _c.mutation.AccessToken(); ok {
+		_spec.SetField(authprovider.FieldAccessToken, field.TypeString, value)
+		_node.AccessToken = value
+	}
+	if value, ok := _c.mutation.RefreshToken(); ok {
+		_spec.SetField(authprovider.FieldRefreshToken, field.TypeString, value)
+		_node.RefreshToken = value
+	}
+	if value, ok := _c.mutation.TokenExpiresAt(); ok {
+		_spec.SetField(authprovider.FieldTokenExpiresAt, field.TypeTime, value)
+		_node.TokenExpiresAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(authprovider.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(authprovider.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -71,6 +71,122 @@ func (_u *AuthProviderUpdate) SetNillableProviderID(v *string) *AuthProviderUpda
 	return _u
 }
 
+// SetEmail sets the "email" field.
+func (_u *AuthProviderUpdate) SetEmail(v string) *AuthProviderUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *AuthProviderUpdate) SetNillableEmail(v *string) *AuthProviderUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (_u *AuthProviderUpdate) SetEmailVerified(v bool) *AuthProviderUpdate {
+	_u.mutation.SetEmailVerified(v)
+	return _u
+}
+
+// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
+func (_u *AuthProviderUpdate) SetNillableEmailVerified(v *bool) *AuthProviderUpdate {
+	if v != nil {
+		_u.SetEmailVerified(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AuthProviderUpdate) SetName(v string) *AuthProviderUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AuthProviderUpdate) SetNillableName(v *string) *AuthProviderUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetAvatar sets the "avatar" field.
+func (_u *AuthProviderUpdate) SetAvatar(v string) *AuthProviderUpdate {
+	_u.mutation.SetAvatar(v)
+	return _u
+}
+
+// SetNillableAvatar sets the "avatar" field if the given value is not nil.
+func (_u *AuthProviderUpdate) SetNillableAvatar(v *string) *AuthProviderUpdate {
+	if v != nil {
+		_u.SetAvatar(*v)
+	}
+	return _u
+}
+
+// SetRawClaims sets the "raw_claims" field.
+func (_u *AuthProviderUpdate) SetRawClaims(v map[string]interface{}) *AuthProviderUpdate {
+	_u.mutation.SetRawClaims(v)
+	return _u
+}
+
+// ClearRawClaims clears the value of the "raw_claims" field.
+func (_u *AuthProviderUpdate) ClearRawClaims() *AuthProviderUpdate {
+	_u.mutation.ClearRawClaims()
+	return _u
+}
+
+// SetAccessToken sets the "access_token" field.
+func (_u *AuthProviderUpdate) SetAccessToken(v string) *AuthProviderUpdate {
+	_u.mutation.SetAccessToken(v)
+	return _u
+}
+
+// SetNillableAccessToken sets the "access_token" field if the given value is not nil.
+func (_u *AuthProviderUpdate) SetNillableAccessToken(v *string) *AuthProviderUpdate {
+	if v != nil {
+		_u.SetAccessToken(*v)
+	}
+	return _u
+}
+
+// SetRefreshToken sets the "refresh_token" field.
+func (_u *AuthProviderUpdate) SetRefreshToken(v string) *AuthProviderUpdate {
+	_u.mutation.SetRefreshToken(v)
+	return _u
+}
+
+// SetNillableRefreshToken sets the "refresh_token" field if the given value is not nil.
+func (_u *AuthProviderUpdate) SetNillableRefreshToken(v *string) *AuthProviderUpdate {
+	if v != nil {
+		_u.SetRefreshToken(*v)
+	}
+	return _u
+}
+
+// SetTokenExpiresAt sets the "token_expires_at" field.
+func (_u *AuthProviderUpdate) SetTokenExpiresAt(v time.Time) *AuthProviderUpdate {
+	_u.mutation.SetTokenExpiresAt(v)
+	return _u
+}
+
+// SetNillableTokenExpiresAt sets the "token_expires_at" field if the given value is not nil.
+func (_u *AuthProviderUpdate) SetNillableTokenExpiresAt(v *time.Time) *AuthProviderUpdate {
+	if v != nil {
+		_u.SetTokenExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearTokenExpiresAt clears the value of the "token_expires_at" field.
+func (_u *AuthProviderUpdate) ClearTokenExpiresAt() *AuthProviderUpdate {
+	_u.mutation.ClearTokenExpiresAt()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *AuthProviderUpdate) SetCreatedAt(v time.Time) *AuthProviderUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -85,6 +201,12 @@ func (_u *AuthProviderUpdate) SetNillableCreatedAt(v *time.Time) *AuthProviderUp
 	return _u
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AuthProviderUpdate) SetUpdatedAt(v time.Time) *AuthProviderUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *AuthProviderUpdate) SetUserID(id int64) *AuthProviderUpdate {
 	_u.mutation.SetUserID(id)
@@ -109,6 +231,7 @@ func (_u *AuthProviderUpdate) ClearUser() *AuthProviderUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *AuthProviderUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -134,6 +257,14 @@ func (_u *AuthProviderUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_u *AuthProviderUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := authprovider.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_u *AuthProviderUpdate) check() error {
 	if v, ok := _u.mutation.UID(); ok {
@@ -151,6 +282,31 @@ func (_u *AuthProviderUpdate) check() error {
 			return &ValidationError{Name: "provider_id", err: fmt.Errorf(`ent: validator failed for field "AuthProvider.provider_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Email(); ok {
+		if err := authprovider.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "AuthProvider.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := authprovider.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AuthProvider.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Avatar(); ok {
+		if err := authprovider.AvatarValidator(v); err != nil {
+			return &ValidationError{Name: "avatar", err: fmt.Errorf(`ent: validator failed for field "AuthProvider.avatar": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccessToken(); ok {
+		if err := authprovider.AccessTokenValidator(v); err != nil {
+			return &ValidationError{Name: "access_token", err: fmt.Errorf(`ent: validator failed for field "AuthProvider.access_token": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RefreshToken(); ok {
+		if err := authprovider.RefreshTokenValidator(v); err != nil {
+			return &ValidationError{Name: "refresh_token", err: fmt.Errorf(`ent: validator failed for field "AuthProvider.refresh_token": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "AuthProvider.user"`)
 	}
@@ -175,9 +331,42 @@ func (_u *AuthProviderUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.ProviderID(); ok {
 		_spec.SetField(authprovider.FieldProviderID, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(authprovider.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EmailVerified(); ok {
+		_spec.SetField(authprovider.FieldEmailVerified, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(authprovider.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Avatar(); ok {
+		_spec.SetField(authprovider.FieldAvatar, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RawClaims(); ok {
+		_spec.SetField(authprovider.FieldRawClaims, field.TypeJSON, value)
+	}
+	if _u.mutation.RawClaimsCleared() {
+		_spec.ClearField(authprovider.FieldRawClaims, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccessToken(); ok {
+		_spec.SetField(authprovider.FieldAccessToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RefreshToken(); ok {
+		_spec.SetField(authprovider.FieldRefreshToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenExpiresAt(); ok {
+		_spec.SetField(authprovider.FieldTokenExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.TokenExpiresAtCleared() {
+		_spec.ClearField(authprovider.FieldTokenExpiresAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(authprovider.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(authprovider.FieldUpdatedAt, field.TypeTime, value)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -269,6 +458,122 @@ func (_u *AuthProviderUpdateOne) SetNillableProviderID(v *string) *AuthProviderU
 	return _u
 }
 
+// SetEmail sets the "email" field.
+func (_u *AuthProviderUpdateOne) SetEmail(v string) *AuthProviderUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *AuthProviderUpdateOne) SetNillableEmail(v *string) *AuthProviderUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (_u *AuthProviderUpdateOne) SetEmailVerified(v bool) *AuthProviderUpdateOne {
+	_u.mutation.SetEmailVerified(v)
+	return _u
+}
+
+// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
+func (_u *AuthProviderUpdateOne) SetNillableEmailVerified(v *bool) *AuthProviderUpdateOne {
+	if v != nil {
+		_u.SetEmailVerified(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AuthProviderUpdateOne) SetName(v string) *AuthProviderUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AuthProviderUpdateOne) SetNillableName(v *string) *AuthProviderUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetAvatar sets the "avatar" field.
+func (_u *AuthProviderUpdateOne) SetAvatar(v string) *AuthProviderUpdateOne {
+	_u.mutation.SetAvatar(v)
+	return _u
+}
+
+// SetNillableAvatar sets the "avatar" field if the given value is not nil.
+func (_u *AuthProviderUpdateOne) SetNillableAvatar(v *string) *AuthProviderUpdateOne {
+	if v != nil {
+		_u.SetAvatar(*v)
+	}
+	return _u
+}
+
+// SetRawClaims sets the "raw_claims" field.
+func (_u *AuthProviderUpdateOne) SetRawClaims(v map[string]interface{}) *AuthProviderUpdateOne {
+	_u.mutation.SetRawClaims(v)
+	return _u
+}
+
+// ClearRawClaims clears the value of the "raw_claims" field.
+func (_u *AuthProviderUpdateOne) ClearRawClaims() *AuthProviderUpdateOne {
+	_u.mutation.ClearRawClaims()
+	return _u
+}
+
+// SetAccessToken sets the "access_token" field.
+func (_u *AuthProviderUpdateOne) SetAccessToken(v string) *AuthProviderUpdateOne {
+	_u.mutation.SetAccessToken(v)
+	return _u
+}
+
+// SetNillableAccessToken sets the "access_token" field if the given value is not nil.
+func (_u *AuthProviderUpdateOne) SetNillableAccessToken(v *string) *AuthProviderUpdateOne {
+	if v != nil {
+		_u.SetAccessToken(*v)
+	}
+	return _u
+}
+
+// SetRefreshToken sets the "refresh_token" field.
+func (_u *AuthProviderUpdateOne) SetRefreshToken(v string) *AuthProviderUpdateOne {
+	_u.mutation.SetRefreshToken(v)
+	return _u
+}
+
+// SetNillableRefreshToken sets the "refresh_token" field if the given value is not nil.
+func (_u *AuthProviderUpdateOne) SetNillableRefreshToken(v *string) *AuthProviderUpdateOne {
+	if v != nil {
+		_u.SetRefreshToken(*v)
+	}
+	return _u
+}
+
+// SetTokenExpiresAt sets the "token_expires_at" field.
+func (_u *AuthProviderUpdateOne) SetTokenExpiresAt(v time.Time) *AuthProviderUpdateOne {
+	_u.mutation.SetTokenExpiresAt(v)
+	return _u
+}
+
+// SetNillableTokenExpiresAt sets the "token_expires_at" field if the given value is not nil.
+func (_u *AuthProviderUpdateOne) SetNillableTokenExpiresAt(v *time.Time) *AuthProviderUpdateOne {
+	if v != nil {
+		_u.SetTokenExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearTokenExpiresAt clears the value of the "token_expires_at" field.
+func (_u *AuthProviderUpdateOne) ClearTokenExpiresAt() *AuthProviderUpdateOne {
+	_u.mutation.ClearTokenExpiresAt()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *AuthProviderUpdateOne) SetCreatedAt(v time.Time) *AuthProviderUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -283,6 +588,12 @@ func (_u *AuthProviderUpdateOne) SetNillableCreatedAt(v *time.Time) *AuthProvide
 	return _u
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AuthProviderUpdateOne) SetUpdatedAt(v time.Time) *AuthProviderUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *AuthProviderUpdateOne) SetUserID(id int64) *AuthProviderUpdateOne {
 	_u.mutation.SetUserID(id)
@@ -320,6 +631,7 @@ func (_u *AuthProviderUpdateOne) Select(field string, fields ...string) *AuthPro
 
 // Save executes the query and returns the updated AuthProvider entity.
 func (_u *AuthProviderUpdateOne) Save(ctx context.Context) (*AuthProvider, error) {
+	_u.defaults()
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -345,6 +657,14 @@ func (_u *AuthProviderUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_u *AuthProviderUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := authprovider.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_u *AuthProviderUpdateOne) check() error {
 	if v, ok := _u.mutation.UID(); ok {
@@ -362,6 +682,31 @@ func (_u *AuthProviderUpdateOne) check() error {
 			return &ValidationError{Name: "provider_id", err: fmt.Errorf(`ent: validator failed for field "AuthProvider.provider_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Email(); ok {
+		if err := authprovider.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "AuthProvider.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := authprovider.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AuthProvider.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Avatar(); ok {
+		if err := authprovider.AvatarValidator(v); err != nil {
+			return &ValidationError{Name: "avatar", err: fmt.Errorf(`ent: validator failed for field "AuthProvider.avatar": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccessToken(); ok {
+		if err := authprovider.AccessTokenValidator(v); err != nil {
+			return &ValidationError{Name: "access_token", err: fmt.Errorf(`ent: validator failed for field "AuthProvider.access_token": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RefreshToken(); ok {
+		if err := authprovider.RefreshTokenValidator(v); err != nil {
+			return &ValidationError{Name: "refresh_token", err: fmt.Errorf(`ent: validator failed for field "AuthProvider.refresh_token": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "AuthProvider.user"`)
 	}
@@ -403,9 +748,42 @@ func (_u *AuthProviderUpdateOne) sqlSave(ctx context.Context) (_node *AuthProvid
 	if value, ok := _u.mutation.ProviderID(); ok {
 		_spec.SetField(authprovider.FieldProviderID, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(authprovider.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EmailVerified(); ok {
+		_spec.SetField(authprovider.FieldEmailVerified, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(authprovider.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Avatar(); ok {
+		_spec.SetField(authprovider.FieldAvatar, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RawClaims(); ok {
+		_spec.SetField(authprovider.FieldRawClaims, field.TypeJSON, value)
+	}
+	if _u.mutation.RawClaimsCleared() {
+		_spec.ClearField(authprovider.FieldRawClaims, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccessToken(); ok {
+		_spec.SetField(authprovider.FieldAccessToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RefreshToken(); ok {
+		_spec.SetField(authprovider.FieldRefreshToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenExpiresAt(); ok {
+		_spec.SetField(authprovider.FieldTokenExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.TokenExpiresAtCleared() {
+		_spec.ClearField(authprovider.FieldTokenExpiresAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(authprovider.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(authprovider.FieldUpdatedAt, field.TypeTime, value)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
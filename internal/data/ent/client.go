@@ -11,8 +11,14 @@ import (
 
 	"user-service/internal/data/ent/migrate"
 
+	"user-service/internal/data/ent/applenotification"
 	"user-service/internal/data/ent/authprovider"
+	"user-service/internal/data/ent/dataexport"
+	"user-service/internal/data/ent/handlehistory"
+	"user-service/internal/data/ent/session"
 	"user-service/internal/data/ent/user"
+	"user-service/internal/data/ent/userevent"
+	"user-service/internal/data/ent/wechataccount"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -25,10 +31,22 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AppleNotification is the client for interacting with the AppleNotification builders.
+	AppleNotification *AppleNotificationClient
 	// AuthProvider is the client for interacting with the AuthProvider builders.
 	AuthProvider *AuthProviderClient
+	// DataExport is the client for interacting with the DataExport builders.
+	DataExport *DataExportClient
+	// HandleHistory is the client for interacting with the HandleHistory builders.
+	HandleHistory *HandleHistoryClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserEvent is the client for interacting with the UserEvent builders.
+	UserEvent *UserEventClient
+	// WechatAccount is the client for interacting with the WechatAccount builders.
+	WechatAccount *WechatAccountClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -40,8 +58,14 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AppleNotification = NewAppleNotificationClient(c.config)
 	c.AuthProvider = NewAuthProviderClient(c.config)
+	c.DataExport = NewDataExportClient(c.config)
+	c.HandleHistory = NewHandleHistoryClient(c.config)
+	c.Session = NewSessionClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.UserEvent = NewUserEventClient(c.config)
+	c.WechatAccount = NewWechatAccountClient(c.config)
 }
 
 type (
@@ -132,10 +156,16 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		AuthProvider: NewAuthProviderClient(cfg),
-		User:         NewUserClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		AppleNotification: NewAppleNotificationClient(cfg),
+		AuthProvider:      NewAuthProviderClient(cfg),
+		DataExport:        NewDataExportClient(cfg),
+		HandleHistory:     NewHandleHistoryClient(cfg),
+		Session:           NewSessionClient(cfg),
+		User:              NewUserClient(cfg),
+		UserEvent:         NewUserEventClient(cfg),
+		WechatAccount:     NewWechatAccountClient(cfg),
 	}, nil
 }
 
@@ -153,17 +183,23 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		AuthProvider: NewAuthProviderClient(cfg),
-		User:         NewUserClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		AppleNotification: NewAppleNotificationClient(cfg),
+		AuthProvider:      NewAuthProviderClient(cfg),
+		DataExport:        NewDataExportClient(cfg),
+		HandleHistory:     NewHandleHistoryClient(cfg),
+		Session:           NewSessionClient(cfg),
+		User:              NewUserClient(cfg),
+		UserEvent:         NewUserEventClient(cfg),
+		WechatAccount:     NewWechatAccountClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		AuthProvider.
+//		AppleNotification.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -185,29 +221,182 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.AuthProvider.Use(hooks...)
-	c.User.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.AppleNotification, c.AuthProvider, c.DataExport, c.HandleHistory, c.Session,
+		c.User, c.UserEvent, c.WechatAccount,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.AuthProvider.Intercept(interceptors...)
-	c.User.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.AppleNotification, c.AuthProvider, c.DataExport, c.HandleHistory, c.Session,
+		c.User, c.UserEvent, c.WechatAccount,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AppleNotificationMutation:
+		return c.AppleNotification.mutate(ctx, m)
 	case *AuthProviderMutation:
 		return c.AuthProvider.mutate(ctx, m)
+	case *DataExportMutation:
+		return c.DataExport.mutate(ctx, m)
+	case *HandleHistoryMutation:
+		return c.HandleHistory.mutate(ctx, m)
+	case *SessionMutation:
+		return c.Session.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *UserEventMutation:
+		return c.UserEvent.mutate(ctx, m)
+	case *WechatAccountMutation:
+		return c.WechatAccount.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// AppleNotificationClient is a client for the AppleNotification schema.
+type AppleNotificationClient struct {
+	config
+}
+
+// NewAppleNotificationClient returns a client for the AppleNotification from the given config.
+func NewAppleNotificationClient(c config) *AppleNotificationClient {
+	return &AppleNotificationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `applenotification.Hooks(f(g(h())))`.
+func (c *AppleNotificationClient) Use(hooks ...Hook) {
+	c.hooks.AppleNotification = append(c.hooks.AppleNotification, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `applenotification.Intercept(f(g(h())))`.
+func (c *AppleNotificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AppleNotification = append(c.inters.AppleNotification, interceptors...)
+}
+
+// Create returns a builder for creating a AppleNotification entity.
+func (c *AppleNotificationClient) Create() *AppleNotificationCreate {
+	mutation := newAppleNotificationMutation(c.config, OpCreate)
+	return &AppleNotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AppleNotification entities.
+func (c *AppleNotificationClient) CreateBulk(builders ...*AppleNotificationCreate) *AppleNotificationCreateBulk {
+	return &AppleNotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AppleNotificationClient) MapCreateBulk(slice any, setFunc func(*AppleNotificationCreate, int)) *AppleNotificationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AppleNotificationCreateBulk{err: fmt.Errorf("calling to AppleNotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AppleNotificationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AppleNotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AppleNotification.
+func (c *AppleNotificationClient) Update() *AppleNotificationUpdate {
+	mutation := newAppleNotificationMutation(c.config, OpUpdate)
+	return &AppleNotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AppleNotificationClient) UpdateOne(_m *AppleNotification) *AppleNotificationUpdateOne {
+	mutation := newAppleNotificationMutation(c.config, OpUpdateOne, withAppleNotification(_m))
+	return &AppleNotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AppleNotificationClient) UpdateOneID(id int64) *AppleNotificationUpdateOne {
+	mutation := newAppleNotificationMutation(c.config, OpUpdateOne, withAppleNotificationID(id))
+	return &AppleNotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AppleNotification.
+func (c *AppleNotificationClient) Delete() *AppleNotificationDelete {
+	mutation := newAppleNotificationMutation(c.config, OpDelete)
+	return &AppleNotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AppleNotificationClient) DeleteOne(_m *AppleNotification) *AppleNotificationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AppleNotificationClient) DeleteOneID(id int64) *AppleNotificationDeleteOne {
+	builder := c.Delete().Where(applenotification.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AppleNotificationDeleteOne{builder}
+}
+
+// Query returns a query builder for AppleNotification.
+func (c *AppleNotificationClient) Query() *AppleNotificationQuery {
+	return &AppleNotificationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAppleNotification},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AppleNotification entity by its id.
+func (c *AppleNotificationClient) Get(ctx context.Context, id int64) (*AppleNotification, error) {
+	return c.Query().Where(applenotification.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AppleNotificationClient) GetX(ctx context.Context, id int64) *AppleNotification {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AppleNotificationClient) Hooks() []Hook {
+	return c.hooks.AppleNotification
+}
+
+// Interceptors returns the client interceptors.
+func (c *AppleNotificationClient) Interceptors() []Interceptor {
+	return c.inters.AppleNotification
+}
+
+func (c *AppleNotificationClient) mutate(ctx context.Context, m *AppleNotificationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AppleNotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AppleNotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AppleNotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AppleNotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AppleNotification mutation op: %q", m.Op())
+	}
+}
+
 // AuthProviderClient is a client for the AuthProvider schema.
 type AuthProviderClient struct {
 	config
@@ -357,107 +546,107 @@ func (c *AuthProviderClient) mutate(ctx context.Context, m *AuthProviderMutation
 	}
 }
 
-// UserClient is a client for the User schema.
-type UserClient struct {
+// DataExportClient is a client for the DataExport schema.
+type DataExportClient struct {
 	config
 }
 
-// NewUserClient returns a client for the User from the given config.
-func NewUserClient(c config) *UserClient {
-	return &UserClient{config: c}
+// NewDataExportClient returns a client for the DataExport from the given config.
+func NewDataExportClient(c config) *DataExportClient {
+	return &DataExportClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
-func (c *UserClient) Use(hooks ...Hook) {
-	c.hooks.User = append(c.hooks.User, hooks...)
+// A call to `Use(f, g, h)` equals to `dataexport.Hooks(f(g(h())))`.
+func (c *DataExportClient) Use(hooks ...Hook) {
+	c.hooks.DataExport = append(c.hooks.DataExport, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
-func (c *UserClient) Intercept(interceptors ...Interceptor) {
-	c.inters.User = append(c.inters.User, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `dataexport.Intercept(f(g(h())))`.
+func (c *DataExportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DataExport = append(c.inters.DataExport, interceptors...)
 }
 
-// Create returns a builder for creating a User entity.
-func (c *UserClient) Create() *UserCreate {
-	mutation := newUserMutation(c.config, OpCreate)
-	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DataExport entity.
+func (c *DataExportClient) Create() *DataExportCreate {
+	mutation := newDataExportMutation(c.config, OpCreate)
+	return &DataExportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of User entities.
-func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
-	return &UserCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DataExport entities.
+func (c *DataExportClient) CreateBulk(builders ...*DataExportCreate) *DataExportCreateBulk {
+	return &DataExportCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+func (c *DataExportClient) MapCreateBulk(slice any, setFunc func(*DataExportCreate, int)) *DataExportCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DataExportCreateBulk{err: fmt.Errorf("calling to DataExportClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UserCreate, rv.Len())
+	builders := make([]*DataExportCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UserCreateBulk{config: c.config, builders: builders}
+	return &DataExportCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for User.
-func (c *UserClient) Update() *UserUpdate {
-	mutation := newUserMutation(c.config, OpUpdate)
-	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DataExport.
+func (c *DataExportClient) Update() *DataExportUpdate {
+	mutation := newDataExportMutation(c.config, OpUpdate)
+	return &DataExportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UserClient) UpdateOne(_m *User) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUser(_m))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DataExportClient) UpdateOne(_m *DataExport) *DataExportUpdateOne {
+	mutation := newDataExportMutation(c.config, OpUpdateOne, withDataExport(_m))
+	return &DataExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UserClient) UpdateOneID(id int64) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DataExportClient) UpdateOneID(id int64) *DataExportUpdateOne {
+	mutation := newDataExportMutation(c.config, OpUpdateOne, withDataExportID(id))
+	return &DataExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for User.
-func (c *UserClient) Delete() *UserDelete {
-	mutation := newUserMutation(c.config, OpDelete)
-	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DataExport.
+func (c *DataExportClient) Delete() *DataExportDelete {
+	mutation := newDataExportMutation(c.config, OpDelete)
+	return &DataExportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UserClient) DeleteOne(_m *User) *UserDeleteOne {
+func (c *DataExportClient) DeleteOne(_m *DataExport) *DataExportDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UserClient) DeleteOneID(id int64) *UserDeleteOne {
-	builder := c.Delete().Where(user.ID(id))
+func (c *DataExportClient) DeleteOneID(id int64) *DataExportDeleteOne {
+	builder := c.Delete().Where(dataexport.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UserDeleteOne{builder}
+	return &DataExportDeleteOne{builder}
 }
 
-// Query returns a query builder for User.
-func (c *UserClient) Query() *UserQuery {
-	return &UserQuery{
+// Query returns a query builder for DataExport.
+func (c *DataExportClient) Query() *DataExportQuery {
+	return &DataExportQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUser},
+		ctx:    &QueryContext{Type: TypeDataExport},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a User entity by its id.
-func (c *UserClient) Get(ctx context.Context, id int64) (*User, error) {
-	return c.Query().Where(user.ID(id)).Only(ctx)
+// Get returns a DataExport entity by its id.
+func (c *DataExportClient) Get(ctx context.Context, id int64) (*DataExport, error) {
+	return c.Query().Where(dataexport.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserClient) GetX(ctx context.Context, id int64) *User {
+func (c *DataExportClient) GetX(ctx context.Context, id int64) *DataExport {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -465,15 +654,15 @@ func (c *UserClient) GetX(ctx context.Context, id int64) *User {
 	return obj
 }
 
-// QueryAuthProviders queries the auth_providers edge of a User.
-func (c *UserClient) QueryAuthProviders(_m *User) *AuthProviderQuery {
-	query := (&AuthProviderClient{config: c.config}).Query()
+// QueryUser queries the user edge of a DataExport.
+func (c *DataExportClient) QueryUser(_m *DataExport) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(authprovider.Table, authprovider.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.AuthProvidersTable, user.AuthProvidersColumn),
+			sqlgraph.From(dataexport.Table, dataexport.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, dataexport.UserTable, dataexport.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -482,36 +671,831 @@ func (c *UserClient) QueryAuthProviders(_m *User) *AuthProviderQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *UserClient) Hooks() []Hook {
-	return c.hooks.User
+func (c *DataExportClient) Hooks() []Hook {
+	return c.hooks.DataExport
 }
 
 // Interceptors returns the client interceptors.
-func (c *UserClient) Interceptors() []Interceptor {
-	return c.inters.User
+func (c *DataExportClient) Interceptors() []Interceptor {
+	return c.inters.DataExport
 }
 
-func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+func (c *DataExportClient) mutate(ctx context.Context, m *DataExportMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DataExportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DataExportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DataExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DataExportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown DataExport mutation op: %q", m.Op())
+	}
+}
+
+// HandleHistoryClient is a client for the HandleHistory schema.
+type HandleHistoryClient struct {
+	config
+}
+
+// NewHandleHistoryClient returns a client for the HandleHistory from the given config.
+func NewHandleHistoryClient(c config) *HandleHistoryClient {
+	return &HandleHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `handlehistory.Hooks(f(g(h())))`.
+func (c *HandleHistoryClient) Use(hooks ...Hook) {
+	c.hooks.HandleHistory = append(c.hooks.HandleHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `handlehistory.Intercept(f(g(h())))`.
+func (c *HandleHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.HandleHistory = append(c.inters.HandleHistory, interceptors...)
+}
+
+// Create returns a builder for creating a HandleHistory entity.
+func (c *HandleHistoryClient) Create() *HandleHistoryCreate {
+	mutation := newHandleHistoryMutation(c.config, OpCreate)
+	return &HandleHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of HandleHistory entities.
+func (c *HandleHistoryClient) CreateBulk(builders ...*HandleHistoryCreate) *HandleHistoryCreateBulk {
+	return &HandleHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *HandleHistoryClient) MapCreateBulk(slice any, setFunc func(*HandleHistoryCreate, int)) *HandleHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &HandleHistoryCreateBulk{err: fmt.Errorf("calling to HandleHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*HandleHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &HandleHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for HandleHistory.
+func (c *HandleHistoryClient) Update() *HandleHistoryUpdate {
+	mutation := newHandleHistoryMutation(c.config, OpUpdate)
+	return &HandleHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HandleHistoryClient) UpdateOne(_m *HandleHistory) *HandleHistoryUpdateOne {
+	mutation := newHandleHistoryMutation(c.config, OpUpdateOne, withHandleHistory(_m))
+	return &HandleHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HandleHistoryClient) UpdateOneID(id int64) *HandleHistoryUpdateOne {
+	mutation := newHandleHistoryMutation(c.config, OpUpdateOne, withHandleHistoryID(id))
+	return &HandleHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for HandleHistory.
+func (c *HandleHistoryClient) Delete() *HandleHistoryDelete {
+	mutation := newHandleHistoryMutation(c.config, OpDelete)
+	return &HandleHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HandleHistoryClient) DeleteOne(_m *HandleHistory) *HandleHistoryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HandleHistoryClient) DeleteOneID(id int64) *HandleHistoryDeleteOne {
+	builder := c.Delete().Where(handlehistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HandleHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for HandleHistory.
+func (c *HandleHistoryClient) Query() *HandleHistoryQuery {
+	return &HandleHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHandleHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a HandleHistory entity by its id.
+func (c *HandleHistoryClient) Get(ctx context.Context, id int64) (*HandleHistory, error) {
+	return c.Query().Where(handlehistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HandleHistoryClient) GetX(ctx context.Context, id int64) *HandleHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a HandleHistory.
+func (c *HandleHistoryClient) QueryUser(_m *HandleHistory) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(handlehistory.Table, handlehistory.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, handlehistory.UserTable, handlehistory.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *HandleHistoryClient) Hooks() []Hook {
+	return c.hooks.HandleHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *HandleHistoryClient) Interceptors() []Interceptor {
+	return c.inters.HandleHistory
+}
+
+func (c *HandleHistoryClient) mutate(ctx context.Context, m *HandleHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HandleHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HandleHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HandleHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HandleHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown HandleHistory mutation op: %q", m.Op())
+	}
+}
+
+// SessionClient is a client for the Session schema.
+type SessionClient struct {
+	config
+}
+
+// NewSessionClient returns a client for the Session from the given config.
+func NewSessionClient(c config) *SessionClient {
+	return &SessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `session.Hooks(f(g(h())))`.
+func (c *SessionClient) Use(hooks ...Hook) {
+	c.hooks.Session = append(c.hooks.Session, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `session.Intercept(f(g(h())))`.
+func (c *SessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Session = append(c.inters.Session, interceptors...)
+}
+
+// Create returns a builder for creating a Session entity.
+func (c *SessionClient) Create() *SessionCreate {
+	mutation := newSessionMutation(c.config, OpCreate)
+	return &SessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Session entities.
+func (c *SessionClient) CreateBulk(builders ...*SessionCreate) *SessionCreateBulk {
+	return &SessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SessionClient) MapCreateBulk(slice any, setFunc func(*SessionCreate, int)) *SessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SessionCreateBulk{err: fmt.Errorf("calling to SessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Session.
+func (c *SessionClient) Update() *SessionUpdate {
+	mutation := newSessionMutation(c.config, OpUpdate)
+	return &SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SessionClient) UpdateOne(_m *Session) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSession(_m))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SessionClient) UpdateOneID(id int64) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSessionID(id))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Session.
+func (c *SessionClient) Delete() *SessionDelete {
+	mutation := newSessionMutation(c.config, OpDelete)
+	return &SessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SessionClient) DeleteOne(_m *Session) *SessionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SessionClient) DeleteOneID(id int64) *SessionDeleteOne {
+	builder := c.Delete().Where(session.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SessionDeleteOne{builder}
+}
+
+// Query returns a query builder for Session.
+func (c *SessionClient) Query() *SessionQuery {
+	return &SessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Session entity by its id.
+func (c *SessionClient) Get(ctx context.Context, id int64) (*Session, error) {
+	return c.Query().Where(session.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SessionClient) GetX(ctx context.Context, id int64) *Session {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Session.
+func (c *SessionClient) QueryUser(_m *Session) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(session.Table, session.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, session.UserTable, session.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SessionClient) Hooks() []Hook {
+	return c.hooks.Session
+}
+
+// Interceptors returns the client interceptors.
+func (c *SessionClient) Interceptors() []Interceptor {
+	return c.inters.Session
+}
+
+func (c *SessionClient) mutate(ctx context.Context, m *SessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Session mutation op: %q", m.Op())
+	}
+}
+
+// UserClient is a client for the User schema.
+type UserClient struct {
+	config
+}
+
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for User.
+func (c *UserClient) Update() *UserUpdate {
+	mutation := newUserMutation(c.config, OpUpdate)
+	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserClient) UpdateOne(_m *User) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUser(_m))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserClient) UpdateOneID(id int64) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for User.
+func (c *UserClient) Delete() *UserDelete {
+	mutation := newUserMutation(c.config, OpDelete)
+	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserClient) DeleteOne(_m *User) *UserDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserClient) DeleteOneID(id int64) *UserDeleteOne {
+	builder := c.Delete().Where(user.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserDeleteOne{builder}
+}
+
+// Query returns a query builder for User.
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a User entity by its id.
+func (c *UserClient) Get(ctx context.Context, id int64) (*User, error) {
+	return c.Query().Where(user.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserClient) GetX(ctx context.Context, id int64) *User {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAuthProviders queries the auth_providers edge of a User.
+func (c *UserClient) QueryAuthProviders(_m *User) *AuthProviderQuery {
+	query := (&AuthProviderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(authprovider.Table, authprovider.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AuthProvidersTable, user.AuthProvidersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWechatAccounts queries the wechat_accounts edge of a User.
+func (c *UserClient) QueryWechatAccounts(_m *User) *WechatAccountQuery {
+	query := (&WechatAccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(wechataccount.Table, wechataccount.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.WechatAccountsTable, user.WechatAccountsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySessions queries the sessions edge of a User.
+func (c *UserClient) QuerySessions(_m *User) *SessionQuery {
+	query := (&SessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SessionsTable, user.SessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHandleHistories queries the handle_histories edge of a User.
+func (c *UserClient) QueryHandleHistories(_m *User) *HandleHistoryQuery {
+	query := (&HandleHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(handlehistory.Table, handlehistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.HandleHistoriesTable, user.HandleHistoriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDataExports queries the data_exports edge of a User.
+func (c *UserClient) QueryDataExports(_m *User) *DataExportQuery {
+	query := (&DataExportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(dataexport.Table, dataexport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.DataExportsTable, user.DataExportsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserClient) Hooks() []Hook {
+	return c.hooks.User
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
+
+func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+	}
+}
+
+// UserEventClient is a client for the UserEvent schema.
+type UserEventClient struct {
+	config
+}
+
+// NewUserEventClient returns a client for the UserEvent from the given config.
+func NewUserEventClient(c config) *UserEventClient {
+	return &UserEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userevent.Hooks(f(g(h())))`.
+func (c *UserEventClient) Use(hooks ...Hook) {
+	c.hooks.UserEvent = append(c.hooks.UserEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userevent.Intercept(f(g(h())))`.
+func (c *UserEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserEvent = append(c.inters.UserEvent, interceptors...)
+}
+
+// Create returns a builder for creating a UserEvent entity.
+func (c *UserEventClient) Create() *UserEventCreate {
+	mutation := newUserEventMutation(c.config, OpCreate)
+	return &UserEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserEvent entities.
+func (c *UserEventClient) CreateBulk(builders ...*UserEventCreate) *UserEventCreateBulk {
+	return &UserEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserEventClient) MapCreateBulk(slice any, setFunc func(*UserEventCreate, int)) *UserEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserEventCreateBulk{err: fmt.Errorf("calling to UserEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserEvent.
+func (c *UserEventClient) Update() *UserEventUpdate {
+	mutation := newUserEventMutation(c.config, OpUpdate)
+	return &UserEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserEventClient) UpdateOne(_m *UserEvent) *UserEventUpdateOne {
+	mutation := newUserEventMutation(c.config, OpUpdateOne, withUserEvent(_m))
+	return &UserEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserEventClient) UpdateOneID(id int64) *UserEventUpdateOne {
+	mutation := newUserEventMutation(c.config, OpUpdateOne, withUserEventID(id))
+	return &UserEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserEvent.
+func (c *UserEventClient) Delete() *UserEventDelete {
+	mutation := newUserEventMutation(c.config, OpDelete)
+	return &UserEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserEventClient) DeleteOne(_m *UserEvent) *UserEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserEventClient) DeleteOneID(id int64) *UserEventDeleteOne {
+	builder := c.Delete().Where(userevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserEventDeleteOne{builder}
+}
+
+// Query returns a query builder for UserEvent.
+func (c *UserEventClient) Query() *UserEventQuery {
+	return &UserEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserEvent entity by its id.
+func (c *UserEventClient) Get(ctx context.Context, id int64) (*UserEvent, error) {
+	return c.Query().Where(userevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserEventClient) GetX(ctx context.Context, id int64) *UserEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserEventClient) Hooks() []Hook {
+	return c.hooks.UserEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserEventClient) Interceptors() []Interceptor {
+	return c.inters.UserEvent
+}
+
+func (c *UserEventClient) mutate(ctx context.Context, m *UserEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserEvent mutation op: %q", m.Op())
+	}
+}
+
+// WechatAccountClient is a client for the WechatAccount schema.
+type WechatAccountClient struct {
+	config
+}
+
+// NewWechatAccountClient returns a client for the WechatAccount from the given config.
+func NewWechatAccountClient(c config) *WechatAccountClient {
+	return &WechatAccountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `wechataccount.Hooks(f(g(h())))`.
+func (c *WechatAccountClient) Use(hooks ...Hook) {
+	c.hooks.WechatAccount = append(c.hooks.WechatAccount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `wechataccount.Intercept(f(g(h())))`.
+func (c *WechatAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WechatAccount = append(c.inters.WechatAccount, interceptors...)
+}
+
+// Create returns a builder for creating a WechatAccount entity.
+func (c *WechatAccountClient) Create() *WechatAccountCreate {
+	mutation := newWechatAccountMutation(c.config, OpCreate)
+	return &WechatAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WechatAccount entities.
+func (c *WechatAccountClient) CreateBulk(builders ...*WechatAccountCreate) *WechatAccountCreateBulk {
+	return &WechatAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WechatAccountClient) MapCreateBulk(slice any, setFunc func(*WechatAccountCreate, int)) *WechatAccountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WechatAccountCreateBulk{err: fmt.Errorf("calling to WechatAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WechatAccountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WechatAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WechatAccount.
+func (c *WechatAccountClient) Update() *WechatAccountUpdate {
+	mutation := newWechatAccountMutation(c.config, OpUpdate)
+	return &WechatAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WechatAccountClient) UpdateOne(_m *WechatAccount) *WechatAccountUpdateOne {
+	mutation := newWechatAccountMutation(c.config, OpUpdateOne, withWechatAccount(_m))
+	return &WechatAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WechatAccountClient) UpdateOneID(id int64) *WechatAccountUpdateOne {
+	mutation := newWechatAccountMutation(c.config, OpUpdateOne, withWechatAccountID(id))
+	return &WechatAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WechatAccount.
+func (c *WechatAccountClient) Delete() *WechatAccountDelete {
+	mutation := newWechatAccountMutation(c.config, OpDelete)
+	return &WechatAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WechatAccountClient) DeleteOne(_m *WechatAccount) *WechatAccountDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WechatAccountClient) DeleteOneID(id int64) *WechatAccountDeleteOne {
+	builder := c.Delete().Where(wechataccount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WechatAccountDeleteOne{builder}
+}
+
+// Query returns a query builder for WechatAccount.
+func (c *WechatAccountClient) Query() *WechatAccountQuery {
+	return &WechatAccountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWechatAccount},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WechatAccount entity by its id.
+func (c *WechatAccountClient) Get(ctx context.Context, id int64) (*WechatAccount, error) {
+	return c.Query().Where(wechataccount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WechatAccountClient) GetX(ctx context.Context, id int64) *WechatAccount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a WechatAccount.
+func (c *WechatAccountClient) QueryUser(_m *WechatAccount) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wechataccount.Table, wechataccount.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, wechataccount.UserTable, wechataccount.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WechatAccountClient) Hooks() []Hook {
+	return c.hooks.WechatAccount
+}
+
+// Interceptors returns the client interceptors.
+func (c *WechatAccountClient) Interceptors() []Interceptor {
+	return c.inters.WechatAccount
+}
+
+func (c *WechatAccountClient) mutate(ctx context.Context, m *WechatAccountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WechatAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WechatAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WechatAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WechatAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WechatAccount mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AuthProvider, User []ent.Hook
+		AppleNotification, AuthProvider, DataExport, HandleHistory, Session, User,
+		UserEvent, WechatAccount []ent.Hook
 	}
 	inters struct {
-		AuthProvider, User []ent.Interceptor
+		AppleNotification, AuthProvider, DataExport, HandleHistory, Session, User,
+		UserEvent, WechatAccount []ent.Interceptor
 	}
 )
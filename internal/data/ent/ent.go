@@ -8,8 +8,14 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"user-service/internal/data/ent/applenotification"
 	"user-service/internal/data/ent/authprovider"
+	"user-service/internal/data/ent/dataexport"
+	"user-service/internal/data/ent/handlehistory"
+	"user-service/internal/data/ent/session"
 	"user-service/internal/data/ent/user"
+	"user-service/internal/data/ent/userevent"
+	"user-service/internal/data/ent/wechataccount"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -74,8 +80,14 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			authprovider.Table: authprovider.ValidColumn,
-			user.Table:         user.ValidColumn,
+			applenotification.Table: applenotification.ValidColumn,
+			authprovider.Table:      authprovider.ValidColumn,
+			dataexport.Table:        dataexport.ValidColumn,
+			handlehistory.Table:     handlehistory.ValidColumn,
+			session.Table:           session.ValidColumn,
+			user.Table:              user.ValidColumn,
+			userevent.Table:         userevent.ValidColumn,
+			wechataccount.Table:     wechataccount.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
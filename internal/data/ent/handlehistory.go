@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"user-service/internal/data/ent/handlehistory"
+	"user-service/internal/data/ent/user"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// HandleHistory is the model entity for the HandleHistory schema.
+type HandleHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// UID holds the value of the "uid" field.
+	UID int64 `json:"uid,omitempty"`
+	// Handle holds the value of the "handle" field.
+	Handle string `json:"handle,omitempty"`
+	// HandleLower holds the value of the "handle_lower" field.
+	HandleLower string `json:"handle_lower,omitempty"`
+	// HeldUntil holds the value of the "held_until" field.
+	HeldUntil time.Time `json:"held_until,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the HandleHistoryQuery when eager-loading is set.
+	Edges        HandleHistoryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// HandleHistoryEdges holds the relations/edges for other nodes in the graph.
+type HandleHistoryEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HandleHistoryEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*HandleHistory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case handlehistory.FieldID, handlehistory.FieldUID:
+			values[i] = new(sql.NullInt64)
+		case handlehistory.FieldHandle, handlehistory.FieldHandleLower:
+			values[i] = new(sql.NullString)
+		case handlehistory.FieldHeldUntil, handlehistory.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the HandleHistory fields.
+func (_m *HandleHistory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case handlehistory.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case handlehistory.FieldUID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				_m.UID = value.Int64
+			}
+		case handlehistory.FieldHandle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field handle", values[i])
+			} else if value.Valid {
+				_m.Handle = value.String
+			}
+		case handlehistory.FieldHandleLower:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field handle_lower", values[i])
+			} else if value.Valid {
+				_m.HandleLower = value.String
+			}
+		case handlehistory.FieldHeldUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field held_until", values[i])
+			} else if value.Valid {
+				_m.HeldUntil = value.Time
+			}
+		case handlehistory.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the HandleHistory.
+// This includes values selected through modifiers, order, etc.
+func (_m *HandleHistory) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the HandleHistory entity.
+func (_m *HandleHistory) QueryUser() *UserQuery {
+	return NewHandleHistoryClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this HandleHistory.
+// Note that you need to call HandleHistory.Unwrap() before calling this method if this HandleHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *HandleHistory) Update() *HandleHistoryUpdateOne {
+	return NewHandleHistoryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the HandleHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *HandleHistory) Unwrap() *HandleHistory {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: HandleHistory is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *HandleHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("HandleHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("uid=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UID))
+	builder.WriteString(", ")
+	builder.WriteString("handle=")
+	builder.WriteString(_m.Handle)
+	builder.WriteString(", ")
+	builder.WriteString("handle_lower=")
+	builder.WriteString(_m.HandleLower)
+	builder.WriteString(", ")
+	builder.WriteString("held_until=")
+	builder.WriteString(_m.HeldUntil.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// HandleHistories is a parsable slice of HandleHistory.
+type HandleHistories []*HandleHistory
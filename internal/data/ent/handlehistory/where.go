@@ -0,0 +1,349 @@
+// Code generated by ent, DO NOT EDIT.
+
+package handlehistory
+
+import (
+	"time"
+	"user-service/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldLTE(FieldID, id))
+}
+
+// UID applies equality check predicate on the "uid" field. It's identical to UIDEQ.
+func UID(v int64) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldEQ(FieldUID, v))
+}
+
+// Handle applies equality check predicate on the "handle" field. It's identical to HandleEQ.
+func Handle(v string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldEQ(FieldHandle, v))
+}
+
+// HandleLower applies equality check predicate on the "handle_lower" field. It's identical to HandleLowerEQ.
+func HandleLower(v string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldEQ(FieldHandleLower, v))
+}
+
+// HeldUntil applies equality check predicate on the "held_until" field. It's identical to HeldUntilEQ.
+func HeldUntil(v time.Time) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldEQ(FieldHeldUntil, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UIDEQ applies the EQ predicate on the "uid" field.
+func UIDEQ(v int64) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldEQ(FieldUID, v))
+}
+
+// UIDNEQ applies the NEQ predicate on the "uid" field.
+func UIDNEQ(v int64) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldNEQ(FieldUID, v))
+}
+
+// UIDIn applies the In predicate on the "uid" field.
+func UIDIn(vs ...int64) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldIn(FieldUID, vs...))
+}
+
+// UIDNotIn applies the NotIn predicate on the "uid" field.
+func UIDNotIn(vs ...int64) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldNotIn(FieldUID, vs...))
+}
+
+// HandleEQ applies the EQ predicate on the "handle" field.
+func HandleEQ(v string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldEQ(FieldHandle, v))
+}
+
+// HandleNEQ applies the NEQ predicate on the "handle" field.
+func HandleNEQ(v string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldNEQ(FieldHandle, v))
+}
+
+// HandleIn applies the In predicate on the "handle" field.
+func HandleIn(vs ...string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldIn(FieldHandle, vs...))
+}
+
+// HandleNotIn applies the NotIn predicate on the "handle" field.
+func HandleNotIn(vs ...string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldNotIn(FieldHandle, vs...))
+}
+
+// HandleGT applies the GT predicate on the "handle" field.
+func HandleGT(v string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldGT(FieldHandle, v))
+}
+
+// HandleGTE applies the GTE predicate on the "handle" field.
+func HandleGTE(v string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldGTE(FieldHandle, v))
+}
+
+// HandleLT applies the LT predicate on the "handle" field.
+func HandleLT(v string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldLT(FieldHandle, v))
+}
+
+// HandleLTE applies the LTE predicate on the "handle" field.
+func HandleLTE(v string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldLTE(FieldHandle, v))
+}
+
+// HandleContains applies the Contains predicate on the "handle" field.
+func HandleContains(v string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldContains(FieldHandle, v))
+}
+
+// HandleHasPrefix applies the HasPrefix predicate on the "handle" field.
+func HandleHasPrefix(v string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldHasPrefix(FieldHandle, v))
+}
+
+// HandleHasSuffix applies the HasSuffix predicate on the "handle" field.
+func HandleHasSuffix(v string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldHasSuffix(FieldHandle, v))
+}
+
+// HandleEqualFold applies the EqualFold predicate on the "handle" field.
+func HandleEqualFold(v string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldEqualFold(FieldHandle, v))
+}
+
+// HandleContainsFold applies the ContainsFold predicate on the "handle" field.
+func HandleContainsFold(v string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldContainsFold(FieldHandle, v))
+}
+
+// HandleLowerEQ applies the EQ predicate on the "handle_lower" field.
+func HandleLowerEQ(v string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldEQ(FieldHandleLower, v))
+}
+
+// HandleLowerNEQ applies the NEQ predicate on the "handle_lower" field.
+func HandleLowerNEQ(v string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldNEQ(FieldHandleLower, v))
+}
+
+// HandleLowerIn applies the In predicate on the "handle_lower" field.
+func HandleLowerIn(vs ...string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldIn(FieldHandleLower, vs...))
+}
+
+// HandleLowerNotIn applies the NotIn predicate on the "handle_lower" field.
+func HandleLowerNotIn(vs ...string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldNotIn(FieldHandleLower, vs...))
+}
+
+// HandleLowerGT applies the GT predicate on the "handle_lower" field.
+func HandleLowerGT(v string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldGT(FieldHandleLower, v))
+}
+
+// HandleLowerGTE applies the GTE predicate on the "handle_lower" field.
+func HandleLowerGTE(v string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldGTE(FieldHandleLower, v))
+}
+
+// HandleLowerLT applies the LT predicate on the "handle_lower" field.
+func HandleLowerLT(v string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldLT(FieldHandleLower, v))
+}
+
+// HandleLowerLTE applies the LTE predicate on the "handle_lower" field.
+func HandleLowerLTE(v string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldLTE(FieldHandleLower, v))
+}
+
+// HandleLowerContains applies the Contains predicate on the "handle_lower" field.
+func HandleLowerContains(v string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldContains(FieldHandleLower, v))
+}
+
+// HandleLowerHasPrefix applies the HasPrefix predicate on the "handle_lower" field.
+func HandleLowerHasPrefix(v string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldHasPrefix(FieldHandleLower, v))
+}
+
+// HandleLowerHasSuffix applies the HasSuffix predicate on the "handle_lower" field.
+func HandleLowerHasSuffix(v string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldHasSuffix(FieldHandleLower, v))
+}
+
+// HandleLowerEqualFold applies the EqualFold predicate on the "handle_lower" field.
+func HandleLowerEqualFold(v string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldEqualFold(FieldHandleLower, v))
+}
+
+// HandleLowerContainsFold applies the ContainsFold predicate on the "handle_lower" field.
+func HandleLowerContainsFold(v string) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldContainsFold(FieldHandleLower, v))
+}
+
+// HeldUntilEQ applies the EQ predicate on the "held_until" field.
+func HeldUntilEQ(v time.Time) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldEQ(FieldHeldUntil, v))
+}
+
+// HeldUntilNEQ applies the NEQ predicate on the "held_until" field.
+func HeldUntilNEQ(v time.Time) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldNEQ(FieldHeldUntil, v))
+}
+
+// HeldUntilIn applies the In predicate on the "held_until" field.
+func HeldUntilIn(vs ...time.Time) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldIn(FieldHeldUntil, vs...))
+}
+
+// HeldUntilNotIn applies the NotIn predicate on the "held_until" field.
+func HeldUntilNotIn(vs ...time.Time) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldNotIn(FieldHeldUntil, vs...))
+}
+
+// HeldUntilGT applies the GT predicate on the "held_until" field.
+func HeldUntilGT(v time.Time) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldGT(FieldHeldUntil, v))
+}
+
+// HeldUntilGTE applies the GTE predicate on the "held_until" field.
+func HeldUntilGTE(v time.Time) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldGTE(FieldHeldUntil, v))
+}
+
+// HeldUntilLT applies the LT predicate on the "held_until" field.
+func HeldUntilLT(v time.Time) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldLT(FieldHeldUntil, v))
+}
+
+// HeldUntilLTE applies the LTE predicate on the "held_until" field.
+func HeldUntilLTE(v time.Time) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldLTE(FieldHeldUntil, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.HandleHistory {
+	return predicate.HandleHistory(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.HandleHistory {
+	return predicate.HandleHistory(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.HandleHistory) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.HandleHistory) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.HandleHistory) predicate.HandleHistory {
+	return predicate.HandleHistory(sql.NotPredicates(p))
+}
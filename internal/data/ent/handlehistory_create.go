@@ -0,0 +1,309 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"user-service/internal/data/ent/handlehistory"
+	"user-service/internal/data/ent/user"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// HandleHistoryCreate is the builder for creating a HandleHistory entity.
+type HandleHistoryCreate struct {
+	config
+	mutation *HandleHistoryMutation
+	hooks    []Hook
+}
+
+// SetUID sets the "uid" field.
+func (_c *HandleHistoryCreate) SetUID(v int64) *HandleHistoryCreate {
+	_c.mutation.SetUID(v)
+	return _c
+}
+
+// SetHandle sets the "handle" field.
+func (_c *HandleHistoryCreate) SetHandle(v string) *HandleHistoryCreate {
+	_c.mutation.SetHandle(v)
+	return _c
+}
+
+// SetHandleLower sets the "handle_lower" field.
+func (_c *HandleHistoryCreate) SetHandleLower(v string) *HandleHistoryCreate {
+	_c.mutation.SetHandleLower(v)
+	return _c
+}
+
+// SetHeldUntil sets the "held_until" field.
+func (_c *HandleHistoryCreate) SetHeldUntil(v time.Time) *HandleHistoryCreate {
+	_c.mutation.SetHeldUntil(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *HandleHistoryCreate) SetCreatedAt(v time.Time) *HandleHistoryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *HandleHistoryCreate) SetNillableCreatedAt(v *time.Time) *HandleHistoryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *HandleHistoryCreate) SetID(v int64) *HandleHistoryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *HandleHistoryCreate) SetUserID(id int64) *HandleHistoryCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *HandleHistoryCreate) SetUser(v *User) *HandleHistoryCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the HandleHistoryMutation object of the builder.
+func (_c *HandleHistoryCreate) Mutation() *HandleHistoryMutation {
+	return _c.mutation
+}
+
+// Save creates the HandleHistory in the database.
+func (_c *HandleHistoryCreate) Save(ctx context.Context) (*HandleHistory, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *HandleHistoryCreate) SaveX(ctx context.Context) *HandleHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HandleHistoryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HandleHistoryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *HandleHistoryCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := handlehistory.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *HandleHistoryCreate) check() error {
+	if _, ok := _c.mutation.UID(); !ok {
+		return &ValidationError{Name: "uid", err: errors.New(`ent: missing required field "HandleHistory.uid"`)}
+	}
+	if v, ok := _c.mutation.UID(); ok {
+		if err := handlehistory.UIDValidator(v); err != nil {
+			return &ValidationError{Name: "uid", err: fmt.Errorf(`ent: validator failed for field "HandleHistory.uid": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Handle(); !ok {
+		return &ValidationError{Name: "handle", err: errors.New(`ent: missing required field "HandleHistory.handle"`)}
+	}
+	if v, ok := _c.mutation.Handle(); ok {
+		if err := handlehistory.HandleValidator(v); err != nil {
+			return &ValidationError{Name: "handle", err: fmt.Errorf(`ent: validator failed for field "HandleHistory.handle": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.HandleLower(); !ok {
+		return &ValidationError{Name: "handle_lower", err: errors.New(`ent: missing required field "HandleHistory.handle_lower"`)}
+	}
+	if v, ok := _c.mutation.HandleLower(); ok {
+		if err := handlehistory.HandleLowerValidator(v); err != nil {
+			return &ValidationError{Name: "handle_lower", err: fmt.Errorf(`ent: validator failed for field "HandleHistory.handle_lower": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.HeldUntil(); !ok {
+		return &ValidationError{Name: "held_until", err: errors.New(`ent: missing required field "HandleHistory.held_until"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "HandleHistory.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "HandleHistory.user"`)}
+	}
+	return nil
+}
+
+func (_c *HandleHistoryCreate) sqlSave(ctx context.Context) (*HandleHistory, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *HandleHistoryCreate) createSpec() (*HandleHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &HandleHistory{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(handlehistory.Table, sqlgraph.NewFieldSpec(handlehistory.FieldID, field.TypeInt64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Handle(); ok {
+		_spec.SetField(handlehistory.FieldHandle, field.TypeString, value)
+		_node.Handle = value
+	}
+	if value, ok := _c.mutation.HandleLower(); ok {
+		_spec.SetField(handlehistory.FieldHandleLower, field.TypeString, value)
+		_node.HandleLower = value
+	}
+	if value, ok := _c.mutation.HeldUntil(); ok {
+		_spec.SetField(handlehistory.FieldHeldUntil, field.TypeTime, value)
+		_node.HeldUntil = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(handlehistory.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   handlehistory.UserTable,
+			Columns: []string{handlehistory.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// HandleHistoryCreateBulk is the builder for creating many HandleHistory entities in bulk.
+type HandleHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*HandleHistoryCreate
+}
+
+// Save creates the HandleHistory entities in the database.
+func (_c *HandleHistoryCreateBulk) Save(ctx context.Context) ([]*HandleHistory, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*HandleHistory, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HandleHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *HandleHistoryCreateBulk) SaveX(ctx context.Context) []*HandleHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HandleHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HandleHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,485 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"user-service/internal/data/ent/handlehistory"
+	"user-service/internal/data/ent/predicate"
+	"user-service/internal/data/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// HandleHistoryUpdate is the builder for updating HandleHistory entities.
+type HandleHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *HandleHistoryMutation
+}
+
+// Where appends a list predicates to the HandleHistoryUpdate builder.
+func (_u *HandleHistoryUpdate) Where(ps ...predicate.HandleHistory) *HandleHistoryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *HandleHistoryUpdate) SetUID(v int64) *HandleHistoryUpdate {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *HandleHistoryUpdate) SetNillableUID(v *int64) *HandleHistoryUpdate {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// SetHandle sets the "handle" field.
+func (_u *HandleHistoryUpdate) SetHandle(v string) *HandleHistoryUpdate {
+	_u.mutation.SetHandle(v)
+	return _u
+}
+
+// SetNillableHandle sets the "handle" field if the given value is not nil.
+func (_u *HandleHistoryUpdate) SetNillableHandle(v *string) *HandleHistoryUpdate {
+	if v != nil {
+		_u.SetHandle(*v)
+	}
+	return _u
+}
+
+// SetHandleLower sets the "handle_lower" field.
+func (_u *HandleHistoryUpdate) SetHandleLower(v string) *HandleHistoryUpdate {
+	_u.mutation.SetHandleLower(v)
+	return _u
+}
+
+// SetNillableHandleLower sets the "handle_lower" field if the given value is not nil.
+func (_u *HandleHistoryUpdate) SetNillableHandleLower(v *string) *HandleHistoryUpdate {
+	if v != nil {
+		_u.SetHandleLower(*v)
+	}
+	return _u
+}
+
+// SetHeldUntil sets the "held_until" field.
+func (_u *HandleHistoryUpdate) SetHeldUntil(v time.Time) *HandleHistoryUpdate {
+	_u.mutation.SetHeldUntil(v)
+	return _u
+}
+
+// SetNillableHeldUntil sets the "held_until" field if the given value is not nil.
+func (_u *HandleHistoryUpdate) SetNillableHeldUntil(v *time.Time) *HandleHistoryUpdate {
+	if v != nil {
+		_u.SetHeldUntil(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *HandleHistoryUpdate) SetCreatedAt(v time.Time) *HandleHistoryUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *HandleHistoryUpdate) SetNillableCreatedAt(v *time.Time) *HandleHistoryUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *HandleHistoryUpdate) SetUserID(id int64) *HandleHistoryUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *HandleHistoryUpdate) SetUser(v *User) *HandleHistoryUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the HandleHistoryMutation object of the builder.
+func (_u *HandleHistoryUpdate) Mutation() *HandleHistoryMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *HandleHistoryUpdate) ClearUser() *HandleHistoryUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *HandleHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HandleHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *HandleHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HandleHistoryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HandleHistoryUpdate) check() error {
+	if v, ok := _u.mutation.UID(); ok {
+		if err := handlehistory.UIDValidator(v); err != nil {
+			return &ValidationError{Name: "uid", err: fmt.Errorf(`ent: validator failed for field "HandleHistory.uid": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Handle(); ok {
+		if err := handlehistory.HandleValidator(v); err != nil {
+			return &ValidationError{Name: "handle", err: fmt.Errorf(`ent: validator failed for field "HandleHistory.handle": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HandleLower(); ok {
+		if err := handlehistory.HandleLowerValidator(v); err != nil {
+			return &ValidationError{Name: "handle_lower", err: fmt.Errorf(`ent: validator failed for field "HandleHistory.handle_lower": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "HandleHistory.user"`)
+	}
+	return nil
+}
+
+func (_u *HandleHistoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(handlehistory.Table, handlehistory.Columns, sqlgraph.NewFieldSpec(handlehistory.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Handle(); ok {
+		_spec.SetField(handlehistory.FieldHandle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HandleLower(); ok {
+		_spec.SetField(handlehistory.FieldHandleLower, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HeldUntil(); ok {
+		_spec.SetField(handlehistory.FieldHeldUntil, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(handlehistory.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   handlehistory.UserTable,
+			Columns: []string{handlehistory.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   handlehistory.UserTable,
+			Columns: []string{handlehistory.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{handlehistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// HandleHistoryUpdateOne is the builder for updating a single HandleHistory entity.
+type HandleHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *HandleHistoryMutation
+}
+
+// SetUID sets the "uid" field.
+func (_u *HandleHistoryUpdateOne) SetUID(v int64) *HandleHistoryUpdateOne {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *HandleHistoryUpdateOne) SetNillableUID(v *int64) *HandleHistoryUpdateOne {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// SetHandle sets the "handle" field.
+func (_u *HandleHistoryUpdateOne) SetHandle(v string) *HandleHistoryUpdateOne {
+	_u.mutation.SetHandle(v)
+	return _u
+}
+
+// SetNillableHandle sets the "handle" field if the given value is not nil.
+func (_u *HandleHistoryUpdateOne) SetNillableHandle(v *string) *HandleHistoryUpdateOne {
+	if v != nil {
+		_u.SetHandle(*v)
+	}
+	return _u
+}
+
+// SetHandleLower sets the "handle_lower" field.
+func (_u *HandleHistoryUpdateOne) SetHandleLower(v string) *HandleHistoryUpdateOne {
+	_u.mutation.SetHandleLower(v)
+	return _u
+}
+
+// SetNillableHandleLower sets the "handle_lower" field if the given value is not nil.
+func (_u *HandleHistoryUpdateOne) SetNillableHandleLower(v *string) *HandleHistoryUpdateOne {
+	if v != nil {
+		_u.SetHandleLower(*v)
+	}
+	return _u
+}
+
+// SetHeldUntil sets the "held_until" field.
+func (_u *HandleHistoryUpdateOne) SetHeldUntil(v time.Time) *HandleHistoryUpdateOne {
+	_u.mutation.SetHeldUntil(v)
+	return _u
+}
+
+// SetNillableHeldUntil sets the "held_until" field if the given value is not nil.
+func (_u *HandleHistoryUpdateOne) SetNillableHeldUntil(v *time.Time) *HandleHistoryUpdateOne {
+	if v != nil {
+		_u.SetHeldUntil(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *HandleHistoryUpdateOne) SetCreatedAt(v time.Time) *HandleHistoryUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *HandleHistoryUpdateOne) SetNillableCreatedAt(v *time.Time) *HandleHistoryUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *HandleHistoryUpdateOne) SetUserID(id int64) *HandleHistoryUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *HandleHistoryUpdateOne) SetUser(v *User) *HandleHistoryUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the HandleHistoryMutation object of the builder.
+func (_u *HandleHistoryUpdateOne) Mutation() *HandleHistoryMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *HandleHistoryUpdateOne) ClearUser() *HandleHistoryUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the HandleHistoryUpdate builder.
+func (_u *HandleHistoryUpdateOne) Where(ps ...predicate.HandleHistory) *HandleHistoryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *HandleHistoryUpdateOne) Select(field string, fields ...string) *HandleHistoryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated HandleHistory entity.
+func (_u *HandleHistoryUpdateOne) Save(ctx context.Context) (*HandleHistory, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HandleHistoryUpdateOne) SaveX(ctx context.Context) *HandleHistory {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *HandleHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HandleHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HandleHistoryUpdateOne) check() error {
+	if v, ok := _u.mutation.UID(); ok {
+		if err := handlehistory.UIDValidator(v); err != nil {
+			return &ValidationError{Name: "uid", err: fmt.Errorf(`ent: validator failed for field "HandleHistory.uid": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Handle(); ok {
+		if err := handlehistory.HandleValidator(v); err != nil {
+			return &ValidationError{Name: "handle", err: fmt.Errorf(`ent: validator failed for field "HandleHistory.handle": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HandleLower(); ok {
+		if err := handlehistory.HandleLowerValidator(v); err != nil {
+			return &ValidationError{Name: "handle_lower", err: fmt.Errorf(`ent: validator failed for field "HandleHistory.handle_lower": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "HandleHistory.user"`)
+	}
+	return nil
+}
+
+func (_u *HandleHistoryUpdateOne) sqlSave(ctx context.Context) (_node *HandleHistory, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(handlehistory.Table, handlehistory.Columns, sqlgraph.NewFieldSpec(handlehistory.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "HandleHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, handlehistory.FieldID)
+		for _, f := range fields {
+			if !handlehistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != handlehistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Handle(); ok {
+		_spec.SetField(handlehistory.FieldHandle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HandleLower(); ok {
+		_spec.SetField(handlehistory.FieldHandleLower, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HeldUntil(); ok {
+		_spec.SetField(handlehistory.FieldHeldUntil, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(handlehistory.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   handlehistory.UserTable,
+			Columns: []string{handlehistory.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   handlehistory.UserTable,
+			Columns: []string{handlehistory.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &HandleHistory{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{handlehistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
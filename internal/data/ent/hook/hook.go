@@ -8,6 +8,18 @@ import (
 	"user-service/internal/data/ent"
 )
 
+// The AppleNotificationFunc type is an adapter to allow the use of ordinary
+// function as AppleNotification mutator.
+type AppleNotificationFunc func(context.Context, *ent.AppleNotificationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AppleNotificationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AppleNotificationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AppleNotificationMutation", m)
+}
+
 // The AuthProviderFunc type is an adapter to allow the use of ordinary
 // function as AuthProvider mutator.
 type AuthProviderFunc func(context.Context, *ent.AuthProviderMutation) (ent.Value, error)
@@ -20,6 +32,42 @@ func (f AuthProviderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuthProviderMutation", m)
 }
 
+// The DataExportFunc type is an adapter to allow the use of ordinary
+// function as DataExport mutator.
+type DataExportFunc func(context.Context, *ent.DataExportMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DataExportFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DataExportMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DataExportMutation", m)
+}
+
+// The HandleHistoryFunc type is an adapter to allow the use of ordinary
+// function as HandleHistory mutator.
+type HandleHistoryFunc func(context.Context, *ent.HandleHistoryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f HandleHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.HandleHistoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.HandleHistoryMutation", m)
+}
+
+// The SessionFunc type is an adapter to allow the use of ordinary
+// function as Session mutator.
+type SessionFunc func(context.Context, *ent.SessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SessionMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
@@ -32,6 +80,30 @@ func (f UserFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserMutation", m)
 }
 
+// The UserEventFunc type is an adapter to allow the use of ordinary
+// function as UserEvent mutator.
+type UserEventFunc func(context.Context, *ent.UserEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserEventMutation", m)
+}
+
+// The WechatAccountFunc type is an adapter to allow the use of ordinary
+// function as WechatAccount mutator.
+type WechatAccountFunc func(context.Context, *ent.WechatAccountMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WechatAccountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WechatAccountMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WechatAccountMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
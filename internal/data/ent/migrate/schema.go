@@ -8,12 +8,46 @@ import (
 )
 
 var (
+	// AppleNotificationsColumns holds the columns for the "apple_notifications" table.
+	AppleNotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "event_type", Type: field.TypeString, Size: 32},
+		{Name: "subject", Type: field.TypeString, Size: 255},
+		{Name: "email", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "is_private_email", Type: field.TypeBool, Default: false},
+		{Name: "event_time", Type: field.TypeTime, Nullable: true},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "result", Type: field.TypeString, Size: 255},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AppleNotificationsTable holds the schema information for the "apple_notifications" table.
+	AppleNotificationsTable = &schema.Table{
+		Name:       "apple_notifications",
+		Columns:    AppleNotificationsColumns,
+		PrimaryKey: []*schema.Column{AppleNotificationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "applenotification_subject",
+				Unique:  false,
+				Columns: []*schema.Column{AppleNotificationsColumns[2]},
+			},
+		},
+	}
 	// AuthProvidersColumns holds the columns for the "auth_providers" table.
 	AuthProvidersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
 		{Name: "provider_type", Type: field.TypeString, Size: 20},
 		{Name: "provider_id", Type: field.TypeString, Size: 255},
+		{Name: "email", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "email_verified", Type: field.TypeBool, Default: false},
+		{Name: "name", Type: field.TypeString, Size: 100, Default: ""},
+		{Name: "avatar", Type: field.TypeString, Size: 1024, Default: ""},
+		{Name: "raw_claims", Type: field.TypeJSON, Nullable: true},
+		{Name: "access_token", Type: field.TypeString, Size: 2048, Default: ""},
+		{Name: "refresh_token", Type: field.TypeString, Size: 2048, Default: ""},
+		{Name: "token_expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "uid", Type: field.TypeInt64},
 	}
 	// AuthProvidersTable holds the schema information for the "auth_providers" table.
@@ -24,20 +58,157 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "auth_providers_users_auth_providers",
-				Columns:    []*schema.Column{AuthProvidersColumns[4]},
+				Columns:    []*schema.Column{AuthProvidersColumns[13]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "authprovider_provider_type_provider_id",
+				Unique:  true,
+				Columns: []*schema.Column{AuthProvidersColumns[1], AuthProvidersColumns[2]},
+			},
+			{
+				Name:    "authprovider_uid",
+				Unique:  false,
+				Columns: []*schema.Column{AuthProvidersColumns[13]},
+			},
+		},
+	}
+	// DataExportsColumns holds the columns for the "data_exports" table.
+	DataExportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "export_id", Type: field.TypeString, Unique: true, Size: 32},
+		{Name: "status", Type: field.TypeString, Size: 16, Default: "pending"},
+		{Name: "object_key", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "error", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "uid", Type: field.TypeInt64},
+	}
+	// DataExportsTable holds the schema information for the "data_exports" table.
+	DataExportsTable = &schema.Table{
+		Name:       "data_exports",
+		Columns:    DataExportsColumns,
+		PrimaryKey: []*schema.Column{DataExportsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "data_exports_users_data_exports",
+				Columns:    []*schema.Column{DataExportsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dataexport_uid_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{DataExportsColumns[9], DataExportsColumns[8]},
+			},
+			{
+				Name:    "dataexport_status",
+				Unique:  false,
+				Columns: []*schema.Column{DataExportsColumns[2]},
+			},
+		},
+	}
+	// HandleHistoriesColumns holds the columns for the "handle_histories" table.
+	HandleHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "handle", Type: field.TypeString, Size: 30},
+		{Name: "handle_lower", Type: field.TypeString, Size: 30},
+		{Name: "held_until", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "uid", Type: field.TypeInt64},
+	}
+	// HandleHistoriesTable holds the schema information for the "handle_histories" table.
+	HandleHistoriesTable = &schema.Table{
+		Name:       "handle_histories",
+		Columns:    HandleHistoriesColumns,
+		PrimaryKey: []*schema.Column{HandleHistoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "handle_histories_users_handle_histories",
+				Columns:    []*schema.Column{HandleHistoriesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "handlehistory_handle_lower",
+				Unique:  false,
+				Columns: []*schema.Column{HandleHistoriesColumns[2]},
+			},
+			{
+				Name:    "handlehistory_uid",
+				Unique:  false,
+				Columns: []*schema.Column{HandleHistoriesColumns[5]},
+			},
+		},
+	}
+	// SessionsColumns holds the columns for the "sessions" table.
+	SessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "session_id", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "provider", Type: field.TypeString, Size: 20},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "uid", Type: field.TypeInt64},
+	}
+	// SessionsTable holds the schema information for the "sessions" table.
+	SessionsTable = &schema.Table{
+		Name:       "sessions",
+		Columns:    SessionsColumns,
+		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sessions_users_sessions",
+				Columns:    []*schema.Column{SessionsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "session_uid",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[6]},
+			},
+		},
 	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
 		{Name: "user_id", Type: field.TypeInt64, Unique: true},
 		{Name: "name", Type: field.TypeString, Size: 100},
-		{Name: "email", Type: field.TypeString, Unique: true, Size: 255},
-		{Name: "phone", Type: field.TypeString, Unique: true, Size: 20},
+		{Name: "email", Type: field.TypeString, Unique: true, Nullable: true, Size: 255},
+		{Name: "email_verified", Type: field.TypeBool, Default: false},
+		{Name: "phone", Type: field.TypeString, Unique: true, Nullable: true, Size: 20},
 		{Name: "avatar", Type: field.TypeString, Size: 255},
+		{Name: "handle", Type: field.TypeString, Nullable: true, Size: 30},
+		{Name: "handle_lower", Type: field.TypeString, Unique: true, Nullable: true, Size: 30},
+		{Name: "handle_changed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "birthday", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "date"}},
+		{Name: "gender", Type: field.TypeString, Size: 16, Default: ""},
+		{Name: "locale", Type: field.TypeString, Size: 35, Default: ""},
+		{Name: "timezone", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "country", Type: field.TypeString, Size: 2, Default: ""},
+		{Name: "bio", Type: field.TypeString, Size: 2000, Default: ""},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "apple_account_deleted", Type: field.TypeBool, Default: false},
+		{Name: "merged_into", Type: field.TypeInt64, Nullable: true},
+		{Name: "status", Type: field.TypeString, Size: 16, Default: "active"},
+		{Name: "status_reason", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "status_changed_by", Type: field.TypeInt64, Default: 0},
+		{Name: "status_changed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "status_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deletion_requested_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deletion_scheduled_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -46,14 +217,98 @@ var (
 		Name:       "users",
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "user_deletion_scheduled_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[25]},
+			},
+			{
+				Name:    "user_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[26]},
+			},
+			{
+				Name:    "user_name",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[2]},
+			},
+		},
+	}
+	// UserEventsColumns holds the columns for the "user_events" table.
+	UserEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "event_type", Type: field.TypeString, Size: 32},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "occurred_at", Type: field.TypeTime},
+		{Name: "published_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// UserEventsTable holds the schema information for the "user_events" table.
+	UserEventsTable = &schema.Table{
+		Name:       "user_events",
+		Columns:    UserEventsColumns,
+		PrimaryKey: []*schema.Column{UserEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userevent_published_at",
+				Unique:  false,
+				Columns: []*schema.Column{UserEventsColumns[4]},
+			},
+		},
+	}
+	// WechatAccountsColumns holds the columns for the "wechat_accounts" table.
+	WechatAccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "app_id", Type: field.TypeString, Size: 64},
+		{Name: "open_id", Type: field.TypeString, Size: 128},
+		{Name: "union_id", Type: field.TypeString, Size: 128, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "uid", Type: field.TypeInt64},
+	}
+	// WechatAccountsTable holds the schema information for the "wechat_accounts" table.
+	WechatAccountsTable = &schema.Table{
+		Name:       "wechat_accounts",
+		Columns:    WechatAccountsColumns,
+		PrimaryKey: []*schema.Column{WechatAccountsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "wechat_accounts_users_wechat_accounts",
+				Columns:    []*schema.Column{WechatAccountsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "wechataccount_app_id_open_id",
+				Unique:  true,
+				Columns: []*schema.Column{WechatAccountsColumns[1], WechatAccountsColumns[2]},
+			},
+			{
+				Name:    "wechataccount_union_id",
+				Unique:  false,
+				Columns: []*schema.Column{WechatAccountsColumns[3]},
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AppleNotificationsTable,
 		AuthProvidersTable,
+		DataExportsTable,
+		HandleHistoriesTable,
+		SessionsTable,
 		UsersTable,
+		UserEventsTable,
+		WechatAccountsTable,
 	}
 )
 
 func init() {
 	AuthProvidersTable.ForeignKeys[0].RefTable = UsersTable
+	DataExportsTable.ForeignKeys[0].RefTable = UsersTable
+	HandleHistoriesTable.ForeignKeys[0].RefTable = UsersTable
+	SessionsTable.ForeignKeys[0].RefTable = UsersTable
+	WechatAccountsTable.ForeignKeys[0].RefTable = UsersTable
 }
@@ -6,8 +6,26 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AppleNotification is the predicate function for applenotification builders.
+type AppleNotification func(*sql.Selector)
+
 // AuthProvider is the predicate function for authprovider builders.
 type AuthProvider func(*sql.Selector)
 
+// DataExport is the predicate function for dataexport builders.
+type DataExport func(*sql.Selector)
+
+// HandleHistory is the predicate function for handlehistory builders.
+type HandleHistory func(*sql.Selector)
+
+// Session is the predicate function for session builders.
+type Session func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// UserEvent is the predicate function for userevent builders.
+type UserEvent func(*sql.Selector)
+
+// WechatAccount is the predicate function for wechataccount builders.
+type WechatAccount func(*sql.Selector)
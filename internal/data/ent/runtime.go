@@ -4,15 +4,49 @@ package ent
 
 import (
 	"time"
+	"user-service/internal/data/ent/applenotification"
 	"user-service/internal/data/ent/authprovider"
+	"user-service/internal/data/ent/dataexport"
+	"user-service/internal/data/ent/handlehistory"
 	"user-service/internal/data/ent/schema"
+	"user-service/internal/data/ent/session"
 	"user-service/internal/data/ent/user"
+	"user-service/internal/data/ent/userevent"
+	"user-service/internal/data/ent/wechataccount"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	applenotificationFields := schema.AppleNotification{}.Fields()
+	_ = applenotificationFields
+	// applenotificationDescEventType is the schema descriptor for event_type field.
+	applenotificationDescEventType := applenotificationFields[1].Descriptor()
+	// applenotification.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	applenotification.EventTypeValidator = applenotificationDescEventType.Validators[0].(func(string) error)
+	// applenotificationDescSubject is the schema descriptor for subject field.
+	applenotificationDescSubject := applenotificationFields[2].Descriptor()
+	// applenotification.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	applenotification.SubjectValidator = applenotificationDescSubject.Validators[0].(func(string) error)
+	// applenotificationDescEmail is the schema descriptor for email field.
+	applenotificationDescEmail := applenotificationFields[3].Descriptor()
+	// applenotification.DefaultEmail holds the default value on creation for the email field.
+	applenotification.DefaultEmail = applenotificationDescEmail.Default.(string)
+	// applenotification.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	applenotification.EmailValidator = applenotificationDescEmail.Validators[0].(func(string) error)
+	// applenotificationDescIsPrivateEmail is the schema descriptor for is_private_email field.
+	applenotificationDescIsPrivateEmail := applenotificationFields[4].Descriptor()
+	// applenotification.DefaultIsPrivateEmail holds the default value on creation for the is_private_email field.
+	applenotification.DefaultIsPrivateEmail = applenotificationDescIsPrivateEmail.Default.(bool)
+	// applenotificationDescResult is the schema descriptor for result field.
+	applenotificationDescResult := applenotificationFields[7].Descriptor()
+	// applenotification.ResultValidator is a validator for the "result" field. It is called by the builders before save.
+	applenotification.ResultValidator = applenotificationDescResult.Validators[0].(func(string) error)
+	// applenotificationDescCreatedAt is the schema descriptor for created_at field.
+	applenotificationDescCreatedAt := applenotificationFields[8].Descriptor()
+	// applenotification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	applenotification.DefaultCreatedAt = applenotificationDescCreatedAt.Default.(func() time.Time)
 	authproviderFields := schema.AuthProvider{}.Fields()
 	_ = authproviderFields
 	// authproviderDescUID is the schema descriptor for uid field.
@@ -27,10 +61,118 @@ func init() {
 	authproviderDescProviderID := authproviderFields[3].Descriptor()
 	// authprovider.ProviderIDValidator is a validator for the "provider_id" field. It is called by the builders before save.
 	authprovider.ProviderIDValidator = authproviderDescProviderID.Validators[0].(func(string) error)
+	// authproviderDescEmail is the schema descriptor for email field.
+	authproviderDescEmail := authproviderFields[4].Descriptor()
+	// authprovider.DefaultEmail holds the default value on creation for the email field.
+	authprovider.DefaultEmail = authproviderDescEmail.Default.(string)
+	// authprovider.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	authprovider.EmailValidator = authproviderDescEmail.Validators[0].(func(string) error)
+	// authproviderDescEmailVerified is the schema descriptor for email_verified field.
+	authproviderDescEmailVerified := authproviderFields[5].Descriptor()
+	// authprovider.DefaultEmailVerified holds the default value on creation for the email_verified field.
+	authprovider.DefaultEmailVerified = authproviderDescEmailVerified.Default.(bool)
+	// authproviderDescName is the schema descriptor for name field.
+	authproviderDescName := authproviderFields[6].Descriptor()
+	// authprovider.DefaultName holds the default value on creation for the name field.
+	authprovider.DefaultName = authproviderDescName.Default.(string)
+	// authprovider.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	authprovider.NameValidator = authproviderDescName.Validators[0].(func(string) error)
+	// authproviderDescAvatar is the schema descriptor for avatar field.
+	authproviderDescAvatar := authproviderFields[7].Descriptor()
+	// authprovider.DefaultAvatar holds the default value on creation for the avatar field.
+	authprovider.DefaultAvatar = authproviderDescAvatar.Default.(string)
+	// authprovider.AvatarValidator is a validator for the "avatar" field. It is called by the builders before save.
+	authprovider.AvatarValidator = authproviderDescAvatar.Validators[0].(func(string) error)
+	// authproviderDescAccessToken is the schema descriptor for access_token field.
+	authproviderDescAccessToken := authproviderFields[9].Descriptor()
+	// authprovider.DefaultAccessToken holds the default value on creation for the access_token field.
+	authprovider.DefaultAccessToken = authproviderDescAccessToken.Default.(string)
+	// authprovider.AccessTokenValidator is a validator for the "access_token" field. It is called by the builders before save.
+	authprovider.AccessTokenValidator = authproviderDescAccessToken.Validators[0].(func(string) error)
+	// authproviderDescRefreshToken is the schema descriptor for refresh_token field.
+	authproviderDescRefreshToken := authproviderFields[10].Descriptor()
+	// authprovider.DefaultRefreshToken holds the default value on creation for the refresh_token field.
+	authprovider.DefaultRefreshToken = authproviderDescRefreshToken.Default.(string)
+	// authprovider.RefreshTokenValidator is a validator for the "refresh_token" field. It is called by the builders before save.
+	authprovider.RefreshTokenValidator = authproviderDescRefreshToken.Validators[0].(func(string) error)
 	// authproviderDescCreatedAt is the schema descriptor for created_at field.
-	authproviderDescCreatedAt := authproviderFields[4].Descriptor()
+	authproviderDescCreatedAt := authproviderFields[12].Descriptor()
 	// authprovider.DefaultCreatedAt holds the default value on creation for the created_at field.
 	authprovider.DefaultCreatedAt = authproviderDescCreatedAt.Default.(func() time.Time)
+	// authproviderDescUpdatedAt is the schema descriptor for updated_at field.
+	authproviderDescUpdatedAt := authproviderFields[13].Descriptor()
+	// authprovider.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	authprovider.DefaultUpdatedAt = authproviderDescUpdatedAt.Default.(func() time.Time)
+	// authprovider.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	authprovider.UpdateDefaultUpdatedAt = authproviderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	dataexportFields := schema.DataExport{}.Fields()
+	_ = dataexportFields
+	// dataexportDescUID is the schema descriptor for uid field.
+	dataexportDescUID := dataexportFields[1].Descriptor()
+	// dataexport.UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	dataexport.UIDValidator = dataexportDescUID.Validators[0].(func(int64) error)
+	// dataexportDescExportID is the schema descriptor for export_id field.
+	dataexportDescExportID := dataexportFields[2].Descriptor()
+	// dataexport.ExportIDValidator is a validator for the "export_id" field. It is called by the builders before save.
+	dataexport.ExportIDValidator = dataexportDescExportID.Validators[0].(func(string) error)
+	// dataexportDescStatus is the schema descriptor for status field.
+	dataexportDescStatus := dataexportFields[3].Descriptor()
+	// dataexport.DefaultStatus holds the default value on creation for the status field.
+	dataexport.DefaultStatus = dataexportDescStatus.Default.(string)
+	// dataexport.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	dataexport.StatusValidator = dataexportDescStatus.Validators[0].(func(string) error)
+	// dataexportDescObjectKey is the schema descriptor for object_key field.
+	dataexportDescObjectKey := dataexportFields[4].Descriptor()
+	// dataexport.DefaultObjectKey holds the default value on creation for the object_key field.
+	dataexport.DefaultObjectKey = dataexportDescObjectKey.Default.(string)
+	// dataexport.ObjectKeyValidator is a validator for the "object_key" field. It is called by the builders before save.
+	dataexport.ObjectKeyValidator = dataexportDescObjectKey.Validators[0].(func(string) error)
+	// dataexportDescError is the schema descriptor for error field.
+	dataexportDescError := dataexportFields[5].Descriptor()
+	// dataexport.DefaultError holds the default value on creation for the error field.
+	dataexport.DefaultError = dataexportDescError.Default.(string)
+	// dataexport.ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	dataexport.ErrorValidator = dataexportDescError.Validators[0].(func(string) error)
+	// dataexportDescCreatedAt is the schema descriptor for created_at field.
+	dataexportDescCreatedAt := dataexportFields[9].Descriptor()
+	// dataexport.DefaultCreatedAt holds the default value on creation for the created_at field.
+	dataexport.DefaultCreatedAt = dataexportDescCreatedAt.Default.(func() time.Time)
+	handlehistoryFields := schema.HandleHistory{}.Fields()
+	_ = handlehistoryFields
+	// handlehistoryDescUID is the schema descriptor for uid field.
+	handlehistoryDescUID := handlehistoryFields[1].Descriptor()
+	// handlehistory.UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	handlehistory.UIDValidator = handlehistoryDescUID.Validators[0].(func(int64) error)
+	// handlehistoryDescHandle is the schema descriptor for handle field.
+	handlehistoryDescHandle := handlehistoryFields[2].Descriptor()
+	// handlehistory.HandleValidator is a validator for the "handle" field. It is called by the builders before save.
+	handlehistory.HandleValidator = handlehistoryDescHandle.Validators[0].(func(string) error)
+	// handlehistoryDescHandleLower is the schema descriptor for handle_lower field.
+	handlehistoryDescHandleLower := handlehistoryFields[3].Descriptor()
+	// handlehistory.HandleLowerValidator is a validator for the "handle_lower" field. It is called by the builders before save.
+	handlehistory.HandleLowerValidator = handlehistoryDescHandleLower.Validators[0].(func(string) error)
+	// handlehistoryDescCreatedAt is the schema descriptor for created_at field.
+	handlehistoryDescCreatedAt := handlehistoryFields[5].Descriptor()
+	// handlehistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	handlehistory.DefaultCreatedAt = handlehistoryDescCreatedAt.Default.(func() time.Time)
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescUID is the schema descriptor for uid field.
+	sessionDescUID := sessionFields[1].Descriptor()
+	// session.UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	session.UIDValidator = sessionDescUID.Validators[0].(func(int64) error)
+	// sessionDescSessionID is the schema descriptor for session_id field.
+	sessionDescSessionID := sessionFields[2].Descriptor()
+	// session.SessionIDValidator is a validator for the "session_id" field. It is called by the builders before save.
+	session.SessionIDValidator = sessionDescSessionID.Validators[0].(func(string) error)
+	// sessionDescProvider is the schema descriptor for provider field.
+	sessionDescProvider := sessionFields[3].Descriptor()
+	// session.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	session.ProviderValidator = sessionDescProvider.Validators[0].(func(string) error)
+	// sessionDescCreatedAt is the schema descriptor for created_at field.
+	sessionDescCreatedAt := sessionFields[6].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescUserID is the schema descriptor for user_id field.
@@ -45,22 +187,118 @@ func init() {
 	userDescEmail := userFields[3].Descriptor()
 	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescEmailVerified is the schema descriptor for email_verified field.
+	userDescEmailVerified := userFields[4].Descriptor()
+	// user.DefaultEmailVerified holds the default value on creation for the email_verified field.
+	user.DefaultEmailVerified = userDescEmailVerified.Default.(bool)
 	// userDescPhone is the schema descriptor for phone field.
-	userDescPhone := userFields[4].Descriptor()
+	userDescPhone := userFields[5].Descriptor()
 	// user.PhoneValidator is a validator for the "phone" field. It is called by the builders before save.
 	user.PhoneValidator = userDescPhone.Validators[0].(func(string) error)
 	// userDescAvatar is the schema descriptor for avatar field.
-	userDescAvatar := userFields[5].Descriptor()
+	userDescAvatar := userFields[6].Descriptor()
 	// user.AvatarValidator is a validator for the "avatar" field. It is called by the builders before save.
 	user.AvatarValidator = userDescAvatar.Validators[0].(func(string) error)
+	// userDescHandle is the schema descriptor for handle field.
+	userDescHandle := userFields[7].Descriptor()
+	// user.HandleValidator is a validator for the "handle" field. It is called by the builders before save.
+	user.HandleValidator = userDescHandle.Validators[0].(func(string) error)
+	// userDescHandleLower is the schema descriptor for handle_lower field.
+	userDescHandleLower := userFields[8].Descriptor()
+	// user.HandleLowerValidator is a validator for the "handle_lower" field. It is called by the builders before save.
+	user.HandleLowerValidator = userDescHandleLower.Validators[0].(func(string) error)
+	// userDescGender is the schema descriptor for gender field.
+	userDescGender := userFields[11].Descriptor()
+	// user.DefaultGender holds the default value on creation for the gender field.
+	user.DefaultGender = userDescGender.Default.(string)
+	// user.GenderValidator is a validator for the "gender" field. It is called by the builders before save.
+	user.GenderValidator = userDescGender.Validators[0].(func(string) error)
+	// userDescLocale is the schema descriptor for locale field.
+	userDescLocale := userFields[12].Descriptor()
+	// user.DefaultLocale holds the default value on creation for the locale field.
+	user.DefaultLocale = userDescLocale.Default.(string)
+	// user.LocaleValidator is a validator for the "locale" field. It is called by the builders before save.
+	user.LocaleValidator = userDescLocale.Validators[0].(func(string) error)
+	// userDescTimezone is the schema descriptor for timezone field.
+	userDescTimezone := userFields[13].Descriptor()
+	// user.DefaultTimezone holds the default value on creation for the timezone field.
+	user.DefaultTimezone = userDescTimezone.Default.(string)
+	// user.TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	user.TimezoneValidator = userDescTimezone.Validators[0].(func(string) error)
+	// userDescCountry is the schema descriptor for country field.
+	userDescCountry := userFields[14].Descriptor()
+	// user.DefaultCountry holds the default value on creation for the country field.
+	user.DefaultCountry = userDescCountry.Default.(string)
+	// user.CountryValidator is a validator for the "country" field. It is called by the builders before save.
+	user.CountryValidator = userDescCountry.Validators[0].(func(string) error)
+	// userDescBio is the schema descriptor for bio field.
+	userDescBio := userFields[15].Descriptor()
+	// user.DefaultBio holds the default value on creation for the bio field.
+	user.DefaultBio = userDescBio.Default.(string)
+	// user.BioValidator is a validator for the "bio" field. It is called by the builders before save.
+	user.BioValidator = userDescBio.Validators[0].(func(string) error)
+	// userDescAppleAccountDeleted is the schema descriptor for apple_account_deleted field.
+	userDescAppleAccountDeleted := userFields[17].Descriptor()
+	// user.DefaultAppleAccountDeleted holds the default value on creation for the apple_account_deleted field.
+	user.DefaultAppleAccountDeleted = userDescAppleAccountDeleted.Default.(bool)
+	// userDescStatus is the schema descriptor for status field.
+	userDescStatus := userFields[19].Descriptor()
+	// user.DefaultStatus holds the default value on creation for the status field.
+	user.DefaultStatus = userDescStatus.Default.(string)
+	// user.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	user.StatusValidator = userDescStatus.Validators[0].(func(string) error)
+	// userDescStatusReason is the schema descriptor for status_reason field.
+	userDescStatusReason := userFields[20].Descriptor()
+	// user.DefaultStatusReason holds the default value on creation for the status_reason field.
+	user.DefaultStatusReason = userDescStatusReason.Default.(string)
+	// user.StatusReasonValidator is a validator for the "status_reason" field. It is called by the builders before save.
+	user.StatusReasonValidator = userDescStatusReason.Validators[0].(func(string) error)
+	// userDescStatusChangedBy is the schema descriptor for status_changed_by field.
+	userDescStatusChangedBy := userFields[21].Descriptor()
+	// user.DefaultStatusChangedBy holds the default value on creation for the status_changed_by field.
+	user.DefaultStatusChangedBy = userDescStatusChangedBy.Default.(int64)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[6].Descriptor()
+	userDescCreatedAt := userFields[26].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[7].Descriptor()
+	userDescUpdatedAt := userFields[27].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	usereventFields := schema.UserEvent{}.Fields()
+	_ = usereventFields
+	// usereventDescEventType is the schema descriptor for event_type field.
+	usereventDescEventType := usereventFields[1].Descriptor()
+	// userevent.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	userevent.EventTypeValidator = usereventDescEventType.Validators[0].(func(string) error)
+	// usereventDescCreatedAt is the schema descriptor for created_at field.
+	usereventDescCreatedAt := usereventFields[5].Descriptor()
+	// userevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userevent.DefaultCreatedAt = usereventDescCreatedAt.Default.(func() time.Time)
+	wechataccountFields := schema.WechatAccount{}.Fields()
+	_ = wechataccountFields
+	// wechataccountDescUID is the schema descriptor for uid field.
+	wechataccountDescUID := wechataccountFields[1].Descriptor()
+	// wechataccount.UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	wechataccount.UIDValidator = wechataccountDescUID.Validators[0].(func(int64) error)
+	// wechataccountDescAppID is the schema descriptor for app_id field.
+	wechataccountDescAppID := wechataccountFields[2].Descriptor()
+	// wechataccount.AppIDValidator is a validator for the "app_id" field. It is called by the builders before save.
+	wechataccount.AppIDValidator = wechataccountDescAppID.Validators[0].(func(string) error)
+	// wechataccountDescOpenID is the schema descriptor for open_id field.
+	wechataccountDescOpenID := wechataccountFields[3].Descriptor()
+	// wechataccount.OpenIDValidator is a validator for the "open_id" field. It is called by the builders before save.
+	wechataccount.OpenIDValidator = wechataccountDescOpenID.Validators[0].(func(string) error)
+	// wechataccountDescUnionID is the schema descriptor for union_id field.
+	wechataccountDescUnionID := wechataccountFields[4].Descriptor()
+	// wechataccount.DefaultUnionID holds the default value on creation for the union_id field.
+	wechataccount.DefaultUnionID = wechataccountDescUnionID.Default.(string)
+	// wechataccount.UnionIDValidator is a validator for the "union_id" field. It is called by the builders before save.
+	wechataccount.UnionIDValidator = wechataccountDescUnionID.Validators[0].(func(string) error)
+	// wechataccountDescCreatedAt is the schema descriptor for created_at field.
+	wechataccountDescCreatedAt := wechataccountFields[5].Descriptor()
+	// wechataccount.DefaultCreatedAt holds the default value on creation for the created_at field.
+	wechataccount.DefaultCreatedAt = wechataccountDescCreatedAt.Default.(func() time.Time)
 }
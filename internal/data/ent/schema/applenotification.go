@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AppleNotification holds the schema definition for the AppleNotification entity.
+// Apple 服务端通知的审计记录, 每个事件一行
+type AppleNotification struct {
+	ent.Schema
+}
+
+// Fields of the AppleNotification.
+func (AppleNotification) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Unique(),
+		field.String("event_type").
+			MaxLen(32),
+		field.String("subject").
+			MaxLen(255),
+		field.String("email").
+			MaxLen(255).
+			Default(""),
+		field.Bool("is_private_email").
+			Default(false),
+		field.Time("event_time").
+			Optional().
+			Nillable(),
+		field.Text("payload"),
+		field.String("result").
+			MaxLen(255),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Indexes of the AppleNotification.
+func (AppleNotification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("subject"),
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // AuthProvider holds the schema definition for the AuthProvider entity.
@@ -18,15 +19,45 @@ func (AuthProvider) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int64("id").
 			Unique(),
+		// 一个用户可以关联多个登录方式
 		field.Int64("uid").
-			Unique().
 			Positive(),
 		field.String("provider_type"). // 改为枚举类型
 			MaxLen(20),
 		field.String("provider_id").
 			MaxLen(255), // 增加长度以匹配SQL定义
+		// 第三方返回的资料, 每个关联账号单独保存
+		field.String("email").
+			MaxLen(255).
+			Default(""),
+		field.Bool("email_verified").
+			Default(false),
+		field.String("name").
+			MaxLen(100).
+			Default(""),
+		field.String("avatar").
+			MaxLen(1024).
+			Default(""),
+		// 第三方返回的原始声明或用户信息
+		field.JSON("raw_claims", map[string]interface{}{}).
+			Optional(),
+		// 第三方令牌, 加密保存; refresh_token 注销账号时用于撤销授权
+		field.String("access_token").
+			MaxLen(2048).
+			Default("").
+			Sensitive(),
+		field.String("refresh_token").
+			MaxLen(2048).
+			Default("").
+			Sensitive(),
+		field.Time("token_expires_at").
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
 	}
 }
 
@@ -40,3 +71,12 @@ func (AuthProvider) Edges() []ent.Edge {
 			Unique(),
 	}
 }
+
+// Indexes of the AuthProvider.
+func (AuthProvider) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider_type", "provider_id").
+			Unique(),
+		index.Fields("uid"),
+	}
+}
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DataExport holds the schema definition for the DataExport entity.
+// 用户申请的个人数据导出, 由后台任务生成压缩包
+type DataExport struct {
+	ent.Schema
+}
+
+// Fields of the DataExport.
+func (DataExport) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Unique(),
+		field.Int64("uid").
+			Positive(),
+		// 对外暴露的随机ID
+		field.String("export_id").
+			MaxLen(32).
+			Unique(),
+		// pending, running, ready, failed, expired
+		field.String("status").
+			MaxLen(16).
+			Default("pending"),
+		// 私有存储中的对象 key
+		field.String("object_key").
+			MaxLen(255).
+			Default(""),
+		field.String("error").
+			MaxLen(255).
+			Default(""),
+		// 开始处理的时间, 处理中断后超时会重新处理
+		field.Time("started_at").
+			Optional().
+			Nillable(),
+		field.Time("completed_at").
+			Optional().
+			Nillable(),
+		// 导出文件删除的时间
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the DataExport.
+func (DataExport) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("data_exports").
+			Field("uid").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the DataExport.
+func (DataExport) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("uid", "created_at"),
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// HandleHistory holds the schema definition for the HandleHistory entity.
+type HandleHistory struct {
+	ent.Schema
+}
+
+// Fields of the HandleHistory.
+func (HandleHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Unique(),
+		field.Int64("uid").
+			Positive(),
+		field.String("handle").
+			MaxLen(30),
+		// 小写的 handle, 用于不区分大小写查找
+		field.String("handle_lower").
+			MaxLen(30),
+		// 保留期结束后其他用户才能使用
+		field.Time("held_until"),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the HandleHistory.
+func (HandleHistory) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("handle_histories").
+			Field("uid").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the HandleHistory.
+func (HandleHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("handle_lower"),
+		index.Fields("uid"),
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // User holds the schema definition for the User entity.
@@ -23,14 +25,95 @@ func (User) Fields() []ent.Field {
 			Positive(),
 		field.String("name").
 			MaxLen(100),
+		// 邮箱和手机号没有时为 NULL, 唯一索引只约束有值的行
 		field.String("email").
 			MaxLen(255).
+			Optional().
+			Nillable().
 			Unique(),
+		// 邮箱是否经第三方验证, 用于按邮箱关联账号
+		field.Bool("email_verified").
+			Default(false),
 		field.String("phone").
 			MaxLen(20).
+			Optional().
+			Nillable().
 			Unique(),
 		field.String("avatar").
 			MaxLen(255),
+		// @handle, 保留用户输入的大小写
+		field.String("handle").
+			MaxLen(30).
+			Optional().
+			Nillable(),
+		// 小写的 handle, 唯一索引保证不区分大小写唯一
+		field.String("handle_lower").
+			MaxLen(30).
+			Optional().
+			Nillable().
+			Unique(),
+		field.Time("handle_changed_at").
+			Optional().
+			Nillable(),
+		field.Time("birthday").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.MySQL: "date"}),
+		// male, female, other, 空表示未设置
+		field.String("gender").
+			MaxLen(16).
+			Default(""),
+		// BCP 47 语言标签, 如 zh-CN
+		field.String("locale").
+			MaxLen(35).
+			Default(""),
+		// IANA 时区, 如 Asia/Shanghai
+		field.String("timezone").
+			MaxLen(64).
+			Default(""),
+		// ISO 3166-1 alpha-2 国家代码
+		field.String("country").
+			MaxLen(2).
+			Default(""),
+		field.String("bio").
+			MaxLen(2000).
+			Default(""),
+		// 业务自定义的键值对
+		field.JSON("metadata", map[string]string{}).
+			Optional(),
+		// Apple ID 已被用户注销, 需要改用其他方式登录
+		field.Bool("apple_account_deleted").
+			Default(false),
+		// 账号已合并到该 user_id
+		field.Int64("merged_into").
+			Optional().
+			Nillable(),
+		// active, suspended, banned, disabled
+		field.String("status").
+			MaxLen(16).
+			Default("active"),
+		// 停用原因代码, 如 spam, fraud
+		field.String("status_reason").
+			MaxLen(255).
+			Default(""),
+		// 修改状态的管理员 user_id, 0 表示系统
+		field.Int64("status_changed_by").
+			Default(0),
+		field.Time("status_changed_at").
+			Optional().
+			Nillable(),
+		// 停用结束的时间, 为空表示永久
+		field.Time("status_expires_at").
+			Optional().
+			Nillable(),
+		// 申请注销的时间, 重新登录时清空
+		field.Time("deletion_requested_at").
+			Optional().
+			Nillable(),
+		// 冷静期结束的时间, 之后由后台任务清除账号
+		field.Time("deletion_scheduled_at").
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Default(time.Now),
 		field.Time("updated_at").
@@ -43,5 +126,19 @@ func (User) Fields() []ent.Field {
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("auth_providers", AuthProvider.Type),
+		edge.To("wechat_accounts", WechatAccount.Type),
+		edge.To("sessions", Session.Type),
+		edge.To("handle_histories", HandleHistory.Type),
+		edge.To("data_exports", DataExport.Type),
+	}
+}
+
+// Indexes of the User.
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("deletion_scheduled_at"),
+		// 管理后台按注册时间排序和按昵称前缀搜索
+		index.Fields("created_at"),
+		index.Fields("name"),
 	}
 }
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserEvent holds the schema definition for the UserEvent entity.
+// 待发布的用户事件, 与业务数据同一事务写入, 由后台任务转发到 Redis Stream
+type UserEvent struct {
+	ent.Schema
+}
+
+// Fields of the UserEvent.
+func (UserEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Unique(),
+		field.String("event_type").
+			MaxLen(32),
+		// 不关联 users, 账号删除后事件仍需发布
+		field.Int64("user_id"),
+		field.Time("occurred_at"),
+		// 写入 Stream 的时间, 为空表示待发布
+		field.Time("published_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Indexes of the UserEvent.
+func (UserEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("published_at"),
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WechatAccount holds the schema definition for the WechatAccount entity.
+// 每个微信应用(移动应用/小程序)下的 openid 与用户的关联
+type WechatAccount struct {
+	ent.Schema
+}
+
+// Fields of the WechatAccount.
+func (WechatAccount) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Unique(),
+		field.Int64("uid").
+			Positive(),
+		field.String("app_id").
+			MaxLen(64),
+		field.String("open_id").
+			MaxLen(128),
+		field.String("union_id").
+			MaxLen(128).
+			Default(""),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the WechatAccount.
+func (WechatAccount) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("wechat_accounts").
+			Field("uid").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the WechatAccount.
+func (WechatAccount) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("app_id", "open_id").
+			Unique(),
+		index.Fields("union_id"),
+	}
+}
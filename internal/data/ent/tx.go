@@ -12,10 +12,22 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AppleNotification is the client for interacting with the AppleNotification builders.
+	AppleNotification *AppleNotificationClient
 	// AuthProvider is the client for interacting with the AuthProvider builders.
 	AuthProvider *AuthProviderClient
+	// DataExport is the client for interacting with the DataExport builders.
+	DataExport *DataExportClient
+	// HandleHistory is the client for interacting with the HandleHistory builders.
+	HandleHistory *HandleHistoryClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserEvent is the client for interacting with the UserEvent builders.
+	UserEvent *UserEventClient
+	// WechatAccount is the client for interacting with the WechatAccount builders.
+	WechatAccount *WechatAccountClient
 
 	// lazily loaded.
 	client     *Client
@@ -147,8 +159,14 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AppleNotification = NewAppleNotificationClient(tx.config)
 	tx.AuthProvider = NewAuthProviderClient(tx.config)
+	tx.DataExport = NewDataExportClient(tx.config)
+	tx.HandleHistory = NewHandleHistoryClient(tx.config)
+	tx.Session = NewSessionClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserEvent = NewUserEventClient(tx.config)
+	tx.WechatAccount = NewWechatAccountClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -158,7 +176,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: AuthProvider.QueryXXX(), the query will be executed
+// applies a query, for example: AppleNotification.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
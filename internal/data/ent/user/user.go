@@ -20,16 +20,64 @@ const (
 	FieldName = "name"
 	// FieldEmail holds the string denoting the email field in the database.
 	FieldEmail = "email"
+	// FieldEmailVerified holds the string denoting the email_verified field in the database.
+	FieldEmailVerified = "email_verified"
 	// FieldPhone holds the string denoting the phone field in the database.
 	FieldPhone = "phone"
 	// FieldAvatar holds the string denoting the avatar field in the database.
 	FieldAvatar = "avatar"
+	// FieldHandle holds the string denoting the handle field in the database.
+	FieldHandle = "handle"
+	// FieldHandleLower holds the string denoting the handle_lower field in the database.
+	FieldHandleLower = "handle_lower"
+	// FieldHandleChangedAt holds the string denoting the handle_changed_at field in the database.
+	FieldHandleChangedAt = "handle_changed_at"
+	// FieldBirthday holds the string denoting the birthday field in the database.
+	FieldBirthday = "birthday"
+	// FieldGender holds the string denoting the gender field in the database.
+	FieldGender = "gender"
+	// FieldLocale holds the string denoting the locale field in the database.
+	FieldLocale = "locale"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
+	// FieldCountry holds the string denoting the country field in the database.
+	FieldCountry = "country"
+	// FieldBio holds the string denoting the bio field in the database.
+	FieldBio = "bio"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldAppleAccountDeleted holds the string denoting the apple_account_deleted field in the database.
+	FieldAppleAccountDeleted = "apple_account_deleted"
+	// FieldMergedInto holds the string denoting the merged_into field in the database.
+	FieldMergedInto = "merged_into"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldStatusReason holds the string denoting the status_reason field in the database.
+	FieldStatusReason = "status_reason"
+	// FieldStatusChangedBy holds the string denoting the status_changed_by field in the database.
+	FieldStatusChangedBy = "status_changed_by"
+	// FieldStatusChangedAt holds the string denoting the status_changed_at field in the database.
+	FieldStatusChangedAt = "status_changed_at"
+	// FieldStatusExpiresAt holds the string denoting the status_expires_at field in the database.
+	FieldStatusExpiresAt = "status_expires_at"
+	// FieldDeletionRequestedAt holds the string denoting the deletion_requested_at field in the database.
+	FieldDeletionRequestedAt = "deletion_requested_at"
+	// FieldDeletionScheduledAt holds the string denoting the deletion_scheduled_at field in the database.
+	FieldDeletionScheduledAt = "deletion_scheduled_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// EdgeAuthProviders holds the string denoting the auth_providers edge name in mutations.
 	EdgeAuthProviders = "auth_providers"
+	// EdgeWechatAccounts holds the string denoting the wechat_accounts edge name in mutations.
+	EdgeWechatAccounts = "wechat_accounts"
+	// EdgeSessions holds the string denoting the sessions edge name in mutations.
+	EdgeSessions = "sessions"
+	// EdgeHandleHistories holds the string denoting the handle_histories edge name in mutations.
+	EdgeHandleHistories = "handle_histories"
+	// EdgeDataExports holds the string denoting the data_exports edge name in mutations.
+	EdgeDataExports = "data_exports"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// AuthProvidersTable is the table that holds the auth_providers relation/edge.
@@ -39,6 +87,34 @@ const (
 	AuthProvidersInverseTable = "auth_providers"
 	// AuthProvidersColumn is the table column denoting the auth_providers relation/edge.
 	AuthProvidersColumn = "uid"
+	// WechatAccountsTable is the table that holds the wechat_accounts relation/edge.
+	WechatAccountsTable = "wechat_accounts"
+	// WechatAccountsInverseTable is the table name for the WechatAccount entity.
+	// It exists in this package in order to avoid circular dependency with the "wechataccount" package.
+	WechatAccountsInverseTable = "wechat_accounts"
+	// WechatAccountsColumn is the table column denoting the wechat_accounts relation/edge.
+	WechatAccountsColumn = "uid"
+	// SessionsTable is the table that holds the sessions relation/edge.
+	SessionsTable = "sessions"
+	// SessionsInverseTable is the table name for the Session entity.
+	// It exists in this package in order to avoid circular dependency with the "session" package.
+	SessionsInverseTable = "sessions"
+	// SessionsColumn is the table column denoting the sessions relation/edge.
+	SessionsColumn = "uid"
+	// HandleHistoriesTable is the table that holds the handle_histories relation/edge.
+	HandleHistoriesTable = "handle_histories"
+	// HandleHistoriesInverseTable is the table name for the HandleHistory entity.
+	// It exists in this package in order to avoid circular dependency with the "handlehistory" package.
+	HandleHistoriesInverseTable = "handle_histories"
+	// HandleHistoriesColumn is the table column denoting the handle_histories relation/edge.
+	HandleHistoriesColumn = "uid"
+	// DataExportsTable is the table that holds the data_exports relation/edge.
+	DataExportsTable = "data_exports"
+	// DataExportsInverseTable is the table name for the DataExport entity.
+	// It exists in this package in order to avoid circular dependency with the "dataexport" package.
+	DataExportsInverseTable = "data_exports"
+	// DataExportsColumn is the table column denoting the data_exports relation/edge.
+	DataExportsColumn = "uid"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -47,8 +123,28 @@ var Columns = []string{
 	FieldUserID,
 	FieldName,
 	FieldEmail,
+	FieldEmailVerified,
 	FieldPhone,
 	FieldAvatar,
+	FieldHandle,
+	FieldHandleLower,
+	FieldHandleChangedAt,
+	FieldBirthday,
+	FieldGender,
+	FieldLocale,
+	FieldTimezone,
+	FieldCountry,
+	FieldBio,
+	FieldMetadata,
+	FieldAppleAccountDeleted,
+	FieldMergedInto,
+	FieldStatus,
+	FieldStatusReason,
+	FieldStatusChangedBy,
+	FieldStatusChangedAt,
+	FieldStatusExpiresAt,
+	FieldDeletionRequestedAt,
+	FieldDeletionScheduledAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -70,10 +166,48 @@ var (
 	NameValidator func(string) error
 	// EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	EmailValidator func(string) error
+	// DefaultEmailVerified holds the default value on creation for the "email_verified" field.
+	DefaultEmailVerified bool
 	// PhoneValidator is a validator for the "phone" field. It is called by the builders before save.
 	PhoneValidator func(string) error
 	// AvatarValidator is a validator for the "avatar" field. It is called by the builders before save.
 	AvatarValidator func(string) error
+	// HandleValidator is a validator for the "handle" field. It is called by the builders before save.
+	HandleValidator func(string) error
+	// HandleLowerValidator is a validator for the "handle_lower" field. It is called by the builders before save.
+	HandleLowerValidator func(string) error
+	// DefaultGender holds the default value on creation for the "gender" field.
+	DefaultGender string
+	// GenderValidator is a validator for the "gender" field. It is called by the builders before save.
+	GenderValidator func(string) error
+	// DefaultLocale holds the default value on creation for the "locale" field.
+	DefaultLocale string
+	// LocaleValidator is a validator for the "locale" field. It is called by the builders before save.
+	LocaleValidator func(string) error
+	// DefaultTimezone holds the default value on creation for the "timezone" field.
+	DefaultTimezone string
+	// TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	TimezoneValidator func(string) error
+	// DefaultCountry holds the default value on creation for the "country" field.
+	DefaultCountry string
+	// CountryValidator is a validator for the "country" field. It is called by the builders before save.
+	CountryValidator func(string) error
+	// DefaultBio holds the default value on creation for the "bio" field.
+	DefaultBio string
+	// BioValidator is a validator for the "bio" field. It is called by the builders before save.
+	BioValidator func(string) error
+	// DefaultAppleAccountDeleted holds the default value on creation for the "apple_account_deleted" field.
+	DefaultAppleAccountDeleted bool
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	StatusValidator func(string) error
+	// DefaultStatusReason holds the default value on creation for the "status_reason" field.
+	DefaultStatusReason string
+	// StatusReasonValidator is a validator for the "status_reason" field. It is called by the builders before save.
+	StatusReasonValidator func(string) error
+	// DefaultStatusChangedBy holds the default value on creation for the "status_changed_by" field.
+	DefaultStatusChangedBy int64
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -105,6 +239,11 @@ func ByEmail(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmail, opts...).ToFunc()
 }
 
+// ByEmailVerified orders the results by the email_verified field.
+func ByEmailVerified(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailVerified, opts...).ToFunc()
+}
+
 // ByPhone orders the results by the phone field.
 func ByPhone(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPhone, opts...).ToFunc()
@@ -115,6 +254,96 @@ func ByAvatar(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAvatar, opts...).ToFunc()
 }
 
+// ByHandle orders the results by the handle field.
+func ByHandle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHandle, opts...).ToFunc()
+}
+
+// ByHandleLower orders the results by the handle_lower field.
+func ByHandleLower(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHandleLower, opts...).ToFunc()
+}
+
+// ByHandleChangedAt orders the results by the handle_changed_at field.
+func ByHandleChangedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHandleChangedAt, opts...).ToFunc()
+}
+
+// ByBirthday orders the results by the birthday field.
+func ByBirthday(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBirthday, opts...).ToFunc()
+}
+
+// ByGender orders the results by the gender field.
+func ByGender(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGender, opts...).ToFunc()
+}
+
+// ByLocale orders the results by the locale field.
+func ByLocale(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocale, opts...).ToFunc()
+}
+
+// ByTimezone orders the results by the timezone field.
+func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
+}
+
+// ByCountry orders the results by the country field.
+func ByCountry(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCountry, opts...).ToFunc()
+}
+
+// ByBio orders the results by the bio field.
+func ByBio(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBio, opts...).ToFunc()
+}
+
+// ByAppleAccountDeleted orders the results by the apple_account_deleted field.
+func ByAppleAccountDeleted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppleAccountDeleted, opts...).ToFunc()
+}
+
+// ByMergedInto orders the results by the merged_into field.
+func ByMergedInto(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMergedInto, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByStatusReason orders the results by the status_reason field.
+func ByStatusReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusReason, opts...).ToFunc()
+}
+
+// ByStatusChangedBy orders the results by the status_changed_by field.
+func ByStatusChangedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusChangedBy, opts...).ToFunc()
+}
+
+// ByStatusChangedAt orders the results by the status_changed_at field.
+func ByStatusChangedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusChangedAt, opts...).ToFunc()
+}
+
+// ByStatusExpiresAt orders the results by the status_expires_at field.
+func ByStatusExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusExpiresAt, opts...).ToFunc()
+}
+
+// ByDeletionRequestedAt orders the results by the deletion_requested_at field.
+func ByDeletionRequestedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletionRequestedAt, opts...).ToFunc()
+}
+
+// ByDeletionScheduledAt orders the results by the deletion_scheduled_at field.
+func ByDeletionScheduledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletionScheduledAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -138,6 +367,62 @@ func ByAuthProviders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newAuthProvidersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByWechatAccountsCount orders the results by wechat_accounts count.
+func ByWechatAccountsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWechatAccountsStep(), opts...)
+	}
+}
+
+// ByWechatAccounts orders the results by wechat_accounts terms.
+func ByWechatAccounts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWechatAccountsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySessionsCount orders the results by sessions count.
+func BySessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSessionsStep(), opts...)
+	}
+}
+
+// BySessions orders the results by sessions terms.
+func BySessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByHandleHistoriesCount orders the results by handle_histories count.
+func ByHandleHistoriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newHandleHistoriesStep(), opts...)
+	}
+}
+
+// ByHandleHistories orders the results by handle_histories terms.
+func ByHandleHistories(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHandleHistoriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDataExportsCount orders the results by data_exports count.
+func ByDataExportsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDataExportsStep(), opts...)
+	}
+}
+
+// ByDataExports orders the results by data_exports terms.
+func ByDataExports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDataExportsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newAuthProvidersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -145,3 +430,31 @@ func newAuthProvidersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, AuthProvidersTable, AuthProvidersColumn),
 	)
 }
+func newWechatAccountsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WechatAccountsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WechatAccountsTable, WechatAccountsColumn),
+	)
+}
+func newSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
+	)
+}
+func newHandleHistoriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HandleHistoriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, HandleHistoriesTable, HandleHistoriesColumn),
+	)
+}
+func newDataExportsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DataExportsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DataExportsTable, DataExportsColumn),
+	)
+}
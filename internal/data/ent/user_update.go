@@ -8,8 +8,12 @@ import (
 	"fmt"
 	"time"
 	"user-service/internal/data/ent/authprovider"
+	"user-service/internal/data/ent/dataexport"
+	"user-service/internal/data/ent/handlehistory"
 	"user-service/internal/data/ent/predicate"
+	"user-service/internal/data/ent/session"
 	"user-service/internal/data/ent/user"
+	"user-service/internal/data/ent/wechataccount"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -78,6 +82,26 @@ func (_u *UserUpdate) SetNillableEmail(v *string) *UserUpdate {
 	return _u
 }
 
+// ClearEmail clears the value of the "email" field.
+func (_u *UserUpdate) ClearEmail() *UserUpdate {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (_u *UserUpdate) SetEmailVerified(v bool) *UserUpdate {
+	_u.mutation.SetEmailVerified(v)
+	return _u
+}
+
+// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableEmailVerified(v *bool) *UserUpdate {
+	if v != nil {
+		_u.SetEmailVerified(*v)
+	}
+	return _u
+}
+
 // SetPhone sets the "phone" field.
 func (_u *UserUpdate) SetPhone(v string) *UserUpdate {
 	_u.mutation.SetPhone(v)
@@ -92,6 +116,12 @@ func (_u *UserUpdate) SetNillablePhone(v *string) *UserUpdate {
 	return _u
 }
 
+// ClearPhone clears the value of the "phone" field.
+func (_u *UserUpdate) ClearPhone() *UserUpdate {
+	_u.mutation.ClearPhone()
+	return _u
+}
+
 // SetAvatar sets the "avatar" field.
 func (_u *UserUpdate) SetAvatar(v string) *UserUpdate {
 	_u.mutation.SetAvatar(v)
@@ -106,6 +136,338 @@ func (_u *UserUpdate) SetNillableAvatar(v *string) *UserUpdate {
 	return _u
 }
 
+// SetHandle sets the "handle" field.
+func (_u *UserUpdate) SetHandle(v string) *UserUpdate {
+	_u.mutation.SetHandle(v)
+	return _u
+}
+
+// SetNillableHandle sets the "handle" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableHandle(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetHandle(*v)
+	}
+	return _u
+}
+
+// ClearHandle clears the value of the "handle" field.
+func (_u *UserUpdate) ClearHandle() *UserUpdate {
+	_u.mutation.ClearHandle()
+	return _u
+}
+
+// SetHandleLower sets the "handle_lower" field.
+func (_u *UserUpdate) SetHandleLower(v string) *UserUpdate {
+	_u.mutation.SetHandleLower(v)
+	return _u
+}
+
+// SetNillableHandleLower sets the "handle_lower" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableHandleLower(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetHandleLower(*v)
+	}
+	return _u
+}
+
+// ClearHandleLower clears the value of the "handle_lower" field.
+func (_u *UserUpdate) ClearHandleLower() *UserUpdate {
+	_u.mutation.ClearHandleLower()
+	return _u
+}
+
+// SetHandleChangedAt sets the "handle_changed_at" field.
+func (_u *UserUpdate) SetHandleChangedAt(v time.Time) *UserUpdate {
+	_u.mutation.SetHandleChangedAt(v)
+	return _u
+}
+
+// SetNillableHandleChangedAt sets the "handle_changed_at" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableHandleChangedAt(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetHandleChangedAt(*v)
+	}
+	return _u
+}
+
+// ClearHandleChangedAt clears the value of the "handle_changed_at" field.
+func (_u *UserUpdate) ClearHandleChangedAt() *UserUpdate {
+	_u.mutation.ClearHandleChangedAt()
+	return _u
+}
+
+// SetBirthday sets the "birthday" field.
+func (_u *UserUpdate) SetBirthday(v time.Time) *UserUpdate {
+	_u.mutation.SetBirthday(v)
+	return _u
+}
+
+// SetNillableBirthday sets the "birthday" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableBirthday(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetBirthday(*v)
+	}
+	return _u
+}
+
+// ClearBirthday clears the value of the "birthday" field.
+func (_u *UserUpdate) ClearBirthday() *UserUpdate {
+	_u.mutation.ClearBirthday()
+	return _u
+}
+
+// SetGender sets the "gender" field.
+func (_u *UserUpdate) SetGender(v string) *UserUpdate {
+	_u.mutation.SetGender(v)
+	return _u
+}
+
+// SetNillableGender sets the "gender" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableGender(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetGender(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *UserUpdate) SetLocale(v string) *UserUpdate {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableLocale(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *UserUpdate) SetTimezone(v string) *UserUpdate {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableTimezone(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetCountry sets the "country" field.
+func (_u *UserUpdate) SetCountry(v string) *UserUpdate {
+	_u.mutation.SetCountry(v)
+	return _u
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableCountry(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetCountry(*v)
+	}
+	return _u
+}
+
+// SetBio sets the "bio" field.
+func (_u *UserUpdate) SetBio(v string) *UserUpdate {
+	_u.mutation.SetBio(v)
+	return _u
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableBio(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetBio(*v)
+	}
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *UserUpdate) SetMetadata(v map[string]string) *UserUpdate {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (_u *UserUpdate) ClearMetadata() *UserUpdate {
+	_u.mutation.ClearMetadata()
+	return _u
+}
+
+// SetAppleAccountDeleted sets the "apple_account_deleted" field.
+func (_u *UserUpdate) SetAppleAccountDeleted(v bool) *UserUpdate {
+	_u.mutation.SetAppleAccountDeleted(v)
+	return _u
+}
+
+// SetNillableAppleAccountDeleted sets the "apple_account_deleted" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableAppleAccountDeleted(v *bool) *UserUpdate {
+	if v != nil {
+		_u.SetAppleAccountDeleted(*v)
+	}
+	return _u
+}
+
+// SetMergedInto sets the "merged_into" field.
+func (_u *UserUpdate) SetMergedInto(v int64) *UserUpdate {
+	_u.mutation.ResetMergedInto()
+	_u.mutation.SetMergedInto(v)
+	return _u
+}
+
+// SetNillableMergedInto sets the "merged_into" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableMergedInto(v *int64) *UserUpdate {
+	if v != nil {
+		_u.SetMergedInto(*v)
+	}
+	return _u
+}
+
+// AddMergedInto adds value to the "merged_into" field.
+func (_u *UserUpdate) AddMergedInto(v int64) *UserUpdate {
+	_u.mutation.AddMergedInto(v)
+	return _u
+}
+
+// ClearMergedInto clears the value of the "merged_into" field.
+func (_u *UserUpdate) ClearMergedInto() *UserUpdate {
+	_u.mutation.ClearMergedInto()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *UserUpdate) SetStatus(v string) *UserUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableStatus(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStatusReason sets the "status_reason" field.
+func (_u *UserUpdate) SetStatusReason(v string) *UserUpdate {
+	_u.mutation.SetStatusReason(v)
+	return _u
+}
+
+// SetNillableStatusReason sets the "status_reason" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableStatusReason(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetStatusReason(*v)
+	}
+	return _u
+}
+
+// SetStatusChangedBy sets the "status_changed_by" field.
+func (_u *UserUpdate) SetStatusChangedBy(v int64) *UserUpdate {
+	_u.mutation.ResetStatusChangedBy()
+	_u.mutation.SetStatusChangedBy(v)
+	return _u
+}
+
+// SetNillableStatusChangedBy sets the "status_changed_by" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableStatusChangedBy(v *int64) *UserUpdate {
+	if v != nil {
+		_u.SetStatusChangedBy(*v)
+	}
+	return _u
+}
+
+// AddStatusChangedBy adds value to the "status_changed_by" field.
+func (_u *UserUpdate) AddStatusChangedBy(v int64) *UserUpdate {
+	_u.mutation.AddStatusChangedBy(v)
+	return _u
+}
+
+// SetStatusChangedAt sets the "status_changed_at" field.
+func (_u *UserUpdate) SetStatusChangedAt(v time.Time) *UserUpdate {
+	_u.mutation.SetStatusChangedAt(v)
+	return _u
+}
+
+// SetNillableStatusChangedAt sets the "status_changed_at" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableStatusChangedAt(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetStatusChangedAt(*v)
+	}
+	return _u
+}
+
+// ClearStatusChangedAt clears the value of the "status_changed_at" field.
+func (_u *UserUpdate) ClearStatusChangedAt() *UserUpdate {
+	_u.mutation.ClearStatusChangedAt()
+	return _u
+}
+
+// SetStatusExpiresAt sets the "status_expires_at" field.
+func (_u *UserUpdate) SetStatusExpiresAt(v time.Time) *UserUpdate {
+	_u.mutation.SetStatusExpiresAt(v)
+	return _u
+}
+
+// SetNillableStatusExpiresAt sets the "status_expires_at" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableStatusExpiresAt(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetStatusExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearStatusExpiresAt clears the value of the "status_expires_at" field.
+func (_u *UserUpdate) ClearStatusExpiresAt() *UserUpdate {
+	_u.mutation.ClearStatusExpiresAt()
+	return _u
+}
+
+// SetDeletionRequestedAt sets the "deletion_requested_at" field.
+func (_u *UserUpdate) SetDeletionRequestedAt(v time.Time) *UserUpdate {
+	_u.mutation.SetDeletionRequestedAt(v)
+	return _u
+}
+
+// SetNillableDeletionRequestedAt sets the "deletion_requested_at" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableDeletionRequestedAt(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetDeletionRequestedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletionRequestedAt clears the value of the "deletion_requested_at" field.
+func (_u *UserUpdate) ClearDeletionRequestedAt() *UserUpdate {
+	_u.mutation.ClearDeletionRequestedAt()
+	return _u
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (_u *UserUpdate) SetDeletionScheduledAt(v time.Time) *UserUpdate {
+	_u.mutation.SetDeletionScheduledAt(v)
+	return _u
+}
+
+// SetNillableDeletionScheduledAt sets the "deletion_scheduled_at" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableDeletionScheduledAt(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetDeletionScheduledAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletionScheduledAt clears the value of the "deletion_scheduled_at" field.
+func (_u *UserUpdate) ClearDeletionScheduledAt() *UserUpdate {
+	_u.mutation.ClearDeletionScheduledAt()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *UserUpdate) SetCreatedAt(v time.Time) *UserUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -141,6 +503,66 @@ func (_u *UserUpdate) AddAuthProviders(v ...*AuthProvider) *UserUpdate {
 	return _u.AddAuthProviderIDs(ids...)
 }
 
+// AddWechatAccountIDs adds the "wechat_accounts" edge to the WechatAccount entity by IDs.
+func (_u *UserUpdate) AddWechatAccountIDs(ids ...int64) *UserUpdate {
+	_u.mutation.AddWechatAccountIDs(ids...)
+	return _u
+}
+
+// AddWechatAccounts adds the "wechat_accounts" edges to the WechatAccount entity.
+func (_u *UserUpdate) AddWechatAccounts(v ...*WechatAccount) *UserUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWechatAccountIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (_u *UserUpdate) AddSessionIDs(ids ...int64) *UserUpdate {
+	_u.mutation.AddSessionIDs(ids...)
+	return _u
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (_u *UserUpdate) AddSessions(v ...*Session) *UserUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSessionIDs(ids...)
+}
+
+// AddHandleHistoryIDs adds the "handle_histories" edge to the HandleHistory entity by IDs.
+func (_u *UserUpdate) AddHandleHistoryIDs(ids ...int64) *UserUpdate {
+	_u.mutation.AddHandleHistoryIDs(ids...)
+	return _u
+}
+
+// AddHandleHistories adds the "handle_histories" edges to the HandleHistory entity.
+func (_u *UserUpdate) AddHandleHistories(v ...*HandleHistory) *UserUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddHandleHistoryIDs(ids...)
+}
+
+// AddDataExportIDs adds the "data_exports" edge to the DataExport entity by IDs.
+func (_u *UserUpdate) AddDataExportIDs(ids ...int64) *UserUpdate {
+	_u.mutation.AddDataExportIDs(ids...)
+	return _u
+}
+
+// AddDataExports adds the "data_exports" edges to the DataExport entity.
+func (_u *UserUpdate) AddDataExports(v ...*DataExport) *UserUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDataExportIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
@@ -167,28 +589,112 @@ func (_u *UserUpdate) RemoveAuthProviders(v ...*AuthProvider) *UserUpdate {
 	return _u.RemoveAuthProviderIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// ClearWechatAccounts clears all "wechat_accounts" edges to the WechatAccount entity.
+func (_u *UserUpdate) ClearWechatAccounts() *UserUpdate {
+	_u.mutation.ClearWechatAccounts()
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *UserUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// RemoveWechatAccountIDs removes the "wechat_accounts" edge to WechatAccount entities by IDs.
+func (_u *UserUpdate) RemoveWechatAccountIDs(ids ...int64) *UserUpdate {
+	_u.mutation.RemoveWechatAccountIDs(ids...)
+	return _u
+}
+
+// RemoveWechatAccounts removes "wechat_accounts" edges to WechatAccount entities.
+func (_u *UserUpdate) RemoveWechatAccounts(v ...*WechatAccount) *UserUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return affected
+	return _u.RemoveWechatAccountIDs(ids...)
 }
 
-// Exec executes the query.
-func (_u *UserUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (_u *UserUpdate) ClearSessions() *UserUpdate {
+	_u.mutation.ClearSessions()
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (_u *UserUpdate) RemoveSessionIDs(ids ...int64) *UserUpdate {
+	_u.mutation.RemoveSessionIDs(ids...)
+	return _u
+}
+
+// RemoveSessions removes "sessions" edges to Session entities.
+func (_u *UserUpdate) RemoveSessions(v ...*Session) *UserUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSessionIDs(ids...)
+}
+
+// ClearHandleHistories clears all "handle_histories" edges to the HandleHistory entity.
+func (_u *UserUpdate) ClearHandleHistories() *UserUpdate {
+	_u.mutation.ClearHandleHistories()
+	return _u
+}
+
+// RemoveHandleHistoryIDs removes the "handle_histories" edge to HandleHistory entities by IDs.
+func (_u *UserUpdate) RemoveHandleHistoryIDs(ids ...int64) *UserUpdate {
+	_u.mutation.RemoveHandleHistoryIDs(ids...)
+	return _u
+}
+
+// RemoveHandleHistories removes "handle_histories" edges to HandleHistory entities.
+func (_u *UserUpdate) RemoveHandleHistories(v ...*HandleHistory) *UserUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveHandleHistoryIDs(ids...)
+}
+
+// ClearDataExports clears all "data_exports" edges to the DataExport entity.
+func (_u *UserUpdate) ClearDataExports() *UserUpdate {
+	_u.mutation.ClearDataExports()
+	return _u
+}
+
+// RemoveDataExportIDs removes the "data_exports" edge to DataExport entities by IDs.
+func (_u *UserUpdate) RemoveDataExportIDs(ids ...int64) *UserUpdate {
+	_u.mutation.RemoveDataExportIDs(ids...)
+	return _u
+}
+
+// RemoveDataExports removes "data_exports" edges to DataExport entities.
+func (_u *UserUpdate) RemoveDataExports(v ...*DataExport) *UserUpdate {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDataExportIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
 func (_u *UserUpdate) ExecX(ctx context.Context) {
 	if err := _u.Exec(ctx); err != nil {
 		panic(err)
@@ -230,6 +736,51 @@ func (_u *UserUpdate) check() error {
 			return &ValidationError{Name: "avatar", err: fmt.Errorf(`ent: validator failed for field "User.avatar": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Handle(); ok {
+		if err := user.HandleValidator(v); err != nil {
+			return &ValidationError{Name: "handle", err: fmt.Errorf(`ent: validator failed for field "User.handle": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HandleLower(); ok {
+		if err := user.HandleLowerValidator(v); err != nil {
+			return &ValidationError{Name: "handle_lower", err: fmt.Errorf(`ent: validator failed for field "User.handle_lower": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Gender(); ok {
+		if err := user.GenderValidator(v); err != nil {
+			return &ValidationError{Name: "gender", err: fmt.Errorf(`ent: validator failed for field "User.gender": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Locale(); ok {
+		if err := user.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "User.locale": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Timezone(); ok {
+		if err := user.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "User.timezone": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Country(); ok {
+		if err := user.CountryValidator(v); err != nil {
+			return &ValidationError{Name: "country", err: fmt.Errorf(`ent: validator failed for field "User.country": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Bio(); ok {
+		if err := user.BioValidator(v); err != nil {
+			return &ValidationError{Name: "bio", err: fmt.Errorf(`ent: validator failed for field "User.bio": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := user.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "User.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StatusReason(); ok {
+		if err := user.StatusReasonValidator(v); err != nil {
+			return &ValidationError{Name: "status_reason", err: fmt.Errorf(`ent: validator failed for field "User.status_reason": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -257,12 +808,114 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Email(); ok {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(user.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.EmailVerified(); ok {
+		_spec.SetField(user.FieldEmailVerified, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.Phone(); ok {
 		_spec.SetField(user.FieldPhone, field.TypeString, value)
 	}
+	if _u.mutation.PhoneCleared() {
+		_spec.ClearField(user.FieldPhone, field.TypeString)
+	}
 	if value, ok := _u.mutation.Avatar(); ok {
 		_spec.SetField(user.FieldAvatar, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Handle(); ok {
+		_spec.SetField(user.FieldHandle, field.TypeString, value)
+	}
+	if _u.mutation.HandleCleared() {
+		_spec.ClearField(user.FieldHandle, field.TypeString)
+	}
+	if value, ok := _u.mutation.HandleLower(); ok {
+		_spec.SetField(user.FieldHandleLower, field.TypeString, value)
+	}
+	if _u.mutation.HandleLowerCleared() {
+		_spec.ClearField(user.FieldHandleLower, field.TypeString)
+	}
+	if value, ok := _u.mutation.HandleChangedAt(); ok {
+		_spec.SetField(user.FieldHandleChangedAt, field.TypeTime, value)
+	}
+	if _u.mutation.HandleChangedAtCleared() {
+		_spec.ClearField(user.FieldHandleChangedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Birthday(); ok {
+		_spec.SetField(user.FieldBirthday, field.TypeTime, value)
+	}
+	if _u.mutation.BirthdayCleared() {
+		_spec.ClearField(user.FieldBirthday, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Gender(); ok {
+		_spec.SetField(user.FieldGender, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(user.FieldLocale, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(user.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Country(); ok {
+		_spec.SetField(user.FieldCountry, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Bio(); ok {
+		_spec.SetField(user.FieldBio, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(user.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.MetadataCleared() {
+		_spec.ClearField(user.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AppleAccountDeleted(); ok {
+		_spec.SetField(user.FieldAppleAccountDeleted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MergedInto(); ok {
+		_spec.SetField(user.FieldMergedInto, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMergedInto(); ok {
+		_spec.AddField(user.FieldMergedInto, field.TypeInt64, value)
+	}
+	if _u.mutation.MergedIntoCleared() {
+		_spec.ClearField(user.FieldMergedInto, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(user.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StatusReason(); ok {
+		_spec.SetField(user.FieldStatusReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StatusChangedBy(); ok {
+		_spec.SetField(user.FieldStatusChangedBy, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedStatusChangedBy(); ok {
+		_spec.AddField(user.FieldStatusChangedBy, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.StatusChangedAt(); ok {
+		_spec.SetField(user.FieldStatusChangedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StatusChangedAtCleared() {
+		_spec.ClearField(user.FieldStatusChangedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.StatusExpiresAt(); ok {
+		_spec.SetField(user.FieldStatusExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.StatusExpiresAtCleared() {
+		_spec.ClearField(user.FieldStatusExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletionRequestedAt(); ok {
+		_spec.SetField(user.FieldDeletionRequestedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletionRequestedAtCleared() {
+		_spec.ClearField(user.FieldDeletionRequestedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletionScheduledAt(); ok {
+		_spec.SetField(user.FieldDeletionScheduledAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletionScheduledAtCleared() {
+		_spec.ClearField(user.FieldDeletionScheduledAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -314,6 +967,186 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.WechatAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WechatAccountsTable,
+			Columns: []string{user.WechatAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wechataccount.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWechatAccountsIDs(); len(nodes) > 0 && !_u.mutation.WechatAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WechatAccountsTable,
+			Columns: []string{user.WechatAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wechataccount.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WechatAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WechatAccountsTable,
+			Columns: []string{user.WechatAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wechataccount.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HandleHistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HandleHistoriesTable,
+			Columns: []string{user.HandleHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(handlehistory.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedHandleHistoriesIDs(); len(nodes) > 0 && !_u.mutation.HandleHistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HandleHistoriesTable,
+			Columns: []string{user.HandleHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(handlehistory.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HandleHistoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HandleHistoriesTable,
+			Columns: []string{user.HandleHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(handlehistory.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DataExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataExportsTable,
+			Columns: []string{user.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDataExportsIDs(); len(nodes) > 0 && !_u.mutation.DataExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataExportsTable,
+			Columns: []string{user.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DataExportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataExportsTable,
+			Columns: []string{user.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -369,107 +1202,609 @@ func (_u *UserUpdateOne) SetNillableName(v *string) *UserUpdateOne {
 	return _u
 }
 
-// SetEmail sets the "email" field.
-func (_u *UserUpdateOne) SetEmail(v string) *UserUpdateOne {
-	_u.mutation.SetEmail(v)
+// SetEmail sets the "email" field.
+func (_u *UserUpdateOne) SetEmail(v string) *UserUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEmail(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (_u *UserUpdateOne) ClearEmail() *UserUpdateOne {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (_u *UserUpdateOne) SetEmailVerified(v bool) *UserUpdateOne {
+	_u.mutation.SetEmailVerified(v)
+	return _u
+}
+
+// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEmailVerified(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetEmailVerified(*v)
+	}
+	return _u
+}
+
+// SetPhone sets the "phone" field.
+func (_u *UserUpdateOne) SetPhone(v string) *UserUpdateOne {
+	_u.mutation.SetPhone(v)
+	return _u
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillablePhone(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetPhone(*v)
+	}
+	return _u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (_u *UserUpdateOne) ClearPhone() *UserUpdateOne {
+	_u.mutation.ClearPhone()
+	return _u
+}
+
+// SetAvatar sets the "avatar" field.
+func (_u *UserUpdateOne) SetAvatar(v string) *UserUpdateOne {
+	_u.mutation.SetAvatar(v)
+	return _u
+}
+
+// SetNillableAvatar sets the "avatar" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableAvatar(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetAvatar(*v)
+	}
+	return _u
+}
+
+// SetHandle sets the "handle" field.
+func (_u *UserUpdateOne) SetHandle(v string) *UserUpdateOne {
+	_u.mutation.SetHandle(v)
+	return _u
+}
+
+// SetNillableHandle sets the "handle" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableHandle(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetHandle(*v)
+	}
+	return _u
+}
+
+// ClearHandle clears the value of the "handle" field.
+func (_u *UserUpdateOne) ClearHandle() *UserUpdateOne {
+	_u.mutation.ClearHandle()
+	return _u
+}
+
+// SetHandleLower sets the "handle_lower" field.
+func (_u *UserUpdateOne) SetHandleLower(v string) *UserUpdateOne {
+	_u.mutation.SetHandleLower(v)
+	return _u
+}
+
+// SetNillableHandleLower sets the "handle_lower" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableHandleLower(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetHandleLower(*v)
+	}
+	return _u
+}
+
+// ClearHandleLower clears the value of the "handle_lower" field.
+func (_u *UserUpdateOne) ClearHandleLower() *UserUpdateOne {
+	_u.mutation.ClearHandleLower()
+	return _u
+}
+
+// SetHandleChangedAt sets the "handle_changed_at" field.
+func (_u *UserUpdateOne) SetHandleChangedAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetHandleChangedAt(v)
+	return _u
+}
+
+// SetNillableHandleChangedAt sets the "handle_changed_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableHandleChangedAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetHandleChangedAt(*v)
+	}
+	return _u
+}
+
+// ClearHandleChangedAt clears the value of the "handle_changed_at" field.
+func (_u *UserUpdateOne) ClearHandleChangedAt() *UserUpdateOne {
+	_u.mutation.ClearHandleChangedAt()
+	return _u
+}
+
+// SetBirthday sets the "birthday" field.
+func (_u *UserUpdateOne) SetBirthday(v time.Time) *UserUpdateOne {
+	_u.mutation.SetBirthday(v)
+	return _u
+}
+
+// SetNillableBirthday sets the "birthday" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableBirthday(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetBirthday(*v)
+	}
+	return _u
+}
+
+// ClearBirthday clears the value of the "birthday" field.
+func (_u *UserUpdateOne) ClearBirthday() *UserUpdateOne {
+	_u.mutation.ClearBirthday()
+	return _u
+}
+
+// SetGender sets the "gender" field.
+func (_u *UserUpdateOne) SetGender(v string) *UserUpdateOne {
+	_u.mutation.SetGender(v)
+	return _u
+}
+
+// SetNillableGender sets the "gender" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableGender(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetGender(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *UserUpdateOne) SetLocale(v string) *UserUpdateOne {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableLocale(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *UserUpdateOne) SetTimezone(v string) *UserUpdateOne {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableTimezone(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetCountry sets the "country" field.
+func (_u *UserUpdateOne) SetCountry(v string) *UserUpdateOne {
+	_u.mutation.SetCountry(v)
+	return _u
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableCountry(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetCountry(*v)
+	}
+	return _u
+}
+
+// SetBio sets the "bio" field.
+func (_u *UserUpdateOne) SetBio(v string) *UserUpdateOne {
+	_u.mutation.SetBio(v)
+	return _u
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableBio(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetBio(*v)
+	}
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *UserUpdateOne) SetMetadata(v map[string]string) *UserUpdateOne {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (_u *UserUpdateOne) ClearMetadata() *UserUpdateOne {
+	_u.mutation.ClearMetadata()
+	return _u
+}
+
+// SetAppleAccountDeleted sets the "apple_account_deleted" field.
+func (_u *UserUpdateOne) SetAppleAccountDeleted(v bool) *UserUpdateOne {
+	_u.mutation.SetAppleAccountDeleted(v)
+	return _u
+}
+
+// SetNillableAppleAccountDeleted sets the "apple_account_deleted" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableAppleAccountDeleted(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetAppleAccountDeleted(*v)
+	}
+	return _u
+}
+
+// SetMergedInto sets the "merged_into" field.
+func (_u *UserUpdateOne) SetMergedInto(v int64) *UserUpdateOne {
+	_u.mutation.ResetMergedInto()
+	_u.mutation.SetMergedInto(v)
+	return _u
+}
+
+// SetNillableMergedInto sets the "merged_into" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableMergedInto(v *int64) *UserUpdateOne {
+	if v != nil {
+		_u.SetMergedInto(*v)
+	}
+	return _u
+}
+
+// AddMergedInto adds value to the "merged_into" field.
+func (_u *UserUpdateOne) AddMergedInto(v int64) *UserUpdateOne {
+	_u.mutation.AddMergedInto(v)
+	return _u
+}
+
+// ClearMergedInto clears the value of the "merged_into" field.
+func (_u *UserUpdateOne) ClearMergedInto() *UserUpdateOne {
+	_u.mutation.ClearMergedInto()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *UserUpdateOne) SetStatus(v string) *UserUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableStatus(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStatusReason sets the "status_reason" field.
+func (_u *UserUpdateOne) SetStatusReason(v string) *UserUpdateOne {
+	_u.mutation.SetStatusReason(v)
+	return _u
+}
+
+// SetNillableStatusReason sets the "status_reason" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableStatusReason(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetStatusReason(*v)
+	}
+	return _u
+}
+
+// SetStatusChangedBy sets the "status_changed_by" field.
+func (_u *UserUpdateOne) SetStatusChangedBy(v int64) *UserUpdateOne {
+	_u.mutation.ResetStatusChangedBy()
+	_u.mutation.SetStatusChangedBy(v)
+	return _u
+}
+
+// SetNillableStatusChangedBy sets the "status_changed_by" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableStatusChangedBy(v *int64) *UserUpdateOne {
+	if v != nil {
+		_u.SetStatusChangedBy(*v)
+	}
+	return _u
+}
+
+// AddStatusChangedBy adds value to the "status_changed_by" field.
+func (_u *UserUpdateOne) AddStatusChangedBy(v int64) *UserUpdateOne {
+	_u.mutation.AddStatusChangedBy(v)
+	return _u
+}
+
+// SetStatusChangedAt sets the "status_changed_at" field.
+func (_u *UserUpdateOne) SetStatusChangedAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetStatusChangedAt(v)
+	return _u
+}
+
+// SetNillableStatusChangedAt sets the "status_changed_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableStatusChangedAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetStatusChangedAt(*v)
+	}
+	return _u
+}
+
+// ClearStatusChangedAt clears the value of the "status_changed_at" field.
+func (_u *UserUpdateOne) ClearStatusChangedAt() *UserUpdateOne {
+	_u.mutation.ClearStatusChangedAt()
+	return _u
+}
+
+// SetStatusExpiresAt sets the "status_expires_at" field.
+func (_u *UserUpdateOne) SetStatusExpiresAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetStatusExpiresAt(v)
+	return _u
+}
+
+// SetNillableStatusExpiresAt sets the "status_expires_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableStatusExpiresAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetStatusExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearStatusExpiresAt clears the value of the "status_expires_at" field.
+func (_u *UserUpdateOne) ClearStatusExpiresAt() *UserUpdateOne {
+	_u.mutation.ClearStatusExpiresAt()
+	return _u
+}
+
+// SetDeletionRequestedAt sets the "deletion_requested_at" field.
+func (_u *UserUpdateOne) SetDeletionRequestedAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetDeletionRequestedAt(v)
+	return _u
+}
+
+// SetNillableDeletionRequestedAt sets the "deletion_requested_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableDeletionRequestedAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetDeletionRequestedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletionRequestedAt clears the value of the "deletion_requested_at" field.
+func (_u *UserUpdateOne) ClearDeletionRequestedAt() *UserUpdateOne {
+	_u.mutation.ClearDeletionRequestedAt()
+	return _u
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (_u *UserUpdateOne) SetDeletionScheduledAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetDeletionScheduledAt(v)
+	return _u
+}
+
+// SetNillableDeletionScheduledAt sets the "deletion_scheduled_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableDeletionScheduledAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetDeletionScheduledAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletionScheduledAt clears the value of the "deletion_scheduled_at" field.
+func (_u *UserUpdateOne) ClearDeletionScheduledAt() *UserUpdateOne {
+	_u.mutation.ClearDeletionScheduledAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *UserUpdateOne) SetCreatedAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableCreatedAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserUpdateOne) SetUpdatedAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddAuthProviderIDs adds the "auth_providers" edge to the AuthProvider entity by IDs.
+func (_u *UserUpdateOne) AddAuthProviderIDs(ids ...int64) *UserUpdateOne {
+	_u.mutation.AddAuthProviderIDs(ids...)
+	return _u
+}
+
+// AddAuthProviders adds the "auth_providers" edges to the AuthProvider entity.
+func (_u *UserUpdateOne) AddAuthProviders(v ...*AuthProvider) *UserUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAuthProviderIDs(ids...)
+}
+
+// AddWechatAccountIDs adds the "wechat_accounts" edge to the WechatAccount entity by IDs.
+func (_u *UserUpdateOne) AddWechatAccountIDs(ids ...int64) *UserUpdateOne {
+	_u.mutation.AddWechatAccountIDs(ids...)
+	return _u
+}
+
+// AddWechatAccounts adds the "wechat_accounts" edges to the WechatAccount entity.
+func (_u *UserUpdateOne) AddWechatAccounts(v ...*WechatAccount) *UserUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWechatAccountIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (_u *UserUpdateOne) AddSessionIDs(ids ...int64) *UserUpdateOne {
+	_u.mutation.AddSessionIDs(ids...)
+	return _u
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (_u *UserUpdateOne) AddSessions(v ...*Session) *UserUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSessionIDs(ids...)
+}
+
+// AddHandleHistoryIDs adds the "handle_histories" edge to the HandleHistory entity by IDs.
+func (_u *UserUpdateOne) AddHandleHistoryIDs(ids ...int64) *UserUpdateOne {
+	_u.mutation.AddHandleHistoryIDs(ids...)
+	return _u
+}
+
+// AddHandleHistories adds the "handle_histories" edges to the HandleHistory entity.
+func (_u *UserUpdateOne) AddHandleHistories(v ...*HandleHistory) *UserUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddHandleHistoryIDs(ids...)
+}
+
+// AddDataExportIDs adds the "data_exports" edge to the DataExport entity by IDs.
+func (_u *UserUpdateOne) AddDataExportIDs(ids ...int64) *UserUpdateOne {
+	_u.mutation.AddDataExportIDs(ids...)
+	return _u
+}
+
+// AddDataExports adds the "data_exports" edges to the DataExport entity.
+func (_u *UserUpdateOne) AddDataExports(v ...*DataExport) *UserUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDataExportIDs(ids...)
+}
+
+// Mutation returns the UserMutation object of the builder.
+func (_u *UserUpdateOne) Mutation() *UserMutation {
+	return _u.mutation
+}
+
+// ClearAuthProviders clears all "auth_providers" edges to the AuthProvider entity.
+func (_u *UserUpdateOne) ClearAuthProviders() *UserUpdateOne {
+	_u.mutation.ClearAuthProviders()
+	return _u
+}
+
+// RemoveAuthProviderIDs removes the "auth_providers" edge to AuthProvider entities by IDs.
+func (_u *UserUpdateOne) RemoveAuthProviderIDs(ids ...int64) *UserUpdateOne {
+	_u.mutation.RemoveAuthProviderIDs(ids...)
 	return _u
 }
 
-// SetNillableEmail sets the "email" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableEmail(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetEmail(*v)
+// RemoveAuthProviders removes "auth_providers" edges to AuthProvider entities.
+func (_u *UserUpdateOne) RemoveAuthProviders(v ...*AuthProvider) *UserUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return _u
+	return _u.RemoveAuthProviderIDs(ids...)
 }
 
-// SetPhone sets the "phone" field.
-func (_u *UserUpdateOne) SetPhone(v string) *UserUpdateOne {
-	_u.mutation.SetPhone(v)
+// ClearWechatAccounts clears all "wechat_accounts" edges to the WechatAccount entity.
+func (_u *UserUpdateOne) ClearWechatAccounts() *UserUpdateOne {
+	_u.mutation.ClearWechatAccounts()
 	return _u
 }
 
-// SetNillablePhone sets the "phone" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillablePhone(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetPhone(*v)
-	}
+// RemoveWechatAccountIDs removes the "wechat_accounts" edge to WechatAccount entities by IDs.
+func (_u *UserUpdateOne) RemoveWechatAccountIDs(ids ...int64) *UserUpdateOne {
+	_u.mutation.RemoveWechatAccountIDs(ids...)
 	return _u
 }
 
-// SetAvatar sets the "avatar" field.
-func (_u *UserUpdateOne) SetAvatar(v string) *UserUpdateOne {
-	_u.mutation.SetAvatar(v)
-	return _u
+// RemoveWechatAccounts removes "wechat_accounts" edges to WechatAccount entities.
+func (_u *UserUpdateOne) RemoveWechatAccounts(v ...*WechatAccount) *UserUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWechatAccountIDs(ids...)
 }
 
-// SetNillableAvatar sets the "avatar" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableAvatar(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetAvatar(*v)
-	}
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (_u *UserUpdateOne) ClearSessions() *UserUpdateOne {
+	_u.mutation.ClearSessions()
 	return _u
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (_u *UserUpdateOne) SetCreatedAt(v time.Time) *UserUpdateOne {
-	_u.mutation.SetCreatedAt(v)
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (_u *UserUpdateOne) RemoveSessionIDs(ids ...int64) *UserUpdateOne {
+	_u.mutation.RemoveSessionIDs(ids...)
 	return _u
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableCreatedAt(v *time.Time) *UserUpdateOne {
-	if v != nil {
-		_u.SetCreatedAt(*v)
+// RemoveSessions removes "sessions" edges to Session entities.
+func (_u *UserUpdateOne) RemoveSessions(v ...*Session) *UserUpdateOne {
+	ids := make([]int64, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return _u
+	return _u.RemoveSessionIDs(ids...)
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *UserUpdateOne) SetUpdatedAt(v time.Time) *UserUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
+// ClearHandleHistories clears all "handle_histories" edges to the HandleHistory entity.
+func (_u *UserUpdateOne) ClearHandleHistories() *UserUpdateOne {
+	_u.mutation.ClearHandleHistories()
 	return _u
 }
 
-// AddAuthProviderIDs adds the "auth_providers" edge to the AuthProvider entity by IDs.
-func (_u *UserUpdateOne) AddAuthProviderIDs(ids ...int64) *UserUpdateOne {
-	_u.mutation.AddAuthProviderIDs(ids...)
+// RemoveHandleHistoryIDs removes the "handle_histories" edge to HandleHistory entities by IDs.
+func (_u *UserUpdateOne) RemoveHandleHistoryIDs(ids ...int64) *UserUpdateOne {
+	_u.mutation.RemoveHandleHistoryIDs(ids...)
 	return _u
 }
 
-// AddAuthProviders adds the "auth_providers" edges to the AuthProvider entity.
-func (_u *UserUpdateOne) AddAuthProviders(v ...*AuthProvider) *UserUpdateOne {
+// RemoveHandleHistories removes "handle_histories" edges to HandleHistory entities.
+func (_u *UserUpdateOne) RemoveHandleHistories(v ...*HandleHistory) *UserUpdateOne {
 	ids := make([]int64, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddAuthProviderIDs(ids...)
-}
-
-// Mutation returns the UserMutation object of the builder.
-func (_u *UserUpdateOne) Mutation() *UserMutation {
-	return _u.mutation
+	return _u.RemoveHandleHistoryIDs(ids...)
 }
 
-// ClearAuthProviders clears all "auth_providers" edges to the AuthProvider entity.
-func (_u *UserUpdateOne) ClearAuthProviders() *UserUpdateOne {
-	_u.mutation.ClearAuthProviders()
+// ClearDataExports clears all "data_exports" edges to the DataExport entity.
+func (_u *UserUpdateOne) ClearDataExports() *UserUpdateOne {
+	_u.mutation.ClearDataExports()
 	return _u
 }
 
-// RemoveAuthProviderIDs removes the "auth_providers" edge to AuthProvider entities by IDs.
-func (_u *UserUpdateOne) RemoveAuthProviderIDs(ids ...int64) *UserUpdateOne {
-	_u.mutation.RemoveAuthProviderIDs(ids...)
+// RemoveDataExportIDs removes the "data_exports" edge to DataExport entities by IDs.
+func (_u *UserUpdateOne) RemoveDataExportIDs(ids ...int64) *UserUpdateOne {
+	_u.mutation.RemoveDataExportIDs(ids...)
 	return _u
 }
 
-// RemoveAuthProviders removes "auth_providers" edges to AuthProvider entities.
-func (_u *UserUpdateOne) RemoveAuthProviders(v ...*AuthProvider) *UserUpdateOne {
+// RemoveDataExports removes "data_exports" edges to DataExport entities.
+func (_u *UserUpdateOne) RemoveDataExports(v ...*DataExport) *UserUpdateOne {
 	ids := make([]int64, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveAuthProviderIDs(ids...)
+	return _u.RemoveDataExportIDs(ids...)
 }
 
 // Where appends a list predicates to the UserUpdate builder.
@@ -548,6 +1883,51 @@ func (_u *UserUpdateOne) check() error {
 			return &ValidationError{Name: "avatar", err: fmt.Errorf(`ent: validator failed for field "User.avatar": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Handle(); ok {
+		if err := user.HandleValidator(v); err != nil {
+			return &ValidationError{Name: "handle", err: fmt.Errorf(`ent: validator failed for field "User.handle": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HandleLower(); ok {
+		if err := user.HandleLowerValidator(v); err != nil {
+			return &ValidationError{Name: "handle_lower", err: fmt.Errorf(`ent: validator failed for field "User.handle_lower": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Gender(); ok {
+		if err := user.GenderValidator(v); err != nil {
+			return &ValidationError{Name: "gender", err: fmt.Errorf(`ent: validator failed for field "User.gender": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Locale(); ok {
+		if err := user.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`ent: validator failed for field "User.locale": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Timezone(); ok {
+		if err := user.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "User.timezone": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Country(); ok {
+		if err := user.CountryValidator(v); err != nil {
+			return &ValidationError{Name: "country", err: fmt.Errorf(`ent: validator failed for field "User.country": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Bio(); ok {
+		if err := user.BioValidator(v); err != nil {
+			return &ValidationError{Name: "bio", err: fmt.Errorf(`ent: validator failed for field "User.bio": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := user.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "User.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StatusReason(); ok {
+		if err := user.StatusReasonValidator(v); err != nil {
+			return &ValidationError{Name: "status_reason", err: fmt.Errorf(`ent: validator failed for field "User.status_reason": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -592,12 +1972,114 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if value, ok := _u.mutation.Email(); ok {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(user.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.EmailVerified(); ok {
+		_spec.SetField(user.FieldEmailVerified, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.Phone(); ok {
 		_spec.SetField(user.FieldPhone, field.TypeString, value)
 	}
+	if _u.mutation.PhoneCleared() {
+		_spec.ClearField(user.FieldPhone, field.TypeString)
+	}
 	if value, ok := _u.mutation.Avatar(); ok {
 		_spec.SetField(user.FieldAvatar, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Handle(); ok {
+		_spec.SetField(user.FieldHandle, field.TypeString, value)
+	}
+	if _u.mutation.HandleCleared() {
+		_spec.ClearField(user.FieldHandle, field.TypeString)
+	}
+	if value, ok := _u.mutation.HandleLower(); ok {
+		_spec.SetField(user.FieldHandleLower, field.TypeString, value)
+	}
+	if _u.mutation.HandleLowerCleared() {
+		_spec.ClearField(user.FieldHandleLower, field.TypeString)
+	}
+	if value, ok := _u.mutation.HandleChangedAt(); ok {
+		_spec.SetField(user.FieldHandleChangedAt, field.TypeTime, value)
+	}
+	if _u.mutation.HandleChangedAtCleared() {
+		_spec.ClearField(user.FieldHandleChangedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Birthday(); ok {
+		_spec.SetField(user.FieldBirthday, field.TypeTime, value)
+	}
+	if _u.mutation.BirthdayCleared() {
+		_spec.ClearField(user.FieldBirthday, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Gender(); ok {
+		_spec.SetField(user.FieldGender, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(user.FieldLocale, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(user.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Country(); ok {
+		_spec.SetField(user.FieldCountry, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Bio(); ok {
+		_spec.SetField(user.FieldBio, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(user.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.MetadataCleared() {
+		_spec.ClearField(user.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AppleAccountDeleted(); ok {
+		_spec.SetField(user.FieldAppleAccountDeleted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MergedInto(); ok {
+		_spec.SetField(user.FieldMergedInto, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMergedInto(); ok {
+		_spec.AddField(user.FieldMergedInto, field.TypeInt64, value)
+	}
+	if _u.mutation.MergedIntoCleared() {
+		_spec.ClearField(user.FieldMergedInto, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(user.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StatusReason(); ok {
+		_spec.SetField(user.FieldStatusReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StatusChangedBy(); ok {
+		_spec.SetField(user.FieldStatusChangedBy, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedStatusChangedBy(); ok {
+		_spec.AddField(user.FieldStatusChangedBy, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.StatusChangedAt(); ok {
+		_spec.SetField(user.FieldStatusChangedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StatusChangedAtCleared() {
+		_spec.ClearField(user.FieldStatusChangedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.StatusExpiresAt(); ok {
+		_spec.SetField(user.FieldStatusExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.StatusExpiresAtCleared() {
+		_spec.ClearField(user.FieldStatusExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletionRequestedAt(); ok {
+		_spec.SetField(user.FieldDeletionRequestedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletionRequestedAtCleared() {
+		_spec.ClearField(user.FieldDeletionRequestedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletionScheduledAt(); ok {
+		_spec.SetField(user.FieldDeletionScheduledAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletionScheduledAtCleared() {
+		_spec.ClearField(user.FieldDeletionScheduledAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -649,6 +2131,186 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.WechatAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WechatAccountsTable,
+			Columns: []string{user.WechatAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wechataccount.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWechatAccountsIDs(); len(nodes) > 0 && !_u.mutation.WechatAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WechatAccountsTable,
+			Columns: []string{user.WechatAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wechataccount.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WechatAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WechatAccountsTable,
+			Columns: []string{user.WechatAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wechataccount.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HandleHistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HandleHistoriesTable,
+			Columns: []string{user.HandleHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(handlehistory.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedHandleHistoriesIDs(); len(nodes) > 0 && !_u.mutation.HandleHistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HandleHistoriesTable,
+			Columns: []string{user.HandleHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(handlehistory.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HandleHistoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.HandleHistoriesTable,
+			Columns: []string{user.HandleHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(handlehistory.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DataExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataExportsTable,
+			Columns: []string{user.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDataExportsIDs(); len(nodes) > 0 && !_u.mutation.DataExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataExportsTable,
+			Columns: []string{user.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DataExportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DataExportsTable,
+			Columns: []string{user.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"user-service/internal/data/ent/user"
+	"user-service/internal/data/ent/wechataccount"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// WechatAccount is the model entity for the WechatAccount schema.
+type WechatAccount struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// UID holds the value of the "uid" field.
+	UID int64 `json:"uid,omitempty"`
+	// AppID holds the value of the "app_id" field.
+	AppID string `json:"app_id,omitempty"`
+	// OpenID holds the value of the "open_id" field.
+	OpenID string `json:"open_id,omitempty"`
+	// UnionID holds the value of the "union_id" field.
+	UnionID string `json:"union_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WechatAccountQuery when eager-loading is set.
+	Edges        WechatAccountEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WechatAccountEdges holds the relations/edges for other nodes in the graph.
+type WechatAccountEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WechatAccountEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WechatAccount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case wechataccount.FieldID, wechataccount.FieldUID:
+			values[i] = new(sql.NullInt64)
+		case wechataccount.FieldAppID, wechataccount.FieldOpenID, wechataccount.FieldUnionID:
+			values[i] = new(sql.NullString)
+		case wechataccount.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WechatAccount fields.
+func (_m *WechatAccount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case wechataccount.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case wechataccount.FieldUID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				_m.UID = value.Int64
+			}
+		case wechataccount.FieldAppID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field app_id", values[i])
+			} else if value.Valid {
+				_m.AppID = value.String
+			}
+		case wechataccount.FieldOpenID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field open_id", values[i])
+			} else if value.Valid {
+				_m.OpenID = value.String
+			}
+		case wechataccount.FieldUnionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field union_id", values[i])
+			} else if value.Valid {
+				_m.UnionID = value.String
+			}
+		case wechataccount.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WechatAccount.
+// This includes values selected through modifiers, order, etc.
+func (_m *WechatAccount) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the WechatAccount entity.
+func (_m *WechatAccount) QueryUser() *UserQuery {
+	return NewWechatAccountClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this WechatAccount.
+// Note that you need to call WechatAccount.Unwrap() before calling this method if this WechatAccount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WechatAccount) Update() *WechatAccountUpdateOne {
+	return NewWechatAccountClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WechatAccount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WechatAccount) Unwrap() *WechatAccount {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WechatAccount is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WechatAccount) String() string {
+	var builder strings.Builder
+	builder.WriteString("WechatAccount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("uid=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UID))
+	builder.WriteString(", ")
+	builder.WriteString("app_id=")
+	builder.WriteString(_m.AppID)
+	builder.WriteString(", ")
+	builder.WriteString("open_id=")
+	builder.WriteString(_m.OpenID)
+	builder.WriteString(", ")
+	builder.WriteString("union_id=")
+	builder.WriteString(_m.UnionID)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WechatAccounts is a parsable slice of WechatAccount.
+type WechatAccounts []*WechatAccount
@@ -0,0 +1,374 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wechataccount
+
+import (
+	"time"
+	"user-service/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldLTE(FieldID, id))
+}
+
+// UID applies equality check predicate on the "uid" field. It's identical to UIDEQ.
+func UID(v int64) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldEQ(FieldUID, v))
+}
+
+// AppID applies equality check predicate on the "app_id" field. It's identical to AppIDEQ.
+func AppID(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldEQ(FieldAppID, v))
+}
+
+// OpenID applies equality check predicate on the "open_id" field. It's identical to OpenIDEQ.
+func OpenID(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldEQ(FieldOpenID, v))
+}
+
+// UnionID applies equality check predicate on the "union_id" field. It's identical to UnionIDEQ.
+func UnionID(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldEQ(FieldUnionID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UIDEQ applies the EQ predicate on the "uid" field.
+func UIDEQ(v int64) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldEQ(FieldUID, v))
+}
+
+// UIDNEQ applies the NEQ predicate on the "uid" field.
+func UIDNEQ(v int64) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldNEQ(FieldUID, v))
+}
+
+// UIDIn applies the In predicate on the "uid" field.
+func UIDIn(vs ...int64) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldIn(FieldUID, vs...))
+}
+
+// UIDNotIn applies the NotIn predicate on the "uid" field.
+func UIDNotIn(vs ...int64) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldNotIn(FieldUID, vs...))
+}
+
+// AppIDEQ applies the EQ predicate on the "app_id" field.
+func AppIDEQ(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldEQ(FieldAppID, v))
+}
+
+// AppIDNEQ applies the NEQ predicate on the "app_id" field.
+func AppIDNEQ(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldNEQ(FieldAppID, v))
+}
+
+// AppIDIn applies the In predicate on the "app_id" field.
+func AppIDIn(vs ...string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldIn(FieldAppID, vs...))
+}
+
+// AppIDNotIn applies the NotIn predicate on the "app_id" field.
+func AppIDNotIn(vs ...string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldNotIn(FieldAppID, vs...))
+}
+
+// AppIDGT applies the GT predicate on the "app_id" field.
+func AppIDGT(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldGT(FieldAppID, v))
+}
+
+// AppIDGTE applies the GTE predicate on the "app_id" field.
+func AppIDGTE(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldGTE(FieldAppID, v))
+}
+
+// AppIDLT applies the LT predicate on the "app_id" field.
+func AppIDLT(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldLT(FieldAppID, v))
+}
+
+// AppIDLTE applies the LTE predicate on the "app_id" field.
+func AppIDLTE(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldLTE(FieldAppID, v))
+}
+
+// AppIDContains applies the Contains predicate on the "app_id" field.
+func AppIDContains(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldContains(FieldAppID, v))
+}
+
+// AppIDHasPrefix applies the HasPrefix predicate on the "app_id" field.
+func AppIDHasPrefix(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldHasPrefix(FieldAppID, v))
+}
+
+// AppIDHasSuffix applies the HasSuffix predicate on the "app_id" field.
+func AppIDHasSuffix(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldHasSuffix(FieldAppID, v))
+}
+
+// AppIDEqualFold applies the EqualFold predicate on the "app_id" field.
+func AppIDEqualFold(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldEqualFold(FieldAppID, v))
+}
+
+// AppIDContainsFold applies the ContainsFold predicate on the "app_id" field.
+func AppIDContainsFold(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldContainsFold(FieldAppID, v))
+}
+
+// OpenIDEQ applies the EQ predicate on the "open_id" field.
+func OpenIDEQ(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldEQ(FieldOpenID, v))
+}
+
+// OpenIDNEQ applies the NEQ predicate on the "open_id" field.
+func OpenIDNEQ(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldNEQ(FieldOpenID, v))
+}
+
+// OpenIDIn applies the In predicate on the "open_id" field.
+func OpenIDIn(vs ...string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldIn(FieldOpenID, vs...))
+}
+
+// OpenIDNotIn applies the NotIn predicate on the "open_id" field.
+func OpenIDNotIn(vs ...string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldNotIn(FieldOpenID, vs...))
+}
+
+// OpenIDGT applies the GT predicate on the "open_id" field.
+func OpenIDGT(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldGT(FieldOpenID, v))
+}
+
+// OpenIDGTE applies the GTE predicate on the "open_id" field.
+func OpenIDGTE(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldGTE(FieldOpenID, v))
+}
+
+// OpenIDLT applies the LT predicate on the "open_id" field.
+func OpenIDLT(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldLT(FieldOpenID, v))
+}
+
+// OpenIDLTE applies the LTE predicate on the "open_id" field.
+func OpenIDLTE(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldLTE(FieldOpenID, v))
+}
+
+// OpenIDContains applies the Contains predicate on the "open_id" field.
+func OpenIDContains(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldContains(FieldOpenID, v))
+}
+
+// OpenIDHasPrefix applies the HasPrefix predicate on the "open_id" field.
+func OpenIDHasPrefix(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldHasPrefix(FieldOpenID, v))
+}
+
+// OpenIDHasSuffix applies the HasSuffix predicate on the "open_id" field.
+func OpenIDHasSuffix(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldHasSuffix(FieldOpenID, v))
+}
+
+// OpenIDEqualFold applies the EqualFold predicate on the "open_id" field.
+func OpenIDEqualFold(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldEqualFold(FieldOpenID, v))
+}
+
+// OpenIDContainsFold applies the ContainsFold predicate on the "open_id" field.
+func OpenIDContainsFold(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldContainsFold(FieldOpenID, v))
+}
+
+// UnionIDEQ applies the EQ predicate on the "union_id" field.
+func UnionIDEQ(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldEQ(FieldUnionID, v))
+}
+
+// UnionIDNEQ applies the NEQ predicate on the "union_id" field.
+func UnionIDNEQ(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldNEQ(FieldUnionID, v))
+}
+
+// UnionIDIn applies the In predicate on the "union_id" field.
+func UnionIDIn(vs ...string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldIn(FieldUnionID, vs...))
+}
+
+// UnionIDNotIn applies the NotIn predicate on the "union_id" field.
+func UnionIDNotIn(vs ...string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldNotIn(FieldUnionID, vs...))
+}
+
+// UnionIDGT applies the GT predicate on the "union_id" field.
+func UnionIDGT(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldGT(FieldUnionID, v))
+}
+
+// UnionIDGTE applies the GTE predicate on the "union_id" field.
+func UnionIDGTE(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldGTE(FieldUnionID, v))
+}
+
+// UnionIDLT applies the LT predicate on the "union_id" field.
+func UnionIDLT(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldLT(FieldUnionID, v))
+}
+
+// UnionIDLTE applies the LTE predicate on the "union_id" field.
+func UnionIDLTE(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldLTE(FieldUnionID, v))
+}
+
+// UnionIDContains applies the Contains predicate on the "union_id" field.
+func UnionIDContains(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldContains(FieldUnionID, v))
+}
+
+// UnionIDHasPrefix applies the HasPrefix predicate on the "union_id" field.
+func UnionIDHasPrefix(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldHasPrefix(FieldUnionID, v))
+}
+
+// UnionIDHasSuffix applies the HasSuffix predicate on the "union_id" field.
+func UnionIDHasSuffix(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldHasSuffix(FieldUnionID, v))
+}
+
+// UnionIDEqualFold applies the EqualFold predicate on the "union_id" field.
+func UnionIDEqualFold(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldEqualFold(FieldUnionID, v))
+}
+
+// UnionIDContainsFold applies the ContainsFold predicate on the "union_id" field.
+func UnionIDContainsFold(v string) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldContainsFold(FieldUnionID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.WechatAccount {
+	return predicate.WechatAccount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.WechatAccount {
+	return predicate.WechatAccount(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WechatAccount) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WechatAccount) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WechatAccount) predicate.WechatAccount {
+	return predicate.WechatAccount(sql.NotPredicates(p))
+}
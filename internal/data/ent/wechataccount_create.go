@@ -0,0 +1,326 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"user-service/internal/data/ent/user"
+	"user-service/internal/data/ent/wechataccount"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WechatAccountCreate is the builder for creating a WechatAccount entity.
+type WechatAccountCreate struct {
+	config
+	mutation *WechatAccountMutation
+	hooks    []Hook
+}
+
+// SetUID sets the "uid" field.
+func (_c *WechatAccountCreate) SetUID(v int64) *WechatAccountCreate {
+	_c.mutation.SetUID(v)
+	return _c
+}
+
+// SetAppID sets the "app_id" field.
+func (_c *WechatAccountCreate) SetAppID(v string) *WechatAccountCreate {
+	_c.mutation.SetAppID(v)
+	return _c
+}
+
+// SetOpenID sets the "open_id" field.
+func (_c *WechatAccountCreate) SetOpenID(v string) *WechatAccountCreate {
+	_c.mutation.SetOpenID(v)
+	return _c
+}
+
+// SetUnionID sets the "union_id" field.
+func (_c *WechatAccountCreate) SetUnionID(v string) *WechatAccountCreate {
+	_c.mutation.SetUnionID(v)
+	return _c
+}
+
+// SetNillableUnionID sets the "union_id" field if the given value is not nil.
+func (_c *WechatAccountCreate) SetNillableUnionID(v *string) *WechatAccountCreate {
+	if v != nil {
+		_c.SetUnionID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WechatAccountCreate) SetCreatedAt(v time.Time) *WechatAccountCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WechatAccountCreate) SetNillableCreatedAt(v *time.Time) *WechatAccountCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WechatAccountCreate) SetID(v int64) *WechatAccountCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *WechatAccountCreate) SetUserID(id int64) *WechatAccountCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *WechatAccountCreate) SetUser(v *User) *WechatAccountCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the WechatAccountMutation object of the builder.
+func (_c *WechatAccountCreate) Mutation() *WechatAccountMutation {
+	return _c.mutation
+}
+
+// Save creates the WechatAccount in the database.
+func (_c *WechatAccountCreate) Save(ctx context.Context) (*WechatAccount, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WechatAccountCreate) SaveX(ctx context.Context) *WechatAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WechatAccountCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WechatAccountCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WechatAccountCreate) defaults() {
+	if _, ok := _c.mutation.UnionID(); !ok {
+		v := wechataccount.DefaultUnionID
+		_c.mutation.SetUnionID(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := wechataccount.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WechatAccountCreate) check() error {
+	if _, ok := _c.mutation.UID(); !ok {
+		return &ValidationError{Name: "uid", err: errors.New(`ent: missing required field "WechatAccount.uid"`)}
+	}
+	if v, ok := _c.mutation.UID(); ok {
+		if err := wechataccount.UIDValidator(v); err != nil {
+			return &ValidationError{Name: "uid", err: fmt.Errorf(`ent: validator failed for field "WechatAccount.uid": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AppID(); !ok {
+		return &ValidationError{Name: "app_id", err: errors.New(`ent: missing required field "WechatAccount.app_id"`)}
+	}
+	if v, ok := _c.mutation.AppID(); ok {
+		if err := wechataccount.AppIDValidator(v); err != nil {
+			return &ValidationError{Name: "app_id", err: fmt.Errorf(`ent: validator failed for field "WechatAccount.app_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OpenID(); !ok {
+		return &ValidationError{Name: "open_id", err: errors.New(`ent: missing required field "WechatAccount.open_id"`)}
+	}
+	if v, ok := _c.mutation.OpenID(); ok {
+		if err := wechataccount.OpenIDValidator(v); err != nil {
+			return &ValidationError{Name: "open_id", err: fmt.Errorf(`ent: validator failed for field "WechatAccount.open_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UnionID(); !ok {
+		return &ValidationError{Name: "union_id", err: errors.New(`ent: missing required field "WechatAccount.union_id"`)}
+	}
+	if v, ok := _c.mutation.UnionID(); ok {
+		if err := wechataccount.UnionIDValidator(v); err != nil {
+			return &ValidationError{Name: "union_id", err: fmt.Errorf(`ent: validator failed for field "WechatAccount.union_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WechatAccount.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "WechatAccount.user"`)}
+	}
+	return nil
+}
+
+func (_c *WechatAccountCreate) sqlSave(ctx context.Context) (*WechatAccount, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WechatAccountCreate) createSpec() (*WechatAccount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WechatAccount{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(wechataccount.Table, sqlgraph.NewFieldSpec(wechataccount.FieldID, field.TypeInt64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.AppID(); ok {
+		_spec.SetField(wechataccount.FieldAppID, field.TypeString, value)
+		_node.AppID = value
+	}
+	if value, ok := _c.mutation.OpenID(); ok {
+		_spec.SetField(wechataccount.FieldOpenID, field.TypeString, value)
+		_node.OpenID = value
+	}
+	if value, ok := _c.mutation.UnionID(); ok {
+		_spec.SetField(wechataccount.FieldUnionID, field.TypeString, value)
+		_node.UnionID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(wechataccount.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wechataccount.UserTable,
+			Columns: []string{wechataccount.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WechatAccountCreateBulk is the builder for creating many WechatAccount entities in bulk.
+type WechatAccountCreateBulk struct {
+	config
+	err      error
+	builders []*WechatAccountCreate
+}
+
+// Save creates the WechatAccount entities in the database.
+func (_c *WechatAccountCreateBulk) Save(ctx context.Context) ([]*WechatAccount, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WechatAccount, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WechatAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WechatAccountCreateBulk) SaveX(ctx context.Context) []*WechatAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WechatAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WechatAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
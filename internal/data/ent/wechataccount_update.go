@@ -0,0 +1,495 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"user-service/internal/data/ent/predicate"
+	"user-service/internal/data/ent/user"
+	"user-service/internal/data/ent/wechataccount"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WechatAccountUpdate is the builder for updating WechatAccount entities.
+type WechatAccountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WechatAccountMutation
+}
+
+// Where appends a list predicates to the WechatAccountUpdate builder.
+func (_u *WechatAccountUpdate) Where(ps ...predicate.WechatAccount) *WechatAccountUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUID sets the "uid" field.
+func (_u *WechatAccountUpdate) SetUID(v int64) *WechatAccountUpdate {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *WechatAccountUpdate) SetNillableUID(v *int64) *WechatAccountUpdate {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// SetAppID sets the "app_id" field.
+func (_u *WechatAccountUpdate) SetAppID(v string) *WechatAccountUpdate {
+	_u.mutation.SetAppID(v)
+	return _u
+}
+
+// SetNillableAppID sets the "app_id" field if the given value is not nil.
+func (_u *WechatAccountUpdate) SetNillableAppID(v *string) *WechatAccountUpdate {
+	if v != nil {
+		_u.SetAppID(*v)
+	}
+	return _u
+}
+
+// SetOpenID sets the "open_id" field.
+func (_u *WechatAccountUpdate) SetOpenID(v string) *WechatAccountUpdate {
+	_u.mutation.SetOpenID(v)
+	return _u
+}
+
+// SetNillableOpenID sets the "open_id" field if the given value is not nil.
+func (_u *WechatAccountUpdate) SetNillableOpenID(v *string) *WechatAccountUpdate {
+	if v != nil {
+		_u.SetOpenID(*v)
+	}
+	return _u
+}
+
+// SetUnionID sets the "union_id" field.
+func (_u *WechatAccountUpdate) SetUnionID(v string) *WechatAccountUpdate {
+	_u.mutation.SetUnionID(v)
+	return _u
+}
+
+// SetNillableUnionID sets the "union_id" field if the given value is not nil.
+func (_u *WechatAccountUpdate) SetNillableUnionID(v *string) *WechatAccountUpdate {
+	if v != nil {
+		_u.SetUnionID(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *WechatAccountUpdate) SetCreatedAt(v time.Time) *WechatAccountUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *WechatAccountUpdate) SetNillableCreatedAt(v *time.Time) *WechatAccountUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *WechatAccountUpdate) SetUserID(id int64) *WechatAccountUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *WechatAccountUpdate) SetUser(v *User) *WechatAccountUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the WechatAccountMutation object of the builder.
+func (_u *WechatAccountUpdate) Mutation() *WechatAccountMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *WechatAccountUpdate) ClearUser() *WechatAccountUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WechatAccountUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WechatAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WechatAccountUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WechatAccountUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WechatAccountUpdate) check() error {
+	if v, ok := _u.mutation.UID(); ok {
+		if err := wechataccount.UIDValidator(v); err != nil {
+			return &ValidationError{Name: "uid", err: fmt.Errorf(`ent: validator failed for field "WechatAccount.uid": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AppID(); ok {
+		if err := wechataccount.AppIDValidator(v); err != nil {
+			return &ValidationError{Name: "app_id", err: fmt.Errorf(`ent: validator failed for field "WechatAccount.app_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OpenID(); ok {
+		if err := wechataccount.OpenIDValidator(v); err != nil {
+			return &ValidationError{Name: "open_id", err: fmt.Errorf(`ent: validator failed for field "WechatAccount.open_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UnionID(); ok {
+		if err := wechataccount.UnionIDValidator(v); err != nil {
+			return &ValidationError{Name: "union_id", err: fmt.Errorf(`ent: validator failed for field "WechatAccount.union_id": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WechatAccount.user"`)
+	}
+	return nil
+}
+
+func (_u *WechatAccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wechataccount.Table, wechataccount.Columns, sqlgraph.NewFieldSpec(wechataccount.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.AppID(); ok {
+		_spec.SetField(wechataccount.FieldAppID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OpenID(); ok {
+		_spec.SetField(wechataccount.FieldOpenID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UnionID(); ok {
+		_spec.SetField(wechataccount.FieldUnionID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(wechataccount.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wechataccount.UserTable,
+			Columns: []string{wechataccount.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wechataccount.UserTable,
+			Columns: []string{wechataccount.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wechataccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WechatAccountUpdateOne is the builder for updating a single WechatAccount entity.
+type WechatAccountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WechatAccountMutation
+}
+
+// SetUID sets the "uid" field.
+func (_u *WechatAccountUpdateOne) SetUID(v int64) *WechatAccountUpdateOne {
+	_u.mutation.SetUID(v)
+	return _u
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (_u *WechatAccountUpdateOne) SetNillableUID(v *int64) *WechatAccountUpdateOne {
+	if v != nil {
+		_u.SetUID(*v)
+	}
+	return _u
+}
+
+// SetAppID sets the "app_id" field.
+func (_u *WechatAccountUpdateOne) SetAppID(v string) *WechatAccountUpdateOne {
+	_u.mutation.SetAppID(v)
+	return _u
+}
+
+// SetNillableAppID sets the "app_id" field if the given value is not nil.
+func (_u *WechatAccountUpdateOne) SetNillableAppID(v *string) *WechatAccountUpdateOne {
+	if v != nil {
+		_u.SetAppID(*v)
+	}
+	return _u
+}
+
+// SetOpenID sets the "open_id" field.
+func (_u *WechatAccountUpdateOne) SetOpenID(v string) *WechatAccountUpdateOne {
+	_u.mutation.SetOpenID(v)
+	return _u
+}
+
+// SetNillableOpenID sets the "open_id" field if the given value is not nil.
+func (_u *WechatAccountUpdateOne) SetNillableOpenID(v *string) *WechatAccountUpdateOne {
+	if v != nil {
+		_u.SetOpenID(*v)
+	}
+	return _u
+}
+
+// SetUnionID sets the "union_id" field.
+func (_u *WechatAccountUpdateOne) SetUnionID(v string) *WechatAccountUpdateOne {
+	_u.mutation.SetUnionID(v)
+	return _u
+}
+
+// SetNillableUnionID sets the "union_id" field if the given value is not nil.
+func (_u *WechatAccountUpdateOne) SetNillableUnionID(v *string) *WechatAccountUpdateOne {
+	if v != nil {
+		_u.SetUnionID(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *WechatAccountUpdateOne) SetCreatedAt(v time.Time) *WechatAccountUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *WechatAccountUpdateOne) SetNillableCreatedAt(v *time.Time) *WechatAccountUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *WechatAccountUpdateOne) SetUserID(id int64) *WechatAccountUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *WechatAccountUpdateOne) SetUser(v *User) *WechatAccountUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the WechatAccountMutation object of the builder.
+func (_u *WechatAccountUpdateOne) Mutation() *WechatAccountMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *WechatAccountUpdateOne) ClearUser() *WechatAccountUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the WechatAccountUpdate builder.
+func (_u *WechatAccountUpdateOne) Where(ps ...predicate.WechatAccount) *WechatAccountUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WechatAccountUpdateOne) Select(field string, fields ...string) *WechatAccountUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WechatAccount entity.
+func (_u *WechatAccountUpdateOne) Save(ctx context.Context) (*WechatAccount, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WechatAccountUpdateOne) SaveX(ctx context.Context) *WechatAccount {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WechatAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WechatAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WechatAccountUpdateOne) check() error {
+	if v, ok := _u.mutation.UID(); ok {
+		if err := wechataccount.UIDValidator(v); err != nil {
+			return &ValidationError{Name: "uid", err: fmt.Errorf(`ent: validator failed for field "WechatAccount.uid": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AppID(); ok {
+		if err := wechataccount.AppIDValidator(v); err != nil {
+			return &ValidationError{Name: "app_id", err: fmt.Errorf(`ent: validator failed for field "WechatAccount.app_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OpenID(); ok {
+		if err := wechataccount.OpenIDValidator(v); err != nil {
+			return &ValidationError{Name: "open_id", err: fmt.Errorf(`ent: validator failed for field "WechatAccount.open_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UnionID(); ok {
+		if err := wechataccount.UnionIDValidator(v); err != nil {
+			return &ValidationError{Name: "union_id", err: fmt.Errorf(`ent: validator failed for field "WechatAccount.union_id": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WechatAccount.user"`)
+	}
+	return nil
+}
+
+func (_u *WechatAccountUpdateOne) sqlSave(ctx context.Context) (_node *WechatAccount, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wechataccount.Table, wechataccount.Columns, sqlgraph.NewFieldSpec(wechataccount.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WechatAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wechataccount.FieldID)
+		for _, f := range fields {
+			if !wechataccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wechataccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.AppID(); ok {
+		_spec.SetField(wechataccount.FieldAppID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OpenID(); ok {
+		_spec.SetField(wechataccount.FieldOpenID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UnionID(); ok {
+		_spec.SetField(wechataccount.FieldUnionID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(wechataccount.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wechataccount.UserTable,
+			Columns: []string{wechataccount.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wechataccount.UserTable,
+			Columns: []string{wechataccount.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WechatAccount{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wechataccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
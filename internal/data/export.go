@@ -0,0 +1,214 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"user-service/internal/biz"
+	"user-service/internal/data/ent"
+	"user-service/internal/data/ent/dataexport"
+	"user-service/internal/data/ent/predicate"
+	"user-service/internal/data/ent/user"
+	"user-service/third_party/blob"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type exportRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewExportRepo 创建数据导出仓储
+func NewExportRepo(data *Data, logger log.Logger) biz.ExportRepo {
+	return &exportRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// toBizExport 转换为业务层导出, 需要预加载 user
+func toBizExport(e *ent.DataExport) *biz.DataExport {
+	return &biz.DataExport{
+		ExportID:    e.ExportID,
+		UserID:      e.Edges.User.UserID,
+		Status:      e.Status,
+		ObjectKey:   e.ObjectKey,
+		Error:       e.Error,
+		CompletedAt: e.CompletedAt,
+		ExpiresAt:   e.ExpiresAt,
+		CreatedAt:   e.CreatedAt,
+	}
+}
+
+// Enabled 是否配置了私有存储
+func (r *exportRepo) Enabled() bool {
+	return r.data.exportBlob != nil
+}
+
+// Create 创建待处理的导出
+func (r *exportRepo) Create(ctx context.Context, e *biz.DataExport) (*biz.DataExport, error) {
+	u, err := r.data.db.User.Query().Where(user.UserID(e.UserID)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, biz.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	created, err := r.data.db.DataExport.Create().
+		SetUID(u.ID).
+		SetExportID(e.ExportID).
+		SetStatus(e.Status).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	created.Edges.User = u
+	return toBizExport(created), nil
+}
+
+// Find 根据 export_id 查找
+func (r *exportRepo) Find(ctx context.Context, exportID string) (*biz.DataExport, error) {
+	e, err := r.data.db.DataExport.Query().
+		Where(dataexport.ExportID(exportID)).
+		WithUser().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, biz.ErrExportNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toBizExport(e), nil
+}
+
+// FindLatest 查找用户最近一次导出
+func (r *exportRepo) FindLatest(ctx context.Context, userID int64) (*biz.DataExport, error) {
+	e, err := r.data.db.DataExport.Query().
+		Where(dataexport.HasUserWith(user.UserID(userID))).
+		Order(ent.Desc(dataexport.FieldCreatedAt), ent.Desc(dataexport.FieldID)).
+		WithUser().
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toBizExport(e), nil
+}
+
+// ListPending 列出待处理和处理中断的导出, 先申请的先处理
+func (r *exportRepo) ListPending(ctx context.Context, staleBefore time.Time, limit int) ([]*biz.DataExport, error) {
+	exports, err := r.data.db.DataExport.Query().
+		Where(claimable(staleBefore)).
+		Order(ent.Asc(dataexport.FieldID)).
+		Limit(limit).
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toBizExports(exports), nil
+}
+
+// Claim 标记为处理中, 条件更新保证多个实例不会重复处理
+func (r *exportRepo) Claim(ctx context.Context, exportID string, staleBefore time.Time) (bool, error) {
+	n, err := r.data.db.DataExport.Update().
+		Where(dataexport.ExportID(exportID), claimable(staleBefore)).
+		SetStatus(biz.ExportRunning).
+		SetStartedAt(time.Now()).
+		Save(ctx)
+	return n > 0, err
+}
+
+// claimable 待处理, 或处理中但 staleBefore 之前就已开始
+func claimable(staleBefore time.Time) predicate.DataExport {
+	return dataexport.Or(
+		dataexport.StatusEQ(biz.ExportPending),
+		dataexport.And(
+			dataexport.StatusEQ(biz.ExportRunning),
+			dataexport.StartedAtLT(staleBefore),
+		),
+	)
+}
+
+// Complete 标记完成
+func (r *exportRepo) Complete(ctx context.Context, exportID, objectKey string, expiresAt time.Time) error {
+	return r.data.db.DataExport.Update().
+		Where(dataexport.ExportID(exportID)).
+		SetStatus(biz.ExportReady).
+		SetObjectKey(objectKey).
+		SetCompletedAt(time.Now()).
+		SetExpiresAt(expiresAt).
+		Exec(ctx)
+}
+
+// Fail 标记失败
+func (r *exportRepo) Fail(ctx context.Context, exportID, reason string) error {
+	return r.data.db.DataExport.Update().
+		Where(dataexport.ExportID(exportID)).
+		SetStatus(biz.ExportFailed).
+		SetError(reason).
+		SetCompletedAt(time.Now()).
+		Exec(ctx)
+}
+
+// ListExpired 列出已到期但文件未删除的导出
+func (r *exportRepo) ListExpired(ctx context.Context, before time.Time, limit int) ([]*biz.DataExport, error) {
+	exports, err := r.data.db.DataExport.Query().
+		Where(dataexport.StatusEQ(biz.ExportReady), dataexport.ExpiresAtLTE(before)).
+		Limit(limit).
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toBizExports(exports), nil
+}
+
+// Expire 标记文件已删除
+func (r *exportRepo) Expire(ctx context.Context, exportID string) error {
+	return r.data.db.DataExport.Update().
+		Where(dataexport.ExportID(exportID)).
+		SetStatus(biz.ExportExpired).
+		SetObjectKey("").
+		Exec(ctx)
+}
+
+// PutArchive 写入私有存储
+func (r *exportRepo) PutArchive(ctx context.Context, key string, data []byte) error {
+	if r.data.exportBlob == nil {
+		return biz.ErrExportDisabled
+	}
+	return r.data.exportBlob.Put(ctx, key, "application/zip", data)
+}
+
+// GetArchive 读取私有存储
+func (r *exportRepo) GetArchive(ctx context.Context, key string) ([]byte, error) {
+	if r.data.exportBlob == nil {
+		return nil, biz.ErrExportDisabled
+	}
+	data, err := r.data.exportBlob.Get(ctx, key)
+	if errors.Is(err, blob.ErrNotFound) {
+		return nil, biz.ErrExportNotFound
+	}
+	return data, err
+}
+
+// DeleteArchive 删除私有存储中的文件
+func (r *exportRepo) DeleteArchive(ctx context.Context, key string) error {
+	if r.data.exportBlob == nil {
+		return nil
+	}
+	return r.data.exportBlob.Delete(ctx, key)
+}
+
+func toBizExports(exports []*ent.DataExport) []*biz.DataExport {
+	result := make([]*biz.DataExport, 0, len(exports))
+	for _, e := range exports {
+		result = append(result, toBizExport(e))
+	}
+	return result
+}
@@ -0,0 +1,69 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"user-service/internal/biz"
+	"user-service/internal/data/ent"
+	"user-service/internal/data/ent/user"
+)
+
+func newTestGuest(t *testing.T, td *testData, secret string) *ent.User {
+	t.Helper()
+	ctx := context.Background()
+	created, err := newTestUserRepo(td).Create(ctx, &biz.User{Name: "guest"})
+	if err != nil {
+		t.Fatalf("error creating guest, %s", err)
+	}
+	u := td.db.User.Query().Where(user.UserID(created.UserID)).OnlyX(ctx)
+	if err = NewAuthProviderRepo(td.Data, td.logger).Create(ctx, biz.GuestProvider, secret, u); err != nil {
+		t.Fatalf("error linking guest secret, %s", err)
+	}
+	return u
+}
+
+func TestDeleteInactiveGuestsRechecksUnderLock(t *testing.T) {
+	td := newTestData(t)
+	repo := newTestUserRepo(td)
+	ctx := context.Background()
+
+	inactive := newTestGuest(t, td, "secret-1")
+	upgraded := newTestGuest(t, td, "secret-2")
+	returned := newTestGuest(t, td, "secret-3")
+	inactiveSince := time.Now().Add(time.Hour)
+
+	// 三个都是候选, 查询候选之后、锁定之前一个账号关联了正式登录方式, 一个重新登录
+	td.drv.onNextTx(func() {
+		if err := td.db.AuthProvider.Create().SetProviderType("google").SetProviderID("sub").SetUID(upgraded.ID).Exec(ctx); err != nil {
+			t.Errorf("error linking google, %s", err)
+		}
+		err := td.db.Session.Create().
+			SetSessionID("session-1").
+			SetProvider(biz.GuestProvider).
+			SetExpiresAt(inactiveSince.Add(time.Hour)).
+			SetCreatedAt(inactiveSince).
+			SetUID(returned.ID).
+			Exec(ctx)
+		if err != nil {
+			t.Errorf("error creating session, %s", err)
+		}
+	})
+
+	n, err := repo.DeleteInactiveGuests(ctx, inactiveSince, 10)
+	if err != nil {
+		t.Fatalf("error deleting guests, %s", err)
+	}
+	if n != 1 {
+		t.Fatalf("expected 1 guest deleted, got %d", n)
+	}
+	if td.db.User.Query().Where(user.ID(inactive.ID)).ExistX(ctx) {
+		t.Fatal("expected the inactive guest to be deleted")
+	}
+	for _, u := range []*ent.User{upgraded, returned} {
+		if !td.db.User.Query().Where(user.ID(u.ID)).ExistX(ctx) {
+			t.Fatalf("expected user %d to be kept", u.UserID)
+		}
+	}
+}
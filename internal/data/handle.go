@@ -0,0 +1,113 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"user-service/internal/biz"
+	"user-service/internal/data/ent"
+	"user-service/internal/data/ent/handlehistory"
+	"user-service/internal/data/ent/user"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type handleRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewHandleRepo 创建 handle 仓储
+func NewHandleRepo(data *Data, logger log.Logger) biz.HandleRepo {
+	return &handleRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// FindUser 查找当前使用该 handle 的用户, 与唯一索引一致, 包括已合并的账号
+func (r *handleRepo) FindUser(ctx context.Context, handleLower string) (*biz.User, error) {
+	u, err := r.data.db.User.Query().
+		Where(user.HandleLower(handleLower)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, biz.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toBizUser(u), nil
+}
+
+// FindLatestHistory 查找最近一次放弃该 handle 的记录
+func (r *handleRepo) FindLatestHistory(ctx context.Context, handleLower string) (*biz.HandleHistory, error) {
+	h, err := r.data.db.HandleHistory.Query().
+		Where(handlehistory.HandleLower(handleLower)).
+		Order(ent.Desc(handlehistory.FieldCreatedAt), ent.Desc(handlehistory.FieldID)).
+		WithUser().
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &biz.HandleHistory{
+		UserID:    h.Edges.User.UserID,
+		Handle:    h.Handle,
+		HeldUntil: h.HeldUntil,
+		CreatedAt: h.CreatedAt,
+	}, nil
+}
+
+// Change 修改用户的 handle, 旧 handle 写入历史, 唯一索引冲突时返回 ErrHandleUnavailable
+func (r *handleRepo) Change(ctx context.Context, userID int64, handle string, heldUntil time.Time) (*biz.User, error) {
+	tx, err := r.data.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	u, err := changeHandle(ctx, tx, userID, handle, heldUntil)
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return nil, biz.ErrHandleUnavailable
+		}
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	r.data.delUserCache(ctx, userID)
+	return toBizUser(u), nil
+}
+
+func changeHandle(ctx context.Context, tx *ent.Tx, userID int64, handle string, heldUntil time.Time) (*ent.User, error) {
+	u, err := tx.User.Query().Where(user.UserID(userID)).ForUpdate().Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, biz.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	lower := strings.ToLower(handle)
+	// 只修改大小写时不记录历史
+	if u.HandleLower != nil && *u.HandleLower != lower {
+		err = tx.HandleHistory.Create().
+			SetUID(u.ID).
+			SetHandle(*u.Handle).
+			SetHandleLower(*u.HandleLower).
+			SetHeldUntil(heldUntil).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tx.User.UpdateOne(u).
+		SetHandle(handle).
+		SetHandleLower(lower).
+		SetHandleChangedAt(time.Now()).
+		Save(ctx)
+}
@@ -0,0 +1,118 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"user-service/internal/biz"
+	"user-service/internal/data/ent/handlehistory"
+	"user-service/internal/data/ent/user"
+)
+
+// newMergeUser 创建带手机号和 handle 的用户, handle 改过一次, 留有历史记录
+func newMergeUser(t *testing.T, td *testData, phone, oldHandle, handle string) *biz.User {
+	t.Helper()
+	ctx := context.Background()
+	u, err := newTestUserRepo(td).Create(ctx, &biz.User{Name: handle, Phone: phone})
+	if err != nil {
+		t.Fatalf("error creating user, %s", err)
+	}
+	handles := NewHandleRepo(td.Data, td.logger)
+	for _, h := range []string{oldHandle, handle} {
+		if _, err = handles.Change(ctx, u.UserID, h, time.Now().Add(time.Hour)); err != nil {
+			t.Fatalf("error changing handle, %s", err)
+		}
+	}
+	return u
+}
+
+func TestMergeReleasesTombstoneValues(t *testing.T) {
+	td := newTestData(t)
+	repo := newTestUserRepo(td)
+	handles := NewHandleRepo(td.Data, td.logger)
+	ctx := context.Background()
+
+	from := newMergeUser(t, td, "+8613800000001", "from_old", "from_handle")
+	to := newMergeUser(t, td, "+8613800000002", "to_old", "to_handle")
+	// 缓存合并前按手机号查到的用户
+	if _, err := repo.FindByPhone(ctx, from.Phone); err != nil {
+		t.Fatalf("error finding user by phone, %s", err)
+	}
+	if !td.redis.has(userPhoneKey(from.Phone)) {
+		t.Fatal("expected phone lookup to be cached")
+	}
+
+	if err := repo.Merge(ctx, from.UserID, to.UserID); err != nil {
+		t.Fatalf("error merging, %s", err)
+	}
+
+	// 两个账号都有手机号和 handle 时保留目标账号的, 旧账号的值被释放
+	tombstone := td.db.User.Query().Where(user.UserID(from.UserID)).OnlyX(ctx)
+	if tombstone.MergedInto == nil || *tombstone.MergedInto != to.UserID {
+		t.Fatalf("expected tombstone merged into %d, got %v", to.UserID, tombstone.MergedInto)
+	}
+	if tombstone.Phone != nil || tombstone.Handle != nil || tombstone.HandleLower != nil {
+		t.Fatalf("expected tombstone phone and handle cleared, got %v %v %v", tombstone.Phone, tombstone.Handle, tombstone.HandleLower)
+	}
+	target := td.db.User.Query().Where(user.UserID(to.UserID)).OnlyX(ctx)
+	if stringValue(target.Phone) != to.Phone || stringValue(target.Handle) != "to_handle" {
+		t.Fatalf("expected target to keep its phone and handle, got %v %v", target.Phone, target.Handle)
+	}
+	if td.redis.has(userPhoneKey(from.Phone)) {
+		t.Fatal("expected the released phone's cache to be deleted")
+	}
+
+	// 旧账号的 handle 历史归属目标账号, 保留期内仍然跳转到目标账号
+	n := td.db.HandleHistory.Query().Where(handlehistory.UID(target.ID)).CountX(ctx)
+	if n != 2 {
+		t.Fatalf("expected 2 handle histories on the target, got %d", n)
+	}
+	h, err := handles.FindLatestHistory(ctx, "from_old")
+	if err != nil || h == nil || h.UserID != to.UserID {
+		t.Fatalf("expected from_old held for %d, got %+v %v", to.UserID, h, err)
+	}
+
+	// 释放的手机号和 handle 可以再次使用
+	other, err := repo.Create(ctx, &biz.User{Name: "other", Phone: from.Phone})
+	if err != nil {
+		t.Fatalf("expected released phone to be usable, got %v", err)
+	}
+	if _, err = handles.FindUser(ctx, "from_handle"); err != biz.ErrUserNotFound {
+		t.Fatalf("expected released handle to be free, got %v", err)
+	}
+	if _, err = handles.Change(ctx, other.UserID, "from_handle", time.Now()); err != nil {
+		t.Fatalf("expected released handle to be usable, got %v", err)
+	}
+}
+
+func TestMergeMovesValuesToEmptyTarget(t *testing.T) {
+	td := newTestData(t)
+	repo := newTestUserRepo(td)
+	ctx := context.Background()
+
+	from := newMergeUser(t, td, "+8613800000001", "from_old", "from_handle")
+	to, err := repo.Create(ctx, &biz.User{Name: "to", Phone: "+8613800000002"})
+	if err != nil {
+		t.Fatalf("error creating user, %s", err)
+	}
+	if _, err = td.db.User.Update().Where(user.UserID(to.UserID)).ClearPhone().Save(ctx); err != nil {
+		t.Fatalf("error clearing phone, %s", err)
+	}
+	// 目标账号需要有可保留的登录方式
+	if err = td.db.AuthProvider.Create().SetProviderType("google").SetProviderID("sub").SetUID(to.ID).Exec(ctx); err != nil {
+		t.Fatalf("error linking provider, %s", err)
+	}
+
+	if err = repo.Merge(ctx, from.UserID, to.UserID); err != nil {
+		t.Fatalf("error merging, %s", err)
+	}
+	target := td.db.User.Query().Where(user.UserID(to.UserID)).OnlyX(ctx)
+	if stringValue(target.Phone) != from.Phone || stringValue(target.Handle) != "from_handle" {
+		t.Fatalf("expected phone and handle moved to the target, got %v %v", target.Phone, target.Handle)
+	}
+	found, err := repo.FindByPhone(ctx, from.Phone)
+	if err != nil || found.UserID != to.UserID {
+		t.Fatalf("expected phone to find the target, got %+v %v", found, err)
+	}
+}
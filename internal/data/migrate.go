@@ -0,0 +1,574 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"user-service/internal/conf"
+	entmigrate "user-service/internal/data/ent/migrate"
+	"user-service/internal/data/migrations"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/mysql"
+	"ariga.io/atlas/sql/schema"
+	entschema "entgo.io/ent/dialect/sql/schema"
+	"github.com/go-kratos/kratos/v2/log"
+	mysqldriver "github.com/go-sql-driver/mysql"
+)
+
+const (
+	// revisionTable 记录已执行的迁移版本, 与 atlas cli 使用的表结构一致
+	revisionTable = "atlas_schema_revisions"
+	// migrateLock 迁移期间持有的 MySQL 命名锁, 防止多个实例同时迁移
+	migrateLock        = "user-service:migrate"
+	migrateLockTimeout = 30 * time.Second
+	// phoneBatch 规范化手机号时每批读取的用户数
+	phoneBatch = 500
+	// mysqlDuplicateEntry 唯一索引冲突的错误码
+	mysqlDuplicateEntry = 1062
+)
+
+// MigrationStatus 数据库迁移状态
+type MigrationStatus struct {
+	// Current 最近一次执行的版本, 未迁移时为空
+	Current string
+	// Latest 内嵌迁移文件的最新版本
+	Latest string
+	// Pending 待执行的迁移文件
+	Pending []string
+	// Error 最近一次迁移的错误, 为空表示执行完整
+	Error string
+	// Drift 迁移完成后数据库结构与代码不一致的说明, 为空表示一致
+	Drift string
+}
+
+// PhoneNormalization 规范化已有手机号的结果
+type PhoneNormalization struct {
+	// Updated 已改写的用户数
+	Updated int
+	// Invalid 格式错误无法规范化的 user_id
+	Invalid []int64
+	// Conflicts 规范化后与其他用户重复的 user_id
+	Conflicts []int64
+}
+
+// Migrator 执行版本化的数据库迁移
+type Migrator struct {
+	db  *sql.DB
+	drv migrate.Driver
+	dir migrate.Dir
+	rrw *revisionReadWriter
+	log *log.Helper
+}
+
+// NewMigrator 创建迁移实例, 目前只支持 MySQL
+func NewMigrator(conf *conf.Data, logger log.Logger) (*Migrator, func(), error) {
+	if conf.Database.Driver != "mysql" {
+		return nil, nil, fmt.Errorf("migrate: unsupported database driver %q", conf.Database.Driver)
+	}
+	db, err := sql.Open(conf.Database.Driver, conf.Database.Source)
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() {
+		if err := db.Close(); err != nil {
+			log.NewHelper(logger).Error(err)
+		}
+	}
+	drv, err := mysql.Open(db)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	dir, err := migrations.Dir()
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	return &Migrator{
+		db:  db,
+		drv: drv,
+		dir: dir,
+		rrw: &revisionReadWriter{db: db},
+		log: log.NewHelper(logger),
+	}, cleanup, nil
+}
+
+// Up 执行所有待执行的迁移;
+// 已有数据的库 (之前由自动迁移创建) 需要指定 baseline 版本, 该版本及之前的迁移只记录不执行
+func (m *Migrator) Up(ctx context.Context, baseline string) error {
+	unlock, err := m.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err = m.rrw.init(ctx); err != nil {
+		return err
+	}
+	opts := []migrate.ExecutorOption{migrate.WithLogger(&migrateLogger{log: m.log})}
+	if baseline != "" {
+		opts = append(opts, migrate.WithBaselineVersion(baseline))
+	}
+	ex, err := migrate.NewExecutor(m.drv, m.dir, m.rrw, opts...)
+	if err != nil {
+		return err
+	}
+	err = ex.ExecuteN(ctx, 0)
+	if errors.Is(err, migrate.ErrNoPendingFiles) {
+		m.log.WithContext(ctx).Info("database schema is up to date")
+		return nil
+	}
+	var cerr *migrate.NotCleanError
+	if errors.As(err, &cerr) {
+		return fmt.Errorf("%w, use -baseline to mark the existing schema", err)
+	}
+	return err
+}
+
+// Down 回滚最近一次执行的迁移
+func (m *Migrator) Down(ctx context.Context) error {
+	unlock, err := m.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err = m.rrw.init(ctx); err != nil {
+		return err
+	}
+	revs, err := m.rrw.ReadRevisions(ctx)
+	if err != nil {
+		return err
+	}
+	if len(revs) == 0 {
+		return errors.New("migrate: no applied migration to revert")
+	}
+	last := revs[len(revs)-1]
+	switch {
+	case last.Type&migrate.RevisionTypeExecute == 0:
+		// baseline 只是标记, 对应的结构不是迁移创建的
+		return fmt.Errorf("migrate: version %s is a baseline and cannot be reverted", last.Version)
+	case last.Applied != last.Total || last.Error != "":
+		return fmt.Errorf("migrate: version %s is partially applied, fix it and run migrate up again", last.Version)
+	}
+
+	files, err := m.dir.Files()
+	if err != nil {
+		return err
+	}
+	idx := migrate.FilesLastIndex(files, func(f migrate.File) bool {
+		return f.Version() == last.Version
+	})
+	if idx == -1 {
+		return fmt.Errorf("migrate: migration file of version %s not found", last.Version)
+	}
+	down, err := migrations.Down(files[idx].Name())
+	if err != nil {
+		return fmt.Errorf("migrate: down file of %s not found: %w", files[idx].Name(), err)
+	}
+	stmts, err := down.Stmts()
+	if err != nil {
+		return err
+	}
+
+	m.log.WithContext(ctx).Infof("reverting %s", files[idx].Name())
+	for _, stmt := range stmts {
+		m.log.WithContext(ctx).Debug(stmt)
+		if _, err = m.db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("migrate: revert %s: %w", files[idx].Name(), err)
+		}
+	}
+	return m.rrw.DeleteRevision(ctx, last.Version)
+}
+
+// Status 返回当前版本和待执行的迁移
+func (m *Migrator) Status(ctx context.Context) (*MigrationStatus, error) {
+	files, err := m.dir.Files()
+	if err != nil {
+		return nil, err
+	}
+	revs, err := m.rrw.ReadRevisions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &MigrationStatus{}
+	if len(files) > 0 {
+		status.Latest = files[len(files)-1].Version()
+	}
+	if len(revs) > 0 {
+		last := revs[len(revs)-1]
+		status.Current = last.Version
+		status.Error = last.Error
+		if status.Error == "" && last.Applied != last.Total {
+			status.Error = fmt.Sprintf("applied %d of %d statements", last.Applied, last.Total)
+		}
+	}
+	for _, f := range files {
+		if f.Version() > status.Current {
+			status.Pending = append(status.Pending, f.Name())
+		}
+	}
+	if len(status.Pending) == 0 && status.Error == "" {
+		if err = checkSchema(ctx, m.db); err != nil {
+			status.Drift = err.Error()
+		}
+	}
+	return status, nil
+}
+
+// NormalizePhones 按 id 顺序逐行规范化已保存的手机号, 规则与登录时相同;
+// 无法规范化或与其他用户重复的号码保持不变, 需要人工处理. 可重复执行
+func (m *Migrator) NormalizePhones(ctx context.Context, normalize func(string) (string, error)) (*PhoneNormalization, error) {
+	type userPhone struct {
+		id, userID int64
+		phone      string
+	}
+	result := &PhoneNormalization{}
+	var lastID int64
+	for {
+		rows, err := m.db.QueryContext(ctx,
+			"SELECT `id`, `user_id`, `phone` FROM `users` WHERE `id` > ? AND `phone` IS NOT NULL ORDER BY `id` LIMIT ?",
+			lastID, phoneBatch)
+		if err != nil {
+			return result, err
+		}
+		var batch []userPhone
+		for rows.Next() {
+			var u userPhone
+			if err = rows.Scan(&u.id, &u.userID, &u.phone); err != nil {
+				_ = rows.Close()
+				return result, err
+			}
+			batch = append(batch, u)
+		}
+		if err = rows.Close(); err != nil {
+			return result, err
+		}
+		if err = rows.Err(); err != nil {
+			return result, err
+		}
+
+		for _, u := range batch {
+			lastID = u.id
+			phone, err := normalize(u.phone)
+			if err != nil {
+				result.Invalid = append(result.Invalid, u.userID)
+				continue
+			}
+			if phone == u.phone {
+				continue
+			}
+			// 读取之后号码被修改过的行不覆盖
+			res, err := m.db.ExecContext(ctx,
+				"UPDATE `users` SET `phone` = ?, `updated_at` = ? WHERE `id` = ? AND `phone` = ?",
+				phone, time.Now(), u.id, u.phone)
+			var mysqlErr *mysqldriver.MySQLError
+			if errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlDuplicateEntry {
+				result.Conflicts = append(result.Conflicts, u.userID)
+				continue
+			}
+			if err != nil {
+				return result, err
+			}
+			if n, _ := res.RowsAffected(); n > 0 {
+				result.Updated++
+			}
+		}
+		if len(batch) < phoneBatch {
+			return result, nil
+		}
+	}
+}
+
+func (m *Migrator) lock(ctx context.Context) (schema.UnlockFunc, error) {
+	locker, ok := m.drv.(schema.Locker)
+	if !ok {
+		return func() error { return nil }, nil
+	}
+	unlock, err := locker.Lock(ctx, migrateLock, migrateLockTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("migrate: acquire lock: %w", err)
+	}
+	return unlock, nil
+}
+
+// checkSchemaVersion 启动时检查数据库已迁移到当前代码期望的版本, 不自动修改表结构
+func checkSchemaVersion(ctx context.Context, db *sql.DB) error {
+	dir, err := migrations.Dir()
+	if err != nil {
+		return err
+	}
+	if err = migrate.Validate(dir); err != nil {
+		return err
+	}
+	files, err := dir.Files()
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return nil
+	}
+	expected := files[len(files)-1].Version()
+
+	revs, err := (&revisionReadWriter{db: db}).ReadRevisions(ctx)
+	if err != nil {
+		return err
+	}
+	if len(revs) == 0 {
+		return fmt.Errorf("database schema is not versioned, expected version %s: run `user-service migrate up`", expected)
+	}
+	last := revs[len(revs)-1]
+	switch {
+	case last.Error != "" || last.Applied != last.Total:
+		return fmt.Errorf("database migration %s is incomplete: %s", last.Version, last.Error)
+	case last.Version < expected:
+		return fmt.Errorf("database schema version %s is behind %s: run `user-service migrate up`", last.Version, expected)
+	case last.Version > expected:
+		return fmt.Errorf("database schema version %s is newer than %s expected by this build", last.Version, expected)
+	}
+	return nil
+}
+
+// checkSchema 启动时检查数据库包含代码使用的表、列和索引;
+// 版本号正确但 baseline 标记错误时, 结构可能与迁移文件不一致
+func checkSchema(ctx context.Context, db *sql.DB) error {
+	drv, err := mysql.Open(db)
+	if err != nil {
+		return err
+	}
+	current, err := drv.InspectSchema(ctx, "", nil)
+	if err != nil {
+		return err
+	}
+	return diffSchema(entmigrate.Tables, current)
+}
+
+// diffSchema 比较 ent schema 与数据库结构, 只检查代码依赖的部分: 表、列、可空性、索引和唯一约束
+func diffSchema(tables []*entschema.Table, current *schema.Schema) error {
+	var problems []string
+	for _, t := range tables {
+		ct, ok := current.Table(t.Name)
+		if !ok {
+			problems = append(problems, fmt.Sprintf("table %s is missing", t.Name))
+			continue
+		}
+		unique := make(map[string]bool)
+		for _, c := range t.Columns {
+			cc, ok := ct.Column(c.Name)
+			switch {
+			case !ok:
+				problems = append(problems, fmt.Sprintf("column %s.%s is missing", t.Name, c.Name))
+				continue
+			case c.Nullable && !cc.Type.Null:
+				problems = append(problems, fmt.Sprintf("column %s.%s must be nullable", t.Name, c.Name))
+			case !c.Nullable && cc.Type.Null:
+				problems = append(problems, fmt.Sprintf("column %s.%s must be NOT NULL", t.Name, c.Name))
+			}
+			if c.Unique {
+				unique[indexKey(c.Name)] = true
+				if !hasUniqueIndex(ct, c.Name) {
+					problems = append(problems, fmt.Sprintf("column %s.%s must be unique", t.Name, c.Name))
+				}
+			}
+		}
+		for _, idx := range t.Indexes {
+			columns := make([]string, 0, len(idx.Columns))
+			for _, c := range idx.Columns {
+				columns = append(columns, c.Name)
+			}
+			if idx.Unique {
+				unique[indexKey(columns...)] = true
+			}
+			ci, ok := ct.Index(idx.Name)
+			switch {
+			case !ok:
+				problems = append(problems, fmt.Sprintf("index %s.%s is missing", t.Name, idx.Name))
+			case ci.Unique != idx.Unique:
+				problems = append(problems, fmt.Sprintf("index %s.%s must have unique=%v", t.Name, idx.Name, idx.Unique))
+			}
+		}
+		// 多出的唯一索引会拒绝合法的写入, 例如旧版本 auth_providers.uid 上的唯一索引
+		for _, ci := range ct.Indexes {
+			if ci.Unique && !unique[indexKey(indexColumns(ci)...)] {
+				problems = append(problems, fmt.Sprintf("unexpected unique index %s.%s", t.Name, ci.Name))
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("database schema does not match this build: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+func hasUniqueIndex(t *schema.Table, column string) bool {
+	for _, idx := range t.Indexes {
+		if idx.Unique && indexKey(indexColumns(idx)...) == indexKey(column) {
+			return true
+		}
+	}
+	return false
+}
+
+func indexColumns(idx *schema.Index) []string {
+	columns := make([]string, 0, len(idx.Parts))
+	for _, p := range idx.Parts {
+		if p.C != nil {
+			columns = append(columns, p.C.Name)
+		}
+	}
+	return columns
+}
+
+func indexKey(columns ...string) string {
+	return strings.Join(columns, ",")
+}
+
+// migrateLogger 把迁移过程输出到服务日志
+type migrateLogger struct {
+	log *log.Helper
+}
+
+func (l *migrateLogger) Log(e migrate.LogEntry) {
+	switch e := e.(type) {
+	case migrate.LogExecution:
+		l.log.Infof("migrating from version %q to %q (%d migrations)", e.From, e.To, len(e.Files))
+	case migrate.LogFile:
+		l.log.Infof("applying %s", e.File.Name())
+	case migrate.LogStmt:
+		l.log.Debug(e.SQL)
+	case migrate.LogError:
+		l.log.Errorf("migration failed: %v %s", e.Error, e.SQL)
+	case migrate.LogDone:
+		l.log.Info("migration done")
+	}
+}
+
+// revisionReadWriter 在 atlas_schema_revisions 表中读写迁移记录
+type revisionReadWriter struct {
+	db *sql.DB
+}
+
+func (r *revisionReadWriter) Ident() *migrate.TableIdent {
+	return &migrate.TableIdent{Name: revisionTable}
+}
+
+// init 创建迁移记录表
+func (r *revisionReadWriter) init(ctx context.Context) error {
+	_, err := r.db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS `"+revisionTable+"` ("+
+		"`version` varchar(255) NOT NULL, "+
+		"`description` varchar(255) NOT NULL, "+
+		"`type` bigint unsigned NOT NULL DEFAULT 2, "+
+		"`applied` bigint NOT NULL DEFAULT 0, "+
+		"`total` bigint NOT NULL DEFAULT 0, "+
+		"`executed_at` timestamp NOT NULL, "+
+		"`execution_time` bigint NOT NULL, "+
+		"`error` longtext NULL, "+
+		"`error_stmt` longtext NULL, "+
+		"`hash` varchar(255) NOT NULL, "+
+		"`partial_hashes` json NULL, "+
+		"`operator_version` varchar(255) NOT NULL, "+
+		"PRIMARY KEY (`version`)"+
+		") CHARSET utf8mb4 COLLATE utf8mb4_bin")
+	return err
+}
+
+// exists 判断迁移记录表是否存在, 只读检查时不创建
+func (r *revisionReadWriter) exists(ctx context.Context) (bool, error) {
+	var n int
+	err := r.db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?",
+		revisionTable,
+	).Scan(&n)
+	return n > 0, err
+}
+
+const revisionColumns = "`version`, `description`, `type`, `applied`, `total`, `executed_at`, `execution_time`, " +
+	"`error`, `error_stmt`, `hash`, `partial_hashes`, `operator_version`"
+
+// ReadRevisions 按版本升序返回所有迁移记录
+func (r *revisionReadWriter) ReadRevisions(ctx context.Context) ([]*migrate.Revision, error) {
+	ok, err := r.exists(ctx)
+	if err != nil || !ok {
+		return nil, err
+	}
+	rows, err := r.db.QueryContext(ctx, "SELECT "+revisionColumns+" FROM `"+revisionTable+"` ORDER BY `version`")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revs []*migrate.Revision
+	for rows.Next() {
+		rev, err := scanRevision(rows)
+		if err != nil {
+			return nil, err
+		}
+		revs = append(revs, rev)
+	}
+	return revs, rows.Err()
+}
+
+// ReadRevision 按版本读取迁移记录, 不存在时返回 migrate.ErrRevisionNotExist
+func (r *revisionReadWriter) ReadRevision(ctx context.Context, version string) (*migrate.Revision, error) {
+	row := r.db.QueryRowContext(ctx, "SELECT "+revisionColumns+" FROM `"+revisionTable+"` WHERE `version` = ?", version)
+	rev, err := scanRevision(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, migrate.ErrRevisionNotExist
+	}
+	return rev, err
+}
+
+// WriteRevision 保存迁移记录, 已存在时更新
+func (r *revisionReadWriter) WriteRevision(ctx context.Context, rev *migrate.Revision) error {
+	partial, err := json.Marshal(rev.PartialHashes)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, "INSERT INTO `"+revisionTable+"` ("+revisionColumns+") "+
+		"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) "+
+		"ON DUPLICATE KEY UPDATE `description` = VALUES(`description`), `type` = VALUES(`type`), "+
+		"`applied` = VALUES(`applied`), `total` = VALUES(`total`), `executed_at` = VALUES(`executed_at`), "+
+		"`execution_time` = VALUES(`execution_time`), `error` = VALUES(`error`), `error_stmt` = VALUES(`error_stmt`), "+
+		"`hash` = VALUES(`hash`), `partial_hashes` = VALUES(`partial_hashes`), `operator_version` = VALUES(`operator_version`)",
+		rev.Version, rev.Description, rev.Type, rev.Applied, rev.Total, rev.ExecutedAt, int64(rev.ExecutionTime),
+		rev.Error, rev.ErrorStmt, rev.Hash, partial, rev.OperatorVersion,
+	)
+	return err
+}
+
+// DeleteRevision 删除迁移记录
+func (r *revisionReadWriter) DeleteRevision(ctx context.Context, version string) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM `"+revisionTable+"` WHERE `version` = ?", version)
+	return err
+}
+
+func scanRevision(row interface{ Scan(...any) error }) (*migrate.Revision, error) {
+	var (
+		rev             migrate.Revision
+		executionTime   int64
+		errMsg, errStmt sql.NullString
+		partial         []byte
+	)
+	err := row.Scan(
+		&rev.Version, &rev.Description, &rev.Type, &rev.Applied, &rev.Total, &rev.ExecutedAt, &executionTime,
+		&errMsg, &errStmt, &rev.Hash, &partial, &rev.OperatorVersion,
+	)
+	if err != nil {
+		return nil, err
+	}
+	rev.ExecutionTime = time.Duration(executionTime)
+	rev.Error, rev.ErrorStmt = errMsg.String, errStmt.String
+	if len(partial) > 0 {
+		if err = json.Unmarshal(partial, &rev.PartialHashes); err != nil {
+			return nil, err
+		}
+	}
+	return &rev, nil
+}
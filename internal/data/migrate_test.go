@@ -0,0 +1,87 @@
+package data
+
+import (
+	"strings"
+	"testing"
+
+	entmigrate "user-service/internal/data/ent/migrate"
+
+	"ariga.io/atlas/sql/schema"
+	entschema "entgo.io/ent/dialect/sql/schema"
+)
+
+// inspected 按 ent schema 构造数据库结构, 与 InspectSchema 返回的结构相同
+func inspected(tables []*entschema.Table) *schema.Schema {
+	s := schema.New("")
+	for _, t := range tables {
+		st := schema.NewTable(t.Name)
+		for _, c := range t.Columns {
+			st.AddColumns(&schema.Column{Name: c.Name, Type: &schema.ColumnType{Null: c.Nullable}})
+			if c.Unique {
+				st.AddIndexes(schema.NewUniqueIndex(c.Name).AddColumns(st.Columns[len(st.Columns)-1]))
+			}
+		}
+		for _, idx := range t.Indexes {
+			si := schema.NewIndex(idx.Name).SetUnique(idx.Unique)
+			for _, c := range idx.Columns {
+				sc, _ := st.Column(c.Name)
+				si.AddColumns(sc)
+			}
+			st.AddIndexes(si)
+		}
+		s.AddTables(st)
+	}
+	return s
+}
+
+func TestDiffSchema(t *testing.T) {
+	if err := diffSchema(entmigrate.Tables, inspected(entmigrate.Tables)); err != nil {
+		t.Fatalf("expected matching schema, got %v", err)
+	}
+
+	// baseline 标记在旧结构上: 缺表、缺列、旧的唯一索引和不可空的邮箱
+	current := inspected(entmigrate.Tables)
+	current.Tables = removeTable(current.Tables, "sessions")
+	users, _ := current.Table("users")
+	users.Columns = removeColumn(users.Columns, "merged_into")
+	email, _ := users.Column("email")
+	email.Type.Null = false
+	providers, _ := current.Table("auth_providers")
+	uid, _ := providers.Column("uid")
+	providers.AddIndexes(schema.NewUniqueIndex("uid").AddColumns(uid))
+
+	err := diffSchema(entmigrate.Tables, current)
+	if err == nil {
+		t.Fatal("expected error for outdated schema")
+	}
+	for _, want := range []string{
+		"table sessions is missing",
+		"column users.merged_into is missing",
+		"column users.email must be nullable",
+		"unexpected unique index auth_providers.uid",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected %q in %v", want, err)
+		}
+	}
+}
+
+func removeTable(tables []*schema.Table, name string) []*schema.Table {
+	var kept []*schema.Table
+	for _, t := range tables {
+		if t.Name != name {
+			kept = append(kept, t)
+		}
+	}
+	return kept
+}
+
+func removeColumn(columns []*schema.Column, name string) []*schema.Column {
+	var kept []*schema.Column
+	for _, c := range columns {
+		if c.Name != name {
+			kept = append(kept, c)
+		}
+	}
+	return kept
+}
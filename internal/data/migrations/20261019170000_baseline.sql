@@ -0,0 +1,5 @@
+-- Schema created by the startup auto-migration before versioned migrations, existing deployments baseline to this version
+-- Create "users" table
+CREATE TABLE `users` (`id` bigint NOT NULL AUTO_INCREMENT, `user_id` bigint NOT NULL, `name` varchar(100) NOT NULL, `email` varchar(255) NOT NULL, `phone` varchar(20) NOT NULL, `avatar` varchar(255) NOT NULL, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `user_id` (`user_id`), UNIQUE INDEX `email` (`email`), UNIQUE INDEX `phone` (`phone`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "auth_providers" table
+CREATE TABLE `auth_providers` (`id` bigint NOT NULL AUTO_INCREMENT, `provider_type` varchar(20) NOT NULL, `provider_id` varchar(255) NOT NULL, `created_at` timestamp NOT NULL, `uid` bigint NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `uid` (`uid`), CONSTRAINT `auth_providers_users_auth_providers` FOREIGN KEY (`uid`) REFERENCES `users` (`id`) ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
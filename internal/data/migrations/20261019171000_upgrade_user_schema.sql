@@ -0,0 +1,22 @@
+-- Modify "users" table: nullable email and phone, verification, handle, profile, status and deletion columns
+ALTER TABLE `users` MODIFY COLUMN `email` varchar(255) NULL, MODIFY COLUMN `phone` varchar(20) NULL, ADD COLUMN `email_verified` bool NOT NULL DEFAULT false, ADD COLUMN `handle` varchar(30) NULL, ADD COLUMN `handle_lower` varchar(30) NULL, ADD COLUMN `handle_changed_at` timestamp NULL, ADD COLUMN `birthday` date NULL, ADD COLUMN `gender` varchar(16) NOT NULL DEFAULT '', ADD COLUMN `locale` varchar(35) NOT NULL DEFAULT '', ADD COLUMN `timezone` varchar(64) NOT NULL DEFAULT '', ADD COLUMN `country` varchar(2) NOT NULL DEFAULT '', ADD COLUMN `bio` varchar(2000) NOT NULL DEFAULT '', ADD COLUMN `metadata` json NULL, ADD COLUMN `apple_account_deleted` bool NOT NULL DEFAULT false, ADD COLUMN `merged_into` bigint NULL, ADD COLUMN `status` varchar(16) NOT NULL DEFAULT 'active', ADD COLUMN `status_reason` varchar(255) NOT NULL DEFAULT '', ADD COLUMN `status_changed_by` bigint NOT NULL DEFAULT 0, ADD COLUMN `status_changed_at` timestamp NULL, ADD COLUMN `status_expires_at` timestamp NULL, ADD COLUMN `deletion_requested_at` timestamp NULL, ADD COLUMN `deletion_scheduled_at` timestamp NULL, ADD UNIQUE INDEX `handle_lower` (`handle_lower`), ADD INDEX `user_deletion_scheduled_at` (`deletion_scheduled_at`), ADD INDEX `user_created_at` (`created_at`), ADD INDEX `user_name` (`name`);
+-- Modify "auth_providers" table: per-identity profile and tokens, a user can link several providers
+ALTER TABLE `auth_providers` ADD COLUMN `email` varchar(255) NOT NULL DEFAULT '', ADD COLUMN `email_verified` bool NOT NULL DEFAULT false, ADD COLUMN `name` varchar(100) NOT NULL DEFAULT '', ADD COLUMN `avatar` varchar(1024) NOT NULL DEFAULT '', ADD COLUMN `raw_claims` json NULL, ADD COLUMN `access_token` varchar(2048) NOT NULL DEFAULT '', ADD COLUMN `refresh_token` varchar(2048) NOT NULL DEFAULT '', ADD COLUMN `token_expires_at` timestamp NULL, ADD COLUMN `updated_at` timestamp NULL, ADD UNIQUE INDEX `authprovider_provider_type_provider_id` (`provider_type`, `provider_id`), ADD INDEX `authprovider_uid` (`uid`);
+-- Existing links have never been updated
+UPDATE `auth_providers` SET `updated_at` = `created_at`;
+ALTER TABLE `auth_providers` MODIFY COLUMN `updated_at` timestamp NOT NULL;
+-- Drop the old unique index on "uid" if the database has one, "authprovider_uid" now backs the foreign key
+SET @drop_uid = (SELECT IF(COUNT(*) > 0, 'ALTER TABLE `auth_providers` DROP INDEX `uid`', 'DO 0') FROM `information_schema`.`statistics` WHERE `table_schema` = DATABASE() AND `table_name` = 'auth_providers' AND `index_name` = 'uid');
+PREPARE `drop_uid` FROM @drop_uid;
+EXECUTE `drop_uid`;
+DEALLOCATE PREPARE `drop_uid`;
+-- Create "apple_notifications" table
+CREATE TABLE `apple_notifications` (`id` bigint NOT NULL AUTO_INCREMENT, `event_type` varchar(32) NOT NULL, `subject` varchar(255) NOT NULL, `email` varchar(255) NOT NULL DEFAULT '', `is_private_email` bool NOT NULL DEFAULT false, `event_time` timestamp NULL, `payload` longtext NOT NULL, `result` varchar(255) NOT NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `applenotification_subject` (`subject`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "data_exports" table
+CREATE TABLE `data_exports` (`id` bigint NOT NULL AUTO_INCREMENT, `export_id` varchar(32) NOT NULL, `status` varchar(16) NOT NULL DEFAULT 'pending', `object_key` varchar(255) NOT NULL DEFAULT '', `error` varchar(255) NOT NULL DEFAULT '', `started_at` timestamp NULL, `completed_at` timestamp NULL, `expires_at` timestamp NULL, `created_at` timestamp NOT NULL, `uid` bigint NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `export_id` (`export_id`), INDEX `dataexport_uid_created_at` (`uid`, `created_at`), INDEX `dataexport_status` (`status`), CONSTRAINT `data_exports_users_data_exports` FOREIGN KEY (`uid`) REFERENCES `users` (`id`) ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "handle_histories" table
+CREATE TABLE `handle_histories` (`id` bigint NOT NULL AUTO_INCREMENT, `handle` varchar(30) NOT NULL, `handle_lower` varchar(30) NOT NULL, `held_until` timestamp NOT NULL, `created_at` timestamp NOT NULL, `uid` bigint NOT NULL, PRIMARY KEY (`id`), INDEX `handlehistory_handle_lower` (`handle_lower`), INDEX `handlehistory_uid` (`uid`), CONSTRAINT `handle_histories_users_handle_histories` FOREIGN KEY (`uid`) REFERENCES `users` (`id`) ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "sessions" table
+CREATE TABLE `sessions` (`id` bigint NOT NULL AUTO_INCREMENT, `session_id` varchar(64) NOT NULL, `provider` varchar(20) NOT NULL, `expires_at` timestamp NOT NULL, `revoked_at` timestamp NULL, `created_at` timestamp NOT NULL, `uid` bigint NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `session_id` (`session_id`), INDEX `session_uid` (`uid`), CONSTRAINT `sessions_users_sessions` FOREIGN KEY (`uid`) REFERENCES `users` (`id`) ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "wechat_accounts" table
+CREATE TABLE `wechat_accounts` (`id` bigint NOT NULL AUTO_INCREMENT, `app_id` varchar(64) NOT NULL, `open_id` varchar(128) NOT NULL, `union_id` varchar(128) NOT NULL DEFAULT '', `created_at` timestamp NOT NULL, `uid` bigint NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `wechataccount_app_id_open_id` (`app_id`, `open_id`), INDEX `wechataccount_union_id` (`union_id`), CONSTRAINT `wechat_accounts_users_wechat_accounts` FOREIGN KEY (`uid`) REFERENCES `users` (`id`) ON DELETE NO ACTION) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,3 @@
+-- Store missing email and phone as NULL so the unique indexes only cover real values
+UPDATE `users` SET `email` = NULL WHERE `email` = '';
+UPDATE `users` SET `phone` = NULL WHERE `phone` = '';
@@ -0,0 +1,2 @@
+-- Create "user_events" table, the outbox relayed to the "user:events" stream
+CREATE TABLE `user_events` (`id` bigint NOT NULL AUTO_INCREMENT, `event_type` varchar(32) NOT NULL, `user_id` bigint NOT NULL, `occurred_at` timestamp NOT NULL, `published_at` timestamp NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `userevent_published_at` (`published_at`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,3 @@
+-- Drop tables referencing "users" first
+DROP TABLE `auth_providers`;
+DROP TABLE `users`;
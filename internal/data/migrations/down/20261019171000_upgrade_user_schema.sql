@@ -0,0 +1,10 @@
+-- Drop tables referencing "users" first
+DROP TABLE `wechat_accounts`;
+DROP TABLE `sessions`;
+DROP TABLE `handle_histories`;
+DROP TABLE `data_exports`;
+DROP TABLE `apple_notifications`;
+-- Users may have linked several providers, "authprovider_uid" stays as the foreign key index instead of the unique "uid"
+ALTER TABLE `auth_providers` DROP INDEX `authprovider_provider_type_provider_id`, DROP COLUMN `email`, DROP COLUMN `email_verified`, DROP COLUMN `name`, DROP COLUMN `avatar`, DROP COLUMN `raw_claims`, DROP COLUMN `access_token`, DROP COLUMN `refresh_token`, DROP COLUMN `token_expires_at`, DROP COLUMN `updated_at`;
+-- Missing email and phone are NULL and cannot be restored to NOT NULL under the unique indexes
+ALTER TABLE `users` DROP INDEX `handle_lower`, DROP INDEX `user_deletion_scheduled_at`, DROP INDEX `user_created_at`, DROP INDEX `user_name`, DROP COLUMN `email_verified`, DROP COLUMN `handle`, DROP COLUMN `handle_lower`, DROP COLUMN `handle_changed_at`, DROP COLUMN `birthday`, DROP COLUMN `gender`, DROP COLUMN `locale`, DROP COLUMN `timezone`, DROP COLUMN `country`, DROP COLUMN `bio`, DROP COLUMN `metadata`, DROP COLUMN `apple_account_deleted`, DROP COLUMN `merged_into`, DROP COLUMN `status`, DROP COLUMN `status_reason`, DROP COLUMN `status_changed_by`, DROP COLUMN `status_changed_at`, DROP COLUMN `status_expires_at`, DROP COLUMN `deletion_requested_at`, DROP COLUMN `deletion_scheduled_at`;
@@ -0,0 +1 @@
+-- NULL is the only representation of a missing email or phone, nothing to revert
@@ -0,0 +1,2 @@
+-- Unpublished events are lost
+DROP TABLE `user_events`;
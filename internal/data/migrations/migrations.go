@@ -0,0 +1,47 @@
+// Package migrations 内嵌数据库的版本化迁移文件.
+//
+// 迁移文件由 Atlas 根据 ent schema 生成, 修改 schema 后执行
+// make migration name=<name> 生成新版本, 并在 down 目录下编写同名的回滚文件.
+package migrations
+
+import (
+	"embed"
+	"io/fs"
+
+	"ariga.io/atlas/sql/migrate"
+)
+
+var (
+	//go:embed *.sql atlas.sum
+	upFiles embed.FS
+	//go:embed down/*.sql
+	downFiles embed.FS
+)
+
+// Dir 返回内嵌的迁移目录
+func Dir() (migrate.Dir, error) {
+	dir := &migrate.MemDir{}
+	entries, err := fs.ReadDir(upFiles, ".")
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		data, err := upFiles.ReadFile(e.Name())
+		if err != nil {
+			return nil, err
+		}
+		if err = dir.WriteFile(e.Name(), data); err != nil {
+			return nil, err
+		}
+	}
+	return dir, nil
+}
+
+// Down 返回迁移文件对应的回滚文件
+func Down(name string) (migrate.File, error) {
+	data, err := downFiles.ReadFile("down/" + name)
+	if err != nil {
+		return nil, err
+	}
+	return migrate.NewLocalFile(name, data), nil
+}
@@ -2,13 +2,204 @@ package data
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
+	"time"
+
+	"user-service/internal/biz"
+	"user-service/internal/data/ent"
+	"user-service/internal/data/ent/user"
+
+	"github.com/go-redis/redis/v8"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
 )
 
-func userInfoKey(uuid string) string {
-	return fmt.Sprintf("userInfo:%s", uuid)
+const (
+	// userCacheTTL 用户缓存的有效期, 修改时主动删除, 过期作为兜底
+	userCacheTTL = 30 * time.Minute
+	// userCacheMissTTL 不存在的结果缓存较短的时间, 防止穿透
+	userCacheMissTTL = time.Minute
+	// userCacheMiss 不存在的占位值
+	userCacheMiss = "-"
+	// publicUserTTL 公开资料缓存的有效期
+	publicUserTTL = 10 * time.Minute
+)
+
+// 缓存类型, 用于统计命中率
+const (
+	cacheUserID       = "id"
+	cacheUserPhone    = "phone"
+	cacheUserProvider = "provider"
+)
+
+// userCacheRequests 用户缓存查询次数, 按 cache 和 result=hit/miss 统计命中率
+var userCacheRequests = newCacheCounter()
+
+func newCacheCounter() metric.Int64Counter {
+	counter, err := otel.Meter("user-service/data").Int64Counter(
+		"user_cache_requests",
+		metric.WithDescription("user cache lookups by cache and result"),
+	)
+	if err != nil {
+		return noop.Int64Counter{}
+	}
+	return counter
+}
+
+func recordCache(ctx context.Context, cache string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	userCacheRequests.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("cache", cache),
+		attribute.String("result", result),
+	))
+}
+
+// userKey 保存序列化的 biz.User
+func userKey(userID int64) string {
+	return fmt.Sprintf("user:id:%d", userID)
+}
+
+// userPhoneKey 和 userProviderKey 保存 user_id, 再按 userKey 读取用户
+func userPhoneKey(phone string) string {
+	return fmt.Sprintf("user:phone:%s", phone)
+}
+
+func userProviderKey(providerType, providerID string) string {
+	return fmt.Sprintf("user:provider:%s:%s", providerType, providerID)
+}
+
+func publicUserKey(userID int64) string {
+	return fmt.Sprintf("user:public:%d", userID)
+}
+
+// publicUser 缓存的公开资料
+type publicUser struct {
+	UserID int64  `json:"user_id"`
+	Name   string `json:"name"`
+	Avatar string `json:"avatar"`
+	Bio    string `json:"bio"`
+	Handle string `json:"handle"`
+}
+
+// cachedUser 按 user_id 读取用户, 未命中时查询数据库并写回, 不存在的结果同样缓存
+func (d *Data) cachedUser(ctx context.Context, userID int64) (*biz.User, error) {
+	key := userKey(userID)
+	data, err := d.rdb.Get(ctx, key).Bytes()
+	if err == nil {
+		recordCache(ctx, cacheUserID, true)
+		return decodeUser(data)
+	}
+	if !errors.Is(err, redis.Nil) {
+		d.log.WithContext(ctx).Warnf("failed to get user cache %s, error: %v", key, err)
+	}
+	recordCache(ctx, cacheUserID, false)
+
+	return d.loadUser(ctx, key, nil, func(ctx context.Context) (*ent.User, error) {
+		return d.db.User.Query().
+			Where(user.UserID(userID)).
+			Only(ctx)
+	})
+}
+
+// cachedUserBy 通过索引 key 读取用户, 缓存中保存 user_id;
+// 按 user_id 读到的用户已不满足 valid 时 (如手机号已换绑) 视为未命中
+func (d *Data) cachedUserBy(ctx context.Context, cache, key string, valid func(*biz.User) bool, query func(context.Context) (*ent.User, error)) (*biz.User, error) {
+	v, err := d.rdb.Get(ctx, key).Result()
+	switch {
+	case err == nil && v == userCacheMiss:
+		recordCache(ctx, cache, true)
+		return nil, biz.ErrUserNotFound
+	case err == nil:
+		if userID, err := strconv.ParseInt(v, 10, 64); err == nil {
+			if u, err := d.cachedUser(ctx, userID); err == nil && valid(u) {
+				recordCache(ctx, cache, true)
+				return u, nil
+			}
+		}
+	case !errors.Is(err, redis.Nil):
+		d.log.WithContext(ctx).Warnf("failed to get user cache %s, error: %v", key, err)
+	}
+	recordCache(ctx, cache, false)
+
+	return d.loadUser(ctx, key, &key, query)
+}
+
+// loadUser 查询数据库并写回缓存, 同一个 key 的并发查询只执行一次;
+// index 不为空时同时写入索引
+func (d *Data) loadUser(ctx context.Context, key string, index *string, query func(context.Context) (*ent.User, error)) (*biz.User, error) {
+	v, err, _ := d.sf.Do(key, func() (interface{}, error) {
+		// 共享的查询不受第一个调用方取消的影响
+		ctx := context.WithoutCancel(ctx)
+		u, err := query(ctx)
+		if ent.IsNotFound(err) {
+			d.setCache(ctx, map[string]string{key: userCacheMiss}, userCacheMissTTL)
+			return []byte(userCacheMiss), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		data, err := json.Marshal(toBizUser(u))
+		if err != nil {
+			return nil, err
+		}
+		values := map[string]string{userKey(u.UserID): string(data)}
+		if index != nil {
+			values[*index] = strconv.FormatInt(u.UserID, 10)
+		}
+		d.setCache(ctx, values, userCacheTTL)
+		return data, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 每个调用方解码出自己的副本, 避免共享结果被修改
+	return decodeUser(v.([]byte))
+}
+
+func decodeUser(data []byte) (*biz.User, error) {
+	if string(data) == userCacheMiss {
+		return nil, biz.ErrUserNotFound
+	}
+	u := &biz.User{}
+	if err := json.Unmarshal(data, u); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (d *Data) setCache(ctx context.Context, values map[string]string, ttl time.Duration) {
+	pipe := d.rdb.Pipeline()
+	for key, value := range values {
+		pipe.Set(ctx, key, value, ttl)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		d.log.WithContext(ctx).Warnf("failed to set user cache, error: %v", err)
+	}
+}
+
+// delCache 删除缓存, 失败只记录日志, 缓存会在过期后更新
+func (d *Data) delCache(ctx context.Context, keys ...string) {
+	if len(keys) == 0 {
+		return
+	}
+	if err := d.rdb.Del(ctx, keys...).Err(); err != nil {
+		d.log.WithContext(ctx).Warnf("failed to delete cache %v, error: %v", keys, err)
+	}
 }
 
-func (r *userRepo) GetUserInfo(ctx context.Context, uuid string) (info string, err error) {
-	return r.data.rdb.Get(ctx, userInfoKey(uuid)).String(), nil
+// delUserCache 删除用户和公开资料的缓存
+func (d *Data) delUserCache(ctx context.Context, userIDs ...int64) {
+	keys := make([]string, 0, 2*len(userIDs))
+	for _, id := range userIDs {
+		keys = append(keys, userKey(id), publicUserKey(id))
+	}
+	d.delCache(ctx, keys...)
 }
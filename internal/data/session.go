@@ -0,0 +1,116 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"user-service/internal/biz"
+	"user-service/internal/data/ent"
+	"user-service/internal/data/ent/session"
+	"user-service/internal/data/ent/user"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// sessionRepo 实现会话仓储
+type sessionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSessionRepo 创建新的会话仓储
+func NewSessionRepo(data *Data, logger log.Logger) biz.SessionRepo {
+	return &sessionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Create 创建会话
+func (r *sessionRepo) Create(ctx context.Context, s *biz.Session) error {
+	u, err := r.data.db.User.Query().
+		Where(user.UserID(s.UserID)).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.data.db.Session.Create().
+		SetUser(u).
+		SetSessionID(s.SessionID).
+		SetProvider(s.Provider).
+		SetExpiresAt(s.ExpiresAt).
+		Save(ctx)
+
+	return err
+}
+
+// FindBySessionID 根据会话ID查找
+func (r *sessionRepo) FindBySessionID(ctx context.Context, sessionID string) (*biz.Session, error) {
+	s, err := r.data.db.Session.Query().
+		Where(session.SessionID(sessionID)).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toBizSession(s), nil
+}
+
+// ListByUser 列出用户的会话, 最近的在前
+func (r *sessionRepo) ListByUser(ctx context.Context, userID int64) ([]*biz.Session, error) {
+	rows, err := r.data.db.Session.Query().
+		Where(session.HasUserWith(user.UserID(userID))).
+		WithUser().
+		Order(ent.Desc(session.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*biz.Session, 0, len(rows))
+	for _, row := range rows {
+		sessions = append(sessions, toBizSession(row))
+	}
+	return sessions, nil
+}
+
+// RevokeByUser 撤销用户的全部会话
+func (r *sessionRepo) RevokeByUser(ctx context.Context, userID int64) error {
+	_, err := r.data.db.Session.Update().
+		Where(
+			session.HasUserWith(user.UserID(userID)),
+			session.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+
+	return err
+}
+
+// RevokeByProvider 撤销用户通过某种登录方式创建的会话
+func (r *sessionRepo) RevokeByProvider(ctx context.Context, userID int64, provider string) error {
+	_, err := r.data.db.Session.Update().
+		Where(
+			session.HasUserWith(user.UserID(userID)),
+			session.Provider(provider),
+			session.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+
+	return err
+}
+
+// toBizSession 转换为业务层会话, 需要预加载 user
+func toBizSession(s *ent.Session) *biz.Session {
+	return &biz.Session{
+		UserID:    s.Edges.User.UserID,
+		SessionID: s.SessionID,
+		Provider:  s.Provider,
+		ExpiresAt: s.ExpiresAt,
+		RevokedAt: s.RevokedAt,
+		CreatedAt: s.CreatedAt,
+	}
+}
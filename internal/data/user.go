@@ -2,15 +2,26 @@ package data
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"user-service/internal/biz"
 	"user-service/internal/data/ent"
+	"user-service/internal/data/ent/authprovider"
+	"user-service/internal/data/ent/dataexport"
+	"user-service/internal/data/ent/handlehistory"
+	"user-service/internal/data/ent/predicate"
+	"user-service/internal/data/ent/session"
 	"user-service/internal/data/ent/user"
+	"user-service/internal/data/ent/wechataccount"
 	"user-service/third_party/snowflake"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
 
+// maxCreateRetries 并发创建用户冲突时的最大尝试次数
+const maxCreateRetries = 3
+
 type userRepo struct {
 	data   *Data
 	log    *log.Helper
@@ -26,6 +37,43 @@ func NewUserRepo(data *Data, logger log.Logger, uidGen *snowflake.Node) biz.User
 	}
 }
 
+// toBizUser 转换为业务层用户实体
+func toBizUser(u *ent.User) *biz.User {
+	bu := &biz.User{
+		ID:                  u.ID,
+		UserID:              u.UserID,
+		Name:                u.Name,
+		Email:               stringValue(u.Email),
+		EmailVerified:       u.EmailVerified,
+		Phone:               stringValue(u.Phone),
+		AppleAccountDeleted: u.AppleAccountDeleted,
+		Avatar:              u.Avatar,
+		CreatedAt:           u.CreatedAt,
+		UpdatedAt:           u.UpdatedAt,
+		Birthday:            u.Birthday,
+		Gender:              u.Gender,
+		Locale:              u.Locale,
+		Timezone:            u.Timezone,
+		Country:             u.Country,
+		Bio:                 u.Bio,
+		Metadata:            u.Metadata,
+		Status:              u.Status,
+		StatusReason:        u.StatusReason,
+		StatusChangedBy:     u.StatusChangedBy,
+		StatusChangedAt:     u.StatusChangedAt,
+		StatusExpiresAt:     u.StatusExpiresAt,
+	}
+	if u.MergedInto != nil {
+		bu.MergedInto = *u.MergedInto
+	}
+	if u.Handle != nil {
+		bu.Handle = *u.Handle
+	}
+	bu.HandleChangedAt = u.HandleChangedAt
+	bu.DeletionScheduledAt = u.DeletionScheduledAt
+	return bu
+}
+
 // FindByIDOrigin 根据user_id查找用户, 原始输出
 func (r *userRepo) FindByIDOrigin(ctx context.Context, userID int64) (*ent.User, error) {
 	return r.data.db.User.Query().
@@ -33,164 +81,742 @@ func (r *userRepo) FindByIDOrigin(ctx context.Context, userID int64) (*ent.User,
 		First(ctx)
 }
 
-// FindByID 根据user_id查找用户
+// FindByID 根据user_id查找用户, 优先读缓存
 func (r *userRepo) FindByID(ctx context.Context, userID int64) (*biz.User, error) {
-	u, err := r.data.db.User.Query().
-		Where(user.UserID(userID)).
-		First(ctx)
+	return r.data.cachedUser(ctx, userID)
+}
+
+// FindPublicByIDs 批量查询公开资料, 先读缓存, 未命中的用一次 IN 查询后写回缓存
+func (r *userRepo) FindPublicByIDs(ctx context.Context, userIDs []int64) (map[int64]*biz.User, error) {
+	users := make(map[int64]*biz.User, len(userIDs))
+	if len(userIDs) == 0 {
+		return users, nil
+	}
+
+	keys := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		keys = append(keys, publicUserKey(id))
+	}
+	cached, err := r.data.rdb.MGet(ctx, keys...).Result()
 	if err != nil {
-		return nil, err
+		// 缓存不可用时全部查数据库
+		r.log.WithContext(ctx).Warnf("failed to get public user cache, error: %v", err)
+		cached = nil
+	}
+	missed := make([]int64, 0, len(userIDs))
+	for i, id := range userIDs {
+		if i < len(cached) {
+			if v, ok := cached[i].(string); ok {
+				var pu publicUser
+				if err = json.Unmarshal([]byte(v), &pu); err == nil {
+					users[id] = toBizPublicUser(&pu)
+					continue
+				}
+			}
+		}
+		missed = append(missed, id)
+	}
+	if len(missed) == 0 {
+		return users, nil
 	}
 
-	return &biz.User{
-		ID:        u.ID,
-		UserID:    u.UserID,
-		Name:      u.Name,
-		Email:     u.Email,
-		Phone:     u.Phone,
-		Avatar:    u.Avatar,
-		CreatedAt: u.CreatedAt,
-		UpdatedAt: u.UpdatedAt,
-	}, nil
-}
-
-// FindByPhone 根据手机号查找用户
-func (r *userRepo) FindByPhone(ctx context.Context, phone string) (*biz.User, error) {
-	u, err := r.data.db.User.Query().
-		Where(user.Phone(phone)).
-		First(ctx)
+	rows, err := r.data.db.User.Query().
+		Where(user.UserIDIn(missed...), user.MergedIntoIsNil(), user.DeletionScheduledAtIsNil()).
+		Select(user.FieldUserID, user.FieldName, user.FieldAvatar, user.FieldBio, user.FieldHandle).
+		All(ctx)
 	if err != nil {
 		return nil, err
 	}
+	pipe := r.data.rdb.Pipeline()
+	for _, row := range rows {
+		pu := &publicUser{
+			UserID: row.UserID,
+			Name:   row.Name,
+			Avatar: row.Avatar,
+			Bio:    row.Bio,
+		}
+		if row.Handle != nil {
+			pu.Handle = *row.Handle
+		}
+		users[row.UserID] = toBizPublicUser(pu)
+		if data, err := json.Marshal(pu); err == nil {
+			pipe.Set(ctx, publicUserKey(row.UserID), data, publicUserTTL)
+		}
+	}
+	if _, err = pipe.Exec(ctx); err != nil {
+		r.log.WithContext(ctx).Warnf("failed to set public user cache, error: %v", err)
+	}
+	return users, nil
+}
 
+func toBizPublicUser(pu *publicUser) *biz.User {
 	return &biz.User{
-		ID:        u.ID,
-		UserID:    u.UserID,
-		Name:      u.Name,
-		Email:     u.Email,
-		Phone:     u.Phone,
-		Avatar:    u.Avatar,
-		CreatedAt: u.CreatedAt,
-		UpdatedAt: u.UpdatedAt,
-	}, nil
+		UserID: pu.UserID,
+		Name:   pu.Name,
+		Avatar: pu.Avatar,
+		Bio:    pu.Bio,
+		Handle: pu.Handle,
+	}
+}
+
+// FindByPhone 根据手机号查找用户, 优先读缓存
+func (r *userRepo) FindByPhone(ctx context.Context, phone string) (*biz.User, error) {
+	valid := func(u *biz.User) bool {
+		return u.Phone == phone && u.MergedInto == 0
+	}
+	return r.data.cachedUserBy(ctx, cacheUserPhone, userPhoneKey(phone), valid, func(ctx context.Context) (*ent.User, error) {
+		return r.data.db.User.Query().
+			Where(user.Phone(phone), user.MergedIntoIsNil()).
+			First(ctx)
+	})
 }
 
 // FindByEmail 根据邮箱查找用户
 func (r *userRepo) FindByEmail(ctx context.Context, email string) (*biz.User, error) {
 	u, err := r.data.db.User.Query().
-		Where(user.Email(email)).
+		Where(user.Email(email), user.MergedIntoIsNil()).
 		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, biz.ErrUserNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	return &biz.User{
-		ID:        u.ID,
-		UserID:    u.UserID,
-		Name:      u.Name,
-		Email:     u.Email,
-		Phone:     u.Phone,
-		Avatar:    u.Avatar,
-		CreatedAt: u.CreatedAt,
-		UpdatedAt: u.UpdatedAt,
-	}, nil
+	return toBizUser(u), nil
 }
 
 // Create 创建用户
 func (r *userRepo) Create(ctx context.Context, u *biz.User) (*biz.User, error) {
-	created, err := r.data.db.User.Create().
+	created, err := r.newUser(r.data.db.User, u).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r.delCreatedCache(ctx, created)
+
+	return toBizUser(created), nil
+}
+
+// newUser 生成新用户的 user_id 并设置资料
+func (r *userRepo) newUser(client *ent.UserClient, u *biz.User) *ent.UserCreate {
+	return client.Create().
 		SetUserID(r.uidGen.Generate().Int64()).
 		SetName(u.Name).
-		SetEmail(u.Email).
-		SetPhone(u.Phone).
-		SetAvatar(u.Avatar).
-		Save(ctx)
+		SetNillableEmail(nullString(u.Email)).
+		SetEmailVerified(u.EmailVerified).
+		SetNillablePhone(nullString(u.Phone)).
+		SetAvatar(u.Avatar)
+}
+
+// nullString 邮箱、手机号等唯一字段为空时保存为 NULL
+func nullString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// stringValue NULL 读取为空字符串
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// delCreatedCache 清除之前缓存的不存在结果
+func (r *userRepo) delCreatedCache(ctx context.Context, created *ent.User) {
+	r.data.delUserCache(ctx, created.UserID)
+	if created.Phone != nil {
+		r.data.delCache(ctx, userPhoneKey(*created.Phone))
+	}
+}
+
+// FindOrCreateByProvider 在事务中查找登录方式关联的用户, 不存在时创建用户和关联;
+// 并发登录导致唯一索引冲突时重试, 重试时能查到先提交的用户
+func (r *userRepo) FindOrCreateByProvider(ctx context.Context, login *biz.ProviderLogin, u *biz.User) (*biz.User, bool, error) {
+	var err error
+	for i := 0; i < maxCreateRetries; i++ {
+		var found *ent.User
+		var created bool
+		found, created, err = r.findOrCreateByProvider(ctx, login, u)
+		if err == nil {
+			if created {
+				r.delCreatedCache(ctx, found)
+				r.data.delCache(ctx, userProviderKey(login.ProviderType, login.ProviderID))
+			}
+			return toBizUser(found), created, nil
+		}
+		if !ent.IsConstraintError(err) {
+			return nil, false, err
+		}
+		r.log.WithContext(ctx).Warnf("conflict creating user for %s %s, retrying: %v", login.ProviderType, login.ProviderID, err)
+	}
+	return nil, false, err
+}
+
+func (r *userRepo) findOrCreateByProvider(ctx context.Context, login *biz.ProviderLogin, u *biz.User) (*ent.User, bool, error) {
+	tx, err := r.data.db.Tx(ctx)
 	if err != nil {
-		return nil, err
+		return nil, false, err
+	}
+	found, created, err := r.findOrCreateByProviderTx(ctx, tx, login, u)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, false, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, false, err
 	}
+	return found, created, nil
+}
 
-	return &biz.User{
-		ID:        created.ID,
-		UserID:    created.UserID,
-		Name:      created.Name,
-		Email:     created.Email,
-		Phone:     created.Phone,
-		Avatar:    created.Avatar,
-		CreatedAt: created.CreatedAt,
-		UpdatedAt: created.UpdatedAt,
-	}, nil
+func (r *userRepo) findOrCreateByProviderTx(ctx context.Context, tx *ent.Tx, login *biz.ProviderLogin, u *biz.User) (*ent.User, bool, error) {
+	p, err := tx.AuthProvider.Query().
+		Where(
+			authprovider.ProviderType(login.ProviderType),
+			authprovider.ProviderID(login.ProviderID),
+		).
+		WithUser().
+		Only(ctx)
+	if err == nil {
+		return p.Edges.User, false, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, false, err
+	}
+
+	if u.Email != "" {
+		// 邮箱已被其他用户占用 (分开注册或未匹配到的已有用户) 时新用户不保存邮箱
+		taken, err := tx.User.Query().Where(user.Email(u.Email)).Exist(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		if taken {
+			noEmail := *u
+			noEmail.Email, noEmail.EmailVerified = "", false
+			u = &noEmail
+		}
+	}
+	if u.Phone != "" {
+		// 手机号同样唯一, 已被其他用户绑定时新用户不保存手机号, 否则重试也无法创建
+		taken, err := tx.User.Query().Where(user.Phone(u.Phone)).Exist(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		if taken {
+			noPhone := *u
+			noPhone.Phone = ""
+			u = &noPhone
+		}
+	}
+	created, err := r.newUser(tx.User, u).Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	_, err = tx.AuthProvider.Create().
+		SetProviderType(login.ProviderType).
+		SetProviderID(login.ProviderID).
+		SetUser(created).
+		Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if w := login.Wechat; w != nil {
+		_, err = tx.WechatAccount.Create().
+			SetUID(created.ID).
+			SetAppID(w.AppID).
+			SetOpenID(w.OpenID).
+			SetUnionID(w.UnionID).
+			Save(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	return created, true, nil
 }
 
 // Update 更新用户
 func (r *userRepo) Update(ctx context.Context, u *biz.User) (*biz.User, error) {
-	updated, err := r.data.db.User.UpdateOneID(u.ID).
+	tx, err := r.data.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 手机号变更时旧号码的缓存也要删除, 否则旧号码仍能查到该用户
+	current, err := tx.User.Query().
+		Where(user.ID(u.ID)).
+		Select(user.FieldPhone).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, biz.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	update := tx.User.UpdateOneID(u.ID).
 		SetName(u.Name).
-		SetEmail(u.Email).
-		SetPhone(u.Phone).
-		SetAvatar(u.Avatar).
+		SetEmailVerified(u.EmailVerified).
+		SetAvatar(u.Avatar)
+	if u.Email == "" {
+		update.ClearEmail()
+	} else {
+		update.SetEmail(u.Email)
+	}
+	if u.Phone == "" {
+		update.ClearPhone()
+	} else {
+		update.SetPhone(u.Phone)
+	}
+	updated, err := update.Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsConstraintError(err) {
+			// 邮箱或手机号已被其他用户使用
+			return nil, biz.ErrAccountExists
+		}
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	r.data.delUserCache(ctx, updated.UserID)
+	var keys []string
+	for _, phone := range []*string{current.Phone, updated.Phone} {
+		if phone != nil {
+			keys = append(keys, userPhoneKey(*phone))
+		}
+	}
+	r.data.delCache(ctx, keys...)
+
+	return toBizUser(updated), nil
+}
+
+// UpdateProfile 只更新设置了的字段, 避免部分更新时覆盖其他字段
+func (r *userRepo) UpdateProfile(ctx context.Context, userID int64, p *biz.ProfileUpdate) (*biz.User, error) {
+	update := r.data.db.User.Update().
+		Where(user.UserID(userID))
+	if p.Name != nil {
+		update.SetName(*p.Name)
+	}
+	if p.Birthday != nil {
+		if p.Birthday.IsZero() {
+			update.ClearBirthday()
+		} else {
+			update.SetBirthday(*p.Birthday)
+		}
+	}
+	if p.Gender != nil {
+		update.SetGender(*p.Gender)
+	}
+	if p.Locale != nil {
+		update.SetLocale(*p.Locale)
+	}
+	if p.Timezone != nil {
+		update.SetTimezone(*p.Timezone)
+	}
+	if p.Country != nil {
+		update.SetCountry(*p.Country)
+	}
+	if p.Bio != nil {
+		update.SetBio(*p.Bio)
+	}
+	if p.Metadata != nil {
+		if len(p.Metadata) == 0 {
+			update.ClearMetadata()
+		} else {
+			update.SetMetadata(p.Metadata)
+		}
+	}
+	n, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, biz.ErrUserNotFound
+	}
+	r.data.delUserCache(ctx, userID)
+
+	return r.FindByID(ctx, userID)
+}
+
+// UpdateAvatar 只更新用户头像, 避免覆盖并发修改的其他字段
+func (r *userRepo) UpdateAvatar(ctx context.Context, userID int64, avatar string) error {
+	n, err := r.data.db.User.Update().
+		Where(user.UserID(userID)).
+		SetAvatar(avatar).
 		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return biz.ErrUserNotFound
+	}
+	r.data.delUserCache(ctx, userID)
+	return nil
+}
+
+// UpdateStatus 修改账号状态
+func (r *userRepo) UpdateStatus(ctx context.Context, userID int64, s *biz.StatusUpdate) (*biz.User, error) {
+	update := r.data.db.User.Update().
+		Where(user.UserID(userID)).
+		SetStatus(s.Status).
+		SetStatusReason(s.Reason).
+		SetStatusChangedBy(s.ChangedBy).
+		SetStatusChangedAt(time.Now())
+	if s.ExpiresAt != nil {
+		update.SetStatusExpiresAt(*s.ExpiresAt)
+	} else {
+		update.ClearStatusExpiresAt()
+	}
+	n, err := update.Save(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if n == 0 {
+		return nil, biz.ErrUserNotFound
+	}
+	r.data.delUserCache(ctx, userID)
 
-	return &biz.User{
-		ID:        updated.ID,
-		UserID:    updated.UserID,
-		Name:      updated.Name,
-		Email:     updated.Email,
-		Phone:     updated.Phone,
-		Avatar:    updated.Avatar,
-		CreatedAt: updated.CreatedAt,
-		UpdatedAt: updated.UpdatedAt,
-	}, nil
+	return r.FindByID(ctx, userID)
 }
 
-// FindOrCreate 查找或创建用户
-func (r *userRepo) FindOrCreate(ctx context.Context, u *biz.User) (*biz.User, error) {
-	// 尝试根据邮箱查找用户
-	found, err := r.data.db.User.Query().
-		Where(user.Email(u.Email)).
-		First(ctx)
-	if err == nil {
-		// 用户已存在，返回找到的用户
-		return &biz.User{
-			ID:        found.ID,
-			UserID:    found.UserID,
-			Name:      found.Name,
-			Email:     found.Email,
-			Phone:     found.Phone,
-			Avatar:    found.Avatar,
-			CreatedAt: found.CreatedAt,
-			UpdatedAt: found.UpdatedAt,
-		}, nil
+// Search 按条件搜索用户, 用 (排序字段, id) 做游标分页
+func (r *userRepo) Search(ctx context.Context, f *biz.UserFilter) ([]*biz.User, error) {
+	query := r.data.db.User.Query()
+	if f.Phone != "" {
+		query.Where(user.Phone(f.Phone))
+	}
+	if f.Email != "" {
+		query.Where(user.Email(f.Email))
+	}
+	if f.ProviderType != "" {
+		providers := []predicate.AuthProvider{authprovider.ProviderType(f.ProviderType)}
+		if f.ProviderID != "" {
+			providers = append(providers, authprovider.ProviderID(f.ProviderID))
+		}
+		query.Where(user.HasAuthProvidersWith(providers...))
+	}
+	if f.CreatedFrom != nil {
+		query.Where(user.CreatedAtGTE(*f.CreatedFrom))
+	}
+	if f.CreatedTo != nil {
+		query.Where(user.CreatedAtLT(*f.CreatedTo))
+	}
+	if f.Status != "" {
+		query.Where(user.Status(f.Status))
+	}
+	if f.NamePrefix != "" {
+		query.Where(user.NameHasPrefix(f.NamePrefix))
+	}
+	if !f.IncludeMerged {
+		query.Where(user.MergedIntoIsNil())
+	}
+	if f.After != nil {
+		query.Where(userAfter(f))
+	}
+
+	order := ent.Asc
+	if f.Desc {
+		order = ent.Desc
+	}
+	switch f.OrderBy {
+	case biz.UserOrderUserID:
+		query.Order(order(user.FieldUserID))
+	case biz.UserOrderName:
+		query.Order(order(user.FieldName), order(user.FieldID))
+	default:
+		query.Order(order(user.FieldCreatedAt), order(user.FieldID))
+	}
+
+	users, err := query.Limit(f.Limit).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*biz.User, 0, len(users))
+	for _, u := range users {
+		result = append(result, toBizUser(u))
+	}
+	return result, nil
+}
+
+// userAfter 排在游标之后的用户, user_id 唯一, 其他字段相同时再比较 id
+func userAfter(f *biz.UserFilter) predicate.User {
+	a := f.After
+	switch f.OrderBy {
+	case biz.UserOrderUserID:
+		if f.Desc {
+			return user.UserIDLT(a.UserID)
+		}
+		return user.UserIDGT(a.UserID)
+	case biz.UserOrderName:
+		if f.Desc {
+			return user.Or(user.NameLT(a.Name), user.And(user.NameEQ(a.Name), user.IDLT(a.ID)))
+		}
+		return user.Or(user.NameGT(a.Name), user.And(user.NameEQ(a.Name), user.IDGT(a.ID)))
+	default:
+		if f.Desc {
+			return user.Or(user.CreatedAtLT(a.CreatedAt), user.And(user.CreatedAtEQ(a.CreatedAt), user.IDLT(a.ID)))
+		}
+		return user.Or(user.CreatedAtGT(a.CreatedAt), user.And(user.CreatedAtEQ(a.CreatedAt), user.IDGT(a.ID)))
+	}
+}
+
+// SetAppleAccountDeleted 标记或清除用户的 Apple ID 已注销
+func (r *userRepo) SetAppleAccountDeleted(ctx context.Context, userID int64, deleted bool) error {
+	_, err := r.data.db.User.Update().
+		Where(user.UserID(userID)).
+		SetAppleAccountDeleted(deleted).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	r.data.delUserCache(ctx, userID)
+
+	return nil
+}
+
+// Merge 把 from 用户的登录方式、会话和缺失的资料转移到 to 用户, from 标记为已合并
+func (r *userRepo) Merge(ctx context.Context, fromUserID, toUserID int64) error {
+	tx, err := r.data.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	keys, err := merge(ctx, tx, fromUserID, toUserID)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	r.data.delUserCache(ctx, fromUserID, toUserID)
+	r.data.delCache(ctx, keys...)
+	return nil
+}
+
+// merge 返回需要清除的登录方式缓存
+func merge(ctx context.Context, tx *ent.Tx, fromUserID, toUserID int64) ([]string, error) {
+	from, err := tx.User.Query().Where(user.UserID(fromUserID)).ForUpdate().Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	to, err := tx.User.Query().Where(user.UserID(toUserID)).ForUpdate().Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if from.MergedInto != nil || to.MergedInto != nil {
+		return nil, biz.ErrMergeNotAllowed
+	}
+	keys, err := mergeProviders(ctx, tx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	// 转移登录方式和会话
+	if _, err = tx.AuthProvider.Update().Where(authprovider.UID(from.ID)).SetUID(to.ID).Save(ctx); err != nil {
+		return nil, err
 	}
+	if _, err = tx.WechatAccount.Update().Where(wechataccount.UID(from.ID)).SetUID(to.ID).Save(ctx); err != nil {
+		return nil, err
+	}
+	if _, err = tx.Session.Update().Where(session.UID(from.ID)).SetUID(to.ID).Save(ctx); err != nil {
+		return nil, err
+	}
+	// 旧 handle 的保留期归属目标账号
+	if _, err = tx.HandleHistory.Update().Where(handlehistory.UID(from.ID)).SetUID(to.ID).Save(ctx); err != nil {
+		return nil, err
+	}
+
+	// 保留账号已有的资料, 只补充空缺的字段; 邮箱、手机号和 handle 唯一, 且查询时忽略已合并的账号,
+	// 无论是否转移都从 from 上移除, 否则这些值既查不到也无法再被使用
+	fromUpdate := tx.User.UpdateOne(from).SetMergedInto(to.UserID)
+	toUpdate := tx.User.UpdateOne(to)
+	if from.Email != nil {
+		fromUpdate.ClearEmail().SetEmailVerified(false)
+		if to.Email == nil {
+			toUpdate.SetEmail(*from.Email).SetEmailVerified(from.EmailVerified)
+		}
+	}
+	if from.Phone != nil {
+		fromUpdate.ClearPhone()
+		keys = append(keys, userPhoneKey(*from.Phone))
+		if to.Phone == nil {
+			toUpdate.SetPhone(*from.Phone)
+		}
+	}
+	if to.Name == "" && from.Name != "" {
+		toUpdate.SetName(from.Name)
+	}
+	if to.Avatar == "" && from.Avatar != "" {
+		toUpdate.SetAvatar(from.Avatar)
+	}
+	// handle 唯一, 目标账号没有时转移过去, 否则释放
+	if from.Handle != nil {
+		fromUpdate.ClearHandle().ClearHandleLower().ClearHandleChangedAt()
+		if to.Handle == nil {
+			toUpdate.SetHandle(*from.Handle).SetHandleLower(*from.HandleLower).SetHandleChangedAt(time.Now())
+		}
+	}
+	if _, err = fromUpdate.Save(ctx); err != nil {
+		return nil, err
+	}
+	if _, err = toUpdate.Save(ctx); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
 
-	// 如果用户不存在，创建新用户
-	return r.Create(ctx, u)
+// mergeProviders 检查两个账号的登录方式能否合并, 并删除双方的游客密钥;
+// 每种登录方式只能关联一个, 两个账号有同类登录方式时不能合并
+func mergeProviders(ctx context.Context, tx *ent.Tx, from, to *ent.User) ([]string, error) {
+	providers, err := tx.AuthProvider.Query().Where(authprovider.UIDIn(from.ID, to.ID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	var guests []int64
+	seen := make(map[string]bool)
+	for _, p := range providers {
+		keys = append(keys, userProviderKey(p.ProviderType, p.ProviderID))
+		if p.ProviderType == biz.GuestProvider {
+			guests = append(guests, p.ID)
+			continue
+		}
+		if seen[p.ProviderType] {
+			return nil, biz.ErrMergeNotAllowed
+		}
+		seen[p.ProviderType] = true
+	}
+	// 合并后是正式账号, 不再能用设备密钥登录; 两个游客账号没有可保留的登录方式
+	if len(seen) == 0 && from.Phone == nil && to.Phone == nil {
+		return nil, biz.ErrMergeNotAllowed
+	}
+	if len(guests) > 0 {
+		if _, err = tx.AuthProvider.Delete().Where(authprovider.IDIn(guests...)).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+// DeleteInactiveGuests 删除 inactiveSince 之后没有登录过的游客账号, 最多 limit 个
+func (r *userRepo) DeleteInactiveGuests(ctx context.Context, inactiveSince time.Time, limit int) (int, error) {
+	candidates, err := r.data.db.User.Query().
+		Where(inactiveGuest(inactiveSince)).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil || len(candidates) == 0 {
+		return 0, err
+	}
+
+	tx, err := r.data.db.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	// 查询后可能已关联其他登录方式或重新登录, 锁定后重新检查
+	guests, err := tx.User.Query().
+		Where(user.IDIn(candidates...), inactiveGuest(inactiveSince)).
+		ForUpdate().
+		Select(user.FieldID, user.FieldUserID).
+		All(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+	if len(guests) == 0 {
+		_ = tx.Rollback()
+		return 0, nil
+	}
+	ids := make([]int64, 0, len(guests))
+	userIDs := make([]int64, 0, len(guests))
+	for _, g := range guests {
+		ids = append(ids, g.ID)
+		userIDs = append(userIDs, g.UserID)
+	}
+	n, err := deleteUsers(ctx, tx, ids)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+	r.data.delUserCache(ctx, userIDs...)
+	return n, nil
+}
+
+// inactiveGuest 只有游客密钥、没有手机号和邮箱, 且 inactiveSince 之后没有登录过的账号;
+// 关联过正式登录方式的账号即使还留有游客密钥也不删除
+func inactiveGuest(inactiveSince time.Time) predicate.User {
+	return user.And(
+		user.HasAuthProvidersWith(authprovider.ProviderType(biz.GuestProvider)),
+		user.Not(user.HasAuthProvidersWith(authprovider.ProviderTypeNEQ(biz.GuestProvider))),
+		user.PhoneIsNil(),
+		user.EmailIsNil(),
+		user.CreatedAtLT(inactiveSince),
+		user.Not(user.HasSessionsWith(session.CreatedAtGTE(inactiveSince))),
+	)
+}
+
+// deleteUsers 删除用户及其关联数据
+func deleteUsers(ctx context.Context, tx *ent.Tx, ids []int64) (int, error) {
+	if _, err := tx.Session.Delete().Where(session.UIDIn(ids...)).Exec(ctx); err != nil {
+		return 0, err
+	}
+	if _, err := tx.AuthProvider.Delete().Where(authprovider.UIDIn(ids...)).Exec(ctx); err != nil {
+		return 0, err
+	}
+	if _, err := tx.WechatAccount.Delete().Where(wechataccount.UIDIn(ids...)).Exec(ctx); err != nil {
+		return 0, err
+	}
+	if _, err := tx.HandleHistory.Delete().Where(handlehistory.UIDIn(ids...)).Exec(ctx); err != nil {
+		return 0, err
+	}
+	if _, err := tx.DataExport.Delete().Where(dataexport.UIDIn(ids...)).Exec(ctx); err != nil {
+		return 0, err
+	}
+	return tx.User.Delete().Where(user.IDIn(ids...)).Exec(ctx)
+}
+
+// FindOrCreate 查找或创建用户
+func (r *userRepo) FindOrCreate(ctx context.Context, u *biz.User) (*biz.User, error) {
+	found, _, err := r.findOrCreate(ctx, user.Email(u.Email), u)
+	return found, err
 }
 
 // FindOrCreateByPhone 根据Phone 查找或创建用户
 func (r *userRepo) FindOrCreateByPhone(ctx context.Context, phone string) (*biz.User, bool, error) {
-	// 尝试根据邮箱查找用户
-	found, err := r.data.db.User.Query().
-		Where(user.Phone(phone)).
-		First(ctx)
-	if err == nil {
-		// 用户已存在，返回找到的用户
-		return &biz.User{
-			ID:        found.ID,
-			UserID:    found.UserID,
-			Name:      found.Name,
-			Email:     found.Email,
-			Phone:     found.Phone,
-			Avatar:    found.Avatar,
-			CreatedAt: found.CreatedAt,
-			UpdatedAt: found.UpdatedAt,
-		}, false, nil
-	}
-
-	// 如果用户不存在，创建新用户
-	u := &biz.User{Phone: phone}
-	userInfo, errCre := r.Create(ctx, u)
-	return userInfo, true, errCre
+	return r.findOrCreate(ctx, user.Phone(phone), &biz.User{Phone: phone})
+}
+
+// findOrCreate 按唯一字段查找未合并的用户, 不存在时创建;
+// 并发创建导致唯一索引冲突时重新查找
+func (r *userRepo) findOrCreate(ctx context.Context, where predicate.User, u *biz.User) (*biz.User, bool, error) {
+	var err error
+	for i := 0; i < maxCreateRetries; i++ {
+		var found *ent.User
+		found, err = r.data.db.User.Query().
+			Where(where, user.MergedIntoIsNil()).
+			Only(ctx)
+		if err == nil {
+			return toBizUser(found), false, nil
+		}
+		if !ent.IsNotFound(err) {
+			return nil, false, err
+		}
+
+		var created *biz.User
+		if created, err = r.Create(ctx, u); err == nil {
+			return created, true, nil
+		}
+		if !ent.IsConstraintError(err) {
+			return nil, false, err
+		}
+		r.log.WithContext(ctx).Warnf("conflict creating user, retrying: %v", err)
+	}
+	return nil, false, err
 }
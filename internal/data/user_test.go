@@ -0,0 +1,120 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"user-service/internal/biz"
+	"user-service/internal/data/ent"
+)
+
+func newTestUserRepo(td *testData) *userRepo {
+	return NewUserRepo(td.Data, td.logger, td.uidGen).(*userRepo)
+}
+
+func TestFindOrCreateByProviderPhoneTaken(t *testing.T) {
+	td := newTestData(t)
+	repo := newTestUserRepo(td)
+	ctx := context.Background()
+
+	existing, err := repo.Create(ctx, &biz.User{Name: "existing", Phone: "+8613800138000", Email: "a@example.com"})
+	if err != nil {
+		t.Fatalf("error creating user, %s", err)
+	}
+
+	// 第三方返回的邮箱和手机号都已被其他用户使用, 新用户两者都不保存
+	login := &biz.ProviderLogin{ProviderType: "firebase", ProviderID: "uid-1"}
+	u, created, err := repo.FindOrCreateByProvider(ctx, login, &biz.User{Name: "new", Phone: existing.Phone, Email: existing.Email})
+	if err != nil {
+		t.Fatalf("error creating user, %s", err)
+	}
+	if !created || u.UserID == existing.UserID {
+		t.Fatalf("expected a new user, got %+v created=%v", u, created)
+	}
+	if u.Phone != "" || u.Email != "" {
+		t.Fatalf("expected taken phone and email to be dropped, got %q %q", u.Phone, u.Email)
+	}
+}
+
+func TestFindOrCreateByProviderRetry(t *testing.T) {
+	td := newTestData(t)
+	repo := newTestUserRepo(td)
+	ctx := context.Background()
+	login := &biz.ProviderLogin{ProviderType: "google", ProviderID: "sub-1"}
+
+	// 第一次创建时另一个请求已先关联同一个登录方式: 本次冲突, 重试前对方已提交
+	var winner *ent.User
+	conflicts := 0
+	td.db.AuthProvider.Use(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if !m.Op().Is(ent.OpCreate) || conflicts > 0 {
+				return next.Mutate(ctx, m)
+			}
+			conflicts++
+			td.drv.onNextTx(func() {
+				var err error
+				winner, err = repo.newUser(td.db.User, &biz.User{Name: "winner"}).Save(ctx)
+				if err != nil {
+					t.Errorf("error creating winner, %s", err)
+					return
+				}
+				err = td.db.AuthProvider.Create().
+					SetProviderType(login.ProviderType).
+					SetProviderID(login.ProviderID).
+					SetUser(winner).
+					Exec(ctx)
+				if err != nil {
+					t.Errorf("error linking winner, %s", err)
+				}
+			})
+			return nil, &ent.ConstraintError{}
+		})
+	})
+
+	u, created, err := repo.FindOrCreateByProvider(ctx, login, &biz.User{Name: "loser"})
+	if err != nil {
+		t.Fatalf("expected retry to succeed, got %v", err)
+	}
+	if conflicts != 1 || winner == nil {
+		t.Fatalf("expected one conflict, got %d", conflicts)
+	}
+	if created || u.UserID != winner.UserID {
+		t.Fatalf("expected the committed user %d, got %d created=%v", winner.UserID, u.UserID, created)
+	}
+	if n := td.db.User.Query().CountX(ctx); n != 1 {
+		t.Fatalf("expected the failed attempt to be rolled back, got %d users", n)
+	}
+}
+
+func TestUpdatePhoneDeletesPreviousPhoneCache(t *testing.T) {
+	td := newTestData(t)
+	repo := newTestUserRepo(td)
+	ctx := context.Background()
+
+	u, err := repo.Create(ctx, &biz.User{Name: "user", Phone: "+8613800000001"})
+	if err != nil {
+		t.Fatalf("error creating user, %s", err)
+	}
+	oldPhone := u.Phone
+	if _, err = repo.FindByPhone(ctx, oldPhone); err != nil {
+		t.Fatalf("error finding user by phone, %s", err)
+	}
+	if !td.redis.has(userPhoneKey(oldPhone)) || !td.redis.has(userKey(u.UserID)) {
+		t.Fatal("expected phone and user lookups to be cached")
+	}
+
+	u.Phone = "+8613800000002"
+	if _, err = repo.Update(ctx, u); err != nil {
+		t.Fatalf("error updating user, %s", err)
+	}
+	if td.redis.has(userPhoneKey(oldPhone)) || td.redis.has(userKey(u.UserID)) {
+		t.Fatal("expected the previous phone and user cache to be deleted")
+	}
+	if _, err = repo.FindByPhone(ctx, oldPhone); err != biz.ErrUserNotFound {
+		t.Fatalf("expected the previous phone to find nobody, got %v", err)
+	}
+	found, err := repo.FindByPhone(ctx, u.Phone)
+	if err != nil || found.UserID != u.UserID {
+		t.Fatalf("expected the new phone to find the user, got %+v %v", found, err)
+	}
+}
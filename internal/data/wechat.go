@@ -0,0 +1,106 @@
+package data
+
+import (
+	"context"
+
+	"user-service/internal/biz"
+	"user-service/internal/data/ent"
+	"user-service/internal/data/ent/authprovider"
+	"user-service/internal/data/ent/user"
+	"user-service/internal/data/ent/wechataccount"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// wechatRepo 实现微信 openid 关联仓储
+type wechatRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewWechatRepo 创建新的微信关联仓储
+func NewWechatRepo(data *Data, logger log.Logger) biz.WechatRepo {
+	return &wechatRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// FindByOpenID 根据应用 appid 和 openid 查找用户
+func (r *wechatRepo) FindByOpenID(ctx context.Context, appID, openID string) (*biz.User, error) {
+	account, err := r.data.db.WechatAccount.Query().
+		Where(
+			wechataccount.AppID(appID),
+			wechataccount.OpenID(openID),
+		).
+		WithUser().
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, biz.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	userInfo := account.Edges.User
+	return toBizUser(userInfo), nil
+}
+
+// Create 记录用户在某个微信应用下的 openid
+func (r *wechatRepo) Create(ctx context.Context, u *biz.User, appID, openID, unionID string) error {
+	_, err := r.data.db.WechatAccount.Create().
+		SetUID(u.ID).
+		SetAppID(appID).
+		SetOpenID(openID).
+		SetUnionID(unionID).
+		Save(ctx)
+	// 同一应用下的 openid 只对应一个微信用户, 并发登录已记录时忽略
+	if ent.IsConstraintError(err) {
+		return nil
+	}
+
+	return err
+}
+
+// BindUnionID 为之前没有 unionid 的 openid 补充 unionid, 并把以 openid 记录的关联改为 unionid
+func (r *wechatRepo) BindUnionID(ctx context.Context, userID int64, appID, openID, unionID string) error {
+	tx, err := r.data.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	n, err := tx.WechatAccount.Update().
+		Where(
+			wechataccount.AppID(appID),
+			wechataccount.OpenID(openID),
+			wechataccount.UnionID(""),
+		).
+		SetUnionID(unionID).
+		Save(ctx)
+	if err != nil || n == 0 {
+		// 已经记录过 unionid
+		_ = tx.Rollback()
+		return err
+	}
+	_, err = tx.AuthProvider.Update().
+		Where(
+			authprovider.ProviderType("wechat"),
+			authprovider.ProviderID(openID),
+			authprovider.HasUserWith(user.UserID(userID)),
+		).
+		SetProviderID(unionID).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		// unionid 已经关联了其他用户, 需要通过合并账号处理
+		if ent.IsConstraintError(err) {
+			return biz.ErrProviderAlreadyLinked
+		}
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	r.data.delCache(ctx, userProviderKey("wechat", openID), userProviderKey("wechat", unionID))
+
+	return nil
+}
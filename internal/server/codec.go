@@ -0,0 +1,48 @@
+package server
+
+import (
+	"io"
+	"mime"
+	nethttp "net/http"
+
+	login "user-service/api/auth/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+const (
+	// maxUploadBytes 上传请求的最大字节数, 图片本身的限制由 biz 层按配置校验
+	maxUploadBytes = 16 << 20
+	// avatarFormField multipart 上传头像的字段名
+	avatarFormField = "file"
+)
+
+// requestDecoder 上传头像时支持 multipart/form-data, 其他请求使用默认解码
+func requestDecoder(r *nethttp.Request, v interface{}) error {
+	req, ok := v.(*login.UploadAvatarRequest)
+	if !ok {
+		return http.DefaultRequestDecoder(r, v)
+	}
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != "multipart/form-data" {
+		return http.DefaultRequestDecoder(r, v)
+	}
+
+	r.Body = nethttp.MaxBytesReader(nil, r.Body, maxUploadBytes)
+	file, _, err := r.FormFile(avatarFormField)
+	if err != nil {
+		return errors.BadRequest("CODEC", err.Error())
+	}
+	defer func() {
+		_ = file.Close()
+		if r.MultipartForm != nil {
+			_ = r.MultipartForm.RemoveAll()
+		}
+	}()
+
+	if req.Image, err = io.ReadAll(file); err != nil {
+		return errors.BadRequest("CODEC", err.Error())
+	}
+	return nil
+}
@@ -1,22 +1,29 @@
 package server
 
 import (
+	adminv1 "user-service/api/admin/v1"
 	login "user-service/api/auth/v1"
 	v1 "user-service/api/helloworld/v1"
+	userv1 "user-service/api/user/v1"
+	"user-service/internal/biz"
 	"user-service/internal/conf"
 	"user-service/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
+	ggrpc "google.golang.org/grpc"
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, user *service.LoginService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, sessionCase *biz.SessionCase, greeter *service.GreeterService, user *service.LoginService, profile *service.UserService, admin *service.AdminService, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
+			authMiddleware(sessionCase),
 		),
+		// 上传头像的请求超过默认的 4MB
+		grpc.Options(ggrpc.MaxRecvMsgSize(maxUploadBytes)),
 	}
 	if c.Grpc.Network != "" {
 		opts = append(opts, grpc.Network(c.Grpc.Network))
@@ -30,5 +37,7 @@ func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, user *servic
 	srv := grpc.NewServer(opts...)
 	v1.RegisterGreeterServer(srv, greeter)
 	login.RegisterAuthServiceServer(srv, user)
+	userv1.RegisterUserServiceServer(srv, profile)
+	adminv1.RegisterAdminServiceServer(srv, admin)
 	return srv
 }
@@ -1,8 +1,11 @@
 package server
 
 import (
+	adminv1 "user-service/api/admin/v1"
 	login "user-service/api/auth/v1"
 	v1 "user-service/api/helloworld/v1"
+	userv1 "user-service/api/user/v1"
+	"user-service/internal/biz"
 	"user-service/internal/conf"
 	"user-service/internal/service"
 
@@ -12,11 +15,13 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, user *service.LoginService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, sessionCase *biz.SessionCase, greeter *service.GreeterService, user *service.LoginService, profile *service.UserService, admin *service.AdminService, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
+			authMiddleware(sessionCase),
 		),
+		http.RequestDecoder(requestDecoder),
 	}
 	if c.Http.Network != "" {
 		opts = append(opts, http.Network(c.Http.Network))
@@ -30,5 +35,9 @@ func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, user *servic
 	srv := http.NewServer(opts...)
 	v1.RegisterGreeterHTTPServer(srv, greeter)
 	login.RegisterAuthServiceHTTPServer(srv, user)
+	userv1.RegisterUserServiceHTTPServer(srv, profile)
+	adminv1.RegisterAdminServiceHTTPServer(srv, admin)
+	// 下载链接自带签名, 不经过登录校验
+	srv.Route("/").GET(biz.ExportDownloadPath, profile.DownloadDataExport)
 	return srv
 }
@@ -0,0 +1,169 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"user-service/internal/biz"
+	"user-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// defaultJobInterval 未配置执行间隔时的默认值
+	defaultJobInterval = time.Hour
+	// guestCleanupBatch 每次清理的游客数量上限
+	guestCleanupBatch = 500
+	// deletionPurgeBatch 每次清除的待注销账号数量上限
+	deletionPurgeBatch = 100
+	// userEventBatch 每次发布的用户事件数量上限
+	userEventBatch = 100
+	// userEventInterval 发布用户事件的间隔
+	userEventInterval = 10 * time.Second
+	// exportBatch 每次处理的数据导出数量上限
+	exportBatch = 10
+	// defaultExportInterval 未配置时处理数据导出的间隔
+	defaultExportInterval = time.Minute
+	// workerRestartInterval 常驻任务异常退出后的重启间隔
+	workerRestartInterval = time.Minute
+)
+
+// job 定时执行的后台任务
+type job struct {
+	name     string
+	interval time.Duration
+	run      func(ctx context.Context) error
+}
+
+// JobServer 以 kratos transport.Server 的形式运行后台定时任务, 随应用启动和停止
+type JobServer struct {
+	jobs []job
+	log  *log.Helper
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewJobServer new a job server.
+func NewJobServer(authCfg *conf.Auth, userCfg *conf.User, userAuthCase *biz.UserAuthCase, avatarCase *biz.AvatarCase, deletionCase *biz.DeletionCase, exportCase *biz.ExportCase, logger log.Logger) *JobServer {
+	s := &JobServer{log: log.NewHelper(logger)}
+
+	// 清理长期未登录的游客账号
+	if ttl := authCfg.GetGuest().GetTtl().AsDuration(); ttl > 0 {
+		s.add("guest_cleanup", authCfg.GetGuest().GetCleanupInterval().AsDuration(), func(ctx context.Context) error {
+			for {
+				n, err := userAuthCase.CleanupGuests(ctx, time.Now().Add(-ttl), guestCleanupBatch)
+				if err != nil || n < guestCleanupBatch {
+					return err
+				}
+			}
+		})
+	}
+
+	// 清除冷静期已结束的注销账号
+	s.add("account_purge", userCfg.GetDeletion().GetPurgeInterval().AsDuration(), func(ctx context.Context) error {
+		for {
+			n, err := deletionCase.Purge(ctx, deletionPurgeBatch)
+			if err != nil || n < deletionPurgeBatch {
+				return err
+			}
+		}
+	})
+
+	// 发布账号已删除等用户事件, 失败的下次重试
+	s.add("user_events", userEventInterval, func(ctx context.Context) error {
+		for {
+			n, err := deletionCase.PublishEvents(ctx, userEventBatch)
+			if err != nil || n < userEventBatch {
+				return err
+			}
+		}
+	})
+
+	// 生成个人数据导出, 并删除到期的导出文件
+	exportInterval := userCfg.GetExport().GetInterval().AsDuration()
+	if exportInterval <= 0 {
+		exportInterval = defaultExportInterval
+	}
+	s.add("data_export", exportInterval, func(ctx context.Context) error {
+		for {
+			n, err := exportCase.Process(ctx, exportBatch)
+			if err != nil {
+				return err
+			}
+			if n < exportBatch {
+				break
+			}
+		}
+		for {
+			n, err := exportCase.Cleanup(ctx, exportBatch)
+			if err != nil || n < exportBatch {
+				return err
+			}
+		}
+	})
+
+	// 转存第三方头像, 常驻消费队列
+	s.add("avatar_ingest", workerRestartInterval, avatarCase.Run)
+	return s
+}
+
+func (s *JobServer) add(name string, interval time.Duration, run func(ctx context.Context) error) {
+	if interval <= 0 {
+		interval = defaultJobInterval
+	}
+	s.jobs = append(s.jobs, job{name: name, interval: interval, run: run})
+}
+
+// Start 启动所有任务, 阻塞直到 Stop
+func (s *JobServer) Start(ctx context.Context) error {
+	s.mu.Lock()
+	ctx, s.cancel = context.WithCancel(ctx)
+	for _, j := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(ctx, j)
+	}
+	s.mu.Unlock()
+	<-ctx.Done()
+	return nil
+}
+
+// Stop 停止任务并等待正在执行的任务结束
+func (s *JobServer) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.mu.Unlock()
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *JobServer) loop(ctx context.Context, j job) {
+	defer s.wg.Done()
+	s.log.Infof("[job] %s started, interval %v", j.name, j.interval)
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+	for {
+		if err := j.run(ctx); err != nil && ctx.Err() == nil {
+			s.log.Errorf("[job] %s failed, error: %v", j.name, err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
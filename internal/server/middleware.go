@@ -0,0 +1,70 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	adminv1 "user-service/api/admin/v1"
+	v1 "user-service/api/auth/v1"
+	userv1 "user-service/api/user/v1"
+	"user-service/internal/biz"
+	"user-service/third_party/jwt"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// authOperations 需要登录后才能调用的接口
+var authOperations = map[string]struct{}{
+	v1.OperationAuthServiceLinkProvider:          {},
+	v1.OperationAuthServiceUnlinkProvider:        {},
+	v1.OperationAuthServiceListLinkedProviders:   {},
+	v1.OperationAuthServiceMergeAccounts:         {},
+	v1.OperationAuthServiceLinkPhone:             {},
+	v1.OperationAuthServiceUploadAvatar:          {},
+	userv1.OperationUserServiceGetMe:             {},
+	userv1.OperationUserServiceGetUser:           {},
+	userv1.OperationUserServiceBatchGetUsers:     {},
+	userv1.OperationUserServiceUpdateProfile:     {},
+	userv1.OperationUserServiceCheckHandle:       {},
+	userv1.OperationUserServiceChangeHandle:      {},
+	userv1.OperationUserServiceGetUserByHandle:   {},
+	userv1.OperationUserServiceDeleteAccount:     {},
+	userv1.OperationUserServiceRequestDataExport: {},
+	userv1.OperationUserServiceGetDataExport:     {},
+	adminv1.OperationAdminServiceGetUserStatus:   {},
+	adminv1.OperationAdminServiceSetUserStatus:   {},
+	adminv1.OperationAdminServiceListUsers:       {},
+}
+
+// Auth 校验 Authorization: Bearer <token>, 并把声明放入 context
+func Auth(sessionCase *biz.SessionCase) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, biz.ErrUnauthenticated
+			}
+			token, found := strings.CutPrefix(tr.RequestHeader().Get("Authorization"), "Bearer ")
+			if !found || token == "" {
+				return nil, biz.ErrUnauthenticated
+			}
+			claims, err := sessionCase.Verify(ctx, token)
+			if err != nil {
+				return nil, err
+			}
+			return handler(jwt.NewContext(ctx, claims), req)
+		}
+	}
+}
+
+// authMiddleware 只对 authOperations 中的接口做登录校验
+func authMiddleware(sessionCase *biz.SessionCase) middleware.Middleware {
+	return selector.Server(Auth(sessionCase)).
+		Match(func(ctx context.Context, operation string) bool {
+			_, ok := authOperations[operation]
+			return ok
+		}).
+		Build()
+}
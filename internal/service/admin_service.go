@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	v1 "user-service/api/admin/v1"
+	"user-service/internal/biz"
+	"user-service/third_party/jwt"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// AdminService 管理接口
+type AdminService struct {
+	v1.UnimplementedAdminServiceServer
+	log       *log.Helper
+	adminCase *biz.AdminCase
+}
+
+func NewAdminService(logger log.Logger, adminCase *biz.AdminCase) *AdminService {
+	return &AdminService{
+		log:       log.NewHelper(logger),
+		adminCase: adminCase,
+	}
+}
+
+// GetUserStatus 查询用户账号状态
+func (s *AdminService) GetUserStatus(ctx context.Context, req *v1.GetUserStatusRequest) (*v1.UserStatus, error) {
+	adminID, ok := jwt.FromContext(ctx)
+	if !ok {
+		return nil, biz.ErrUnauthenticated
+	}
+	u, err := s.adminCase.GetStatus(ctx, adminID, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return toUserStatus(u), nil
+}
+
+// SetUserStatus 修改用户账号状态
+func (s *AdminService) SetUserStatus(ctx context.Context, req *v1.SetUserStatusRequest) (*v1.UserStatus, error) {
+	adminID, ok := jwt.FromContext(ctx)
+	if !ok {
+		return nil, biz.ErrUnauthenticated
+	}
+	update := &biz.StatusUpdate{
+		Status: req.Status,
+		Reason: req.Reason,
+	}
+	if req.ExpiresAt > 0 {
+		expiresAt := time.Unix(req.ExpiresAt, 0)
+		update.ExpiresAt = &expiresAt
+	}
+	u, err := s.adminCase.SetStatus(ctx, adminID, req.UserId, update)
+	if err != nil {
+		return nil, err
+	}
+	return toUserStatus(u), nil
+}
+
+// ListUsers 搜索用户
+func (s *AdminService) ListUsers(ctx context.Context, req *v1.ListUsersRequest) (*v1.ListUsersReply, error) {
+	adminID, ok := jwt.FromContext(ctx)
+	if !ok {
+		return nil, biz.ErrUnauthenticated
+	}
+	filter := &biz.UserFilter{
+		Phone:         req.Phone,
+		Email:         req.Email,
+		ProviderType:  req.Provider,
+		ProviderID:    req.ProviderId,
+		Status:        req.Status,
+		NamePrefix:    req.NamePrefix,
+		IncludeMerged: req.IncludeMerged,
+	}
+	if req.CreatedFrom > 0 {
+		from := time.Unix(req.CreatedFrom, 0)
+		filter.CreatedFrom = &from
+	}
+	if req.CreatedTo > 0 {
+		to := time.Unix(req.CreatedTo, 0)
+		filter.CreatedTo = &to
+	}
+	users, next, err := s.adminCase.ListUsers(ctx, adminID, filter, req.OrderBy, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListUsersReply{
+		Users:         make([]*v1.AdminUser, 0, len(users)),
+		NextPageToken: next,
+	}
+	for _, u := range users {
+		reply.Users = append(reply.Users, toAdminUser(u))
+	}
+	return reply, nil
+}
+
+func toAdminUser(u *biz.AdminUser) *v1.AdminUser {
+	au := &v1.AdminUser{
+		UserId:        u.UserID,
+		Name:          u.Name,
+		Email:         u.Email,
+		EmailVerified: u.EmailVerified,
+		Phone:         u.Phone,
+		Avatar:        u.Avatar,
+		Handle:        u.Handle,
+		Status:        toUserStatus(u.User),
+		MergedInto:    u.MergedInto,
+		CreatedAt:     u.CreatedAt.Unix(),
+		UpdatedAt:     u.UpdatedAt.Unix(),
+		Providers:     make([]*v1.UserProvider, 0, len(u.Providers)),
+	}
+	if u.DeletionScheduledAt != nil {
+		au.DeletionScheduledAt = u.DeletionScheduledAt.Unix()
+	}
+	for _, p := range u.Providers {
+		au.Providers = append(au.Providers, &v1.UserProvider{
+			Provider:   p.ProviderType,
+			ProviderId: p.ProviderID,
+			Email:      p.Email,
+			Name:       p.Name,
+			CreatedAt:  p.CreatedAt.Unix(),
+		})
+	}
+	return au
+}
+
+func toUserStatus(u *biz.User) *v1.UserStatus {
+	status := &v1.UserStatus{
+		UserId:    u.UserID,
+		Status:    u.Status,
+		Reason:    u.StatusReason,
+		ChangedBy: u.StatusChangedBy,
+	}
+	if u.StatusExpiresAt != nil {
+		status.ExpiresAt = u.StatusExpiresAt.Unix()
+	}
+	if u.StatusChangedAt != nil {
+		status.ChangedAt = u.StatusChangedAt.Unix()
+	}
+	return status
+}
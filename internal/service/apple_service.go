@@ -2,15 +2,10 @@ package service
 
 import (
 	"context"
-	"crypto/rsa"
-	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
-	"math/big"
 	"net/http"
-	"strings"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -18,34 +13,75 @@ import (
 	v1 "user-service/api/auth/v1"
 	"user-service/internal/biz"
 	"user-service/internal/conf"
-	"user-service/third_party/jwt"
+	"user-service/third_party/apple"
+	"user-service/third_party/oidc"
 )
 
 type AppleService struct {
-	cfg        *conf.Jwt
-	log        *log.Helper
-	authCase   *biz.UserAuthCase
-	jwtGen     *jwt.Generator
-	httpClient *http.Client
-	publicKeys map[string]*rsa.PublicKey
+	cfg         *conf.Jwt
+	log         *log.Helper
+	authCase    *biz.UserAuthCase
+	notifyCase  *biz.AppleNotificationCase
+	sessionCase *biz.SessionCase
+	httpClient  *http.Client
+	verifier    *oidc.Verifier
+	client      *apple.Client
 }
 
-func NewAppleService(cfg *conf.Jwt, logger log.Logger, authCase *biz.UserAuthCase) *AppleService {
-	return &AppleService{
-		cfg:        cfg,
-		log:        log.NewHelper(logger),
-		authCase:   authCase,
-		jwtGen:     jwt.NewGenerator(cfg.Secret, int(cfg.Expires)),
-		httpClient: &http.Client{Timeout: 10 * time.Second},
-		publicKeys: make(map[string]*rsa.PublicKey),
+func NewAppleService(cfg *conf.Jwt, authCfg *conf.Auth, dataCfg *conf.Data, logger log.Logger, authCase *biz.UserAuthCase, notifyCase *biz.AppleNotificationCase, sessionCase *biz.SessionCase) (*AppleService, error) {
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+
+	// 未配置 client_id 时拒绝所有 id_token
+	var clientIDs []string
+	if id := authCfg.GetApple().GetClientId(); id != "" {
+		clientIDs = append(clientIDs, id)
+	}
+
+	// 配置了签名私钥时才能换取和撤销 refresh_token
+	var client *apple.Client
+	appleCfg := authCfg.GetApple()
+	if appleCfg.GetPrivateKeyPath() != "" {
+		key, err := apple.LoadPrivateKey(appleCfg.GetPrivateKeyPath())
+		if err != nil {
+			log.NewHelper(logger).Warnf("failed to load apple private key, error: %v", err)
+		} else {
+			client = apple.NewClient(apple.Config{
+				TeamID:     appleCfg.GetTeamId(),
+				ClientID:   appleCfg.GetClientId(),
+				KeyID:      appleCfg.GetKeyId(),
+				PrivateKey: key,
+			}, httpClient)
+		}
+	}
+	// refresh_token 加密保存, 没有密钥时注销账号无法撤销 Apple 授权
+	if client != nil && dataCfg.GetCrypto().GetKey() == "" {
+		return nil, errors.New("apple private_key_path is configured but data.crypto.key is empty, apple refresh tokens cannot be stored")
 	}
+
+	return &AppleService{
+		cfg:         cfg,
+		log:         log.NewHelper(logger),
+		authCase:    authCase,
+		notifyCase:  notifyCase,
+		sessionCase: sessionCase,
+		httpClient:  httpClient,
+		verifier: oidc.NewVerifier(oidc.Config{
+			Issuer:    "https://appleid.apple.com",
+			ClientIDs: clientIDs,
+			JWKSURL:   "https://appleid.apple.com/auth/keys",
+		}, httpClient),
+		client: client,
+	}, nil
 }
 
 // AppleClaims JWT 声明结构
 type AppleClaims struct {
 	jwtv4.RegisteredClaims        // 使用别名
 	Email                  string `json:"email"`
+	EmailVerified          bool   `json:"email_verified"`
 	Sub                    string `json:"sub"` // Apple 用户唯一标识符
+	// Raw id_token 原始声明
+	Raw map[string]interface{} `json:"-"`
 }
 
 func (s *AppleService) Login(ctx context.Context, req *v1.LoginWithAppleRequest) (*v1.LoginResponse, error) {
@@ -58,20 +94,25 @@ func (s *AppleService) Login(ctx context.Context, req *v1.LoginWithAppleRequest)
 	}
 
 	// 解析并验证 id_token
-	claims, err := s.verifyIdToken(ctx, req.IdToken, req.Nonce)
+	identity, err := s.Authenticate(ctx, &biz.Credential{IDToken: req.IdToken, Nonce: req.Nonce})
 	if err != nil {
 		return nil, fmt.Errorf("failed to verify apple id_token: %w", err)
 	}
 
 	// 查找或创建用户
-	u, isNew, err := s.authCase.FindOrCreateByAppleID(ctx, claims.Sub, claims.Email)
+	u, isNew, err := s.authCase.FindOrCreateByIdentity(ctx, identity)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to find or create user: %w", err)
 	}
 
+	// 换取 refresh_token, 失败不影响登录
+	if req.AuthorizationCode != "" {
+		s.saveRefreshToken(ctx, identity.Subject, req.AuthorizationCode)
+	}
+
 	// 生成 JWT token
-	token, err := s.jwtGen.GenerateToken(u.UserID)
+	token, err := s.sessionCase.Issue(ctx, u.UserID, "apple")
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate token: %w", err)
 	}
@@ -80,163 +121,149 @@ func (s *AppleService) Login(ctx context.Context, req *v1.LoginWithAppleRequest)
 	return &v1.LoginResponse{
 		Token:     token,
 		IsNewUser: isNew,
-		UserInfo: &v1.UserInfo{
-			UserId: u.UserID,
-			Name:   u.Name,
-			Email:  u.Email,
-		},
+		UserInfo:  toUserInfo(u),
 	}, nil
 }
 
 func (s *AppleService) verifyIdToken(ctx context.Context, idToken, nonce string) (*AppleClaims, error) {
-	// 测试，先写死一下
-	return &AppleClaims{
-		Email: "bjbd2015@163.com",
-		Sub:   "293239232323",
-	}, nil
-
-	// 解析 token 头部以获取 kid
-	parts := strings.Split(idToken, ".")
-	if len(parts) != 3 {
-		return nil, errors.New("invalid id_token format")
-	}
-
-	// 解码头部
-	headerBytes, err := base64.RawURLEncoding.DecodeString(parts[0])
+	// 校验签名、issuer、audience 及有效期
+	claims, err := s.verifier.Verify(ctx, idToken)
 	if err != nil {
-		return nil, fmt.Errorf("failed to decode header: %w", err)
-	}
-
-	var header struct {
-		Kid string `json:"kid"`
-		Alg string `json:"alg"`
-	}
-	if err := json.Unmarshal(headerBytes, &header); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal header: %w", err)
+		return nil, err
 	}
 
-	// 获取 Apple 公钥
-	publicKey, err := s.getPublicKey(ctx, header.Kid)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get public key: %w", err)
+	// 验证 nonce
+	// 注意: Apple 的 nonce 可能会进行 SHA-256 哈希处理
+	if err = oidc.VerifyNonce(claims, nonce); err != nil {
+		return nil, err
 	}
 
-	// 解析并验证 token
-	token, err := jwtv4.ParseWithClaims(idToken, &AppleClaims{}, func(token *jwtv4.Token) (interface{}, error) {
-		// 验证算法
-		if _, ok := token.Method.(*jwtv4.SigningMethodRSA); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return publicKey, nil
-	})
+	return &AppleClaims{
+		RegisteredClaims: jwtv4.RegisteredClaims{
+			Issuer:  claims.String("iss"),
+			Subject: claims.String("sub"),
+		},
+		Email:         claims.String("email"),
+		EmailVerified: claims.Bool("email_verified"),
+		Sub:           claims.String("sub"),
+		Raw:           claims,
+	}, nil
+}
 
+// saveRefreshToken 使用授权码换取并保存 refresh_token
+func (s *AppleService) saveRefreshToken(ctx context.Context, sub, code string) {
+	if s.client == nil {
+		return
+	}
+	token, err := s.client.ExchangeCode(ctx, code, "")
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse token: %w", err)
+		s.log.WithContext(ctx).Warnf("failed to exchange apple authorization code, error: %v", err)
+		return
 	}
-
-	// 验证声明
-	claims, ok := token.Claims.(*AppleClaims)
-	if !ok || !token.Valid {
-		return nil, errors.New("invalid token claims")
+	if token.RefreshToken == "" {
+		return
 	}
-
-	// 验证 nonce
-	// 注意: Apple 的 nonce 可能会进行 SHA-256 哈希处理
-	// 这里简化处理，实际应用中需要根据 Apple 的要求进行验证
-
-	// 验证 issuer
-	if claims.Issuer != "https://appleid.apple.com" {
-		return nil, errors.New("invalid issuer")
+	if err = s.authCase.SaveRefreshToken(ctx, "apple", sub, token.RefreshToken); err != nil {
+		s.log.WithContext(ctx).Warnf("failed to save apple refresh token, error: %v", err)
 	}
+}
 
-	// 验证 audience
-	// 应该验证 audience 是否为您的应用客户端 ID
-
-	return claims, nil
+// Provider 实现 biz.Authenticator 和 biz.TokenRevoker
+func (s *AppleService) Provider() string {
+	return "apple"
 }
 
-func (s *AppleService) getPublicKey(_ context.Context, kid string) (*rsa.PublicKey, error) {
-	// 检查缓存
-	if key, ok := s.publicKeys[kid]; ok {
-		return key, nil
+// Authenticate 实现 biz.Authenticator, 用于关联账号
+func (s *AppleService) Authenticate(ctx context.Context, cred *biz.Credential) (*biz.Identity, error) {
+	if cred.IDToken == "" || cred.Nonce == "" {
+		return nil, errors.New("id_token and nonce are required")
 	}
-
-	// 从 Apple 获取公钥
-	resp, err := s.httpClient.Get("https://appleid.apple.com/auth/keys")
+	claims, err := s.verifyIdToken(ctx, cred.IDToken, cred.Nonce)
 	if err != nil {
 		return nil, err
 	}
 
-	defer func(Body io.ReadCloser) {
-		errClose := Body.Close()
-		if errClose != nil {
-			s.log.Errorf("close io reader failed: %v", errClose)
-		}
-	}(resp.Body)
+	return &biz.Identity{
+		Provider:      "apple",
+		Subject:       claims.Sub,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		RawClaims:     claims.Raw,
+	}, nil
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("apple keys api returned status code: %d", resp.StatusCode)
+// Revoke 实现 biz.TokenRevoker, 撤销 Apple refresh_token
+func (s *AppleService) Revoke(ctx context.Context, refreshToken string) error {
+	if s.client == nil {
+		return errors.New("apple signing key not configured")
 	}
+	return s.client.Revoke(ctx, refreshToken, "refresh_token")
+}
 
-	var keysResponse struct {
-		Keys []struct {
-			Kid string `json:"kid"`
-			Kty string `json:"kty"`
-			Alg string `json:"alg"`
-			Use string `json:"use"`
-			N   string `json:"n"`
-			E   string `json:"e"`
-		} `json:"keys"`
-	}
+// appleEvent Apple 服务端通知 events 声明的内容
+type appleEvent struct {
+	Type           string          `json:"type"`
+	Sub            string          `json:"sub"`
+	Email          string          `json:"email"`
+	IsPrivateEmail json.RawMessage `json:"is_private_email"`
+	EventTime      int64           `json:"event_time"`
+}
 
-	if err = json.NewDecoder(resp.Body).Decode(&keysResponse); err != nil {
-		return nil, err
+// HandleNotification 校验并处理 Apple 服务端通知
+func (s *AppleService) HandleNotification(ctx context.Context, req *v1.AppleNotificationRequest) (*v1.AppleNotificationReply, error) {
+	if req.Payload == "" {
+		return nil, errors.New("payload is required")
 	}
 
-	// 查找匹配的 key
-	var keyData struct {
-		N string
-		E string
+	// 通知与 id_token 使用同一套签名公钥、issuer 和 audience
+	claims, err := s.verifier.VerifyJWS(ctx, req.Payload)
+	if err != nil {
+		s.log.WithContext(ctx).Warnf("failed to verify apple notification, error: %v", err)
+		return nil, biz.ErrInvalidCredential
 	}
-	found := false
-	for _, key := range keysResponse.Keys {
-		if key.Kid == kid {
-			keyData.N = key.N
-			keyData.E = key.E
-			found = true
-			break
-		}
+
+	event, err := parseAppleEvent(claims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse apple event: %w", err)
 	}
 
-	if !found {
-		return nil, fmt.Errorf("public key with kid %s not found", kid)
+	if err = s.notifyCase.Handle(ctx, event, req.Payload); err != nil {
+		// 返回错误让 Apple 重试
+		return nil, fmt.Errorf("failed to handle apple event: %w", err)
 	}
+	return &v1.AppleNotificationReply{}, nil
+}
 
-	// 解码并构建 RSA 公钥
-	nBytes, err := base64.RawURLEncoding.DecodeString(keyData.N)
-	if err != nil {
-		return nil, err
+// parseAppleEvent events 声明可能是 JSON 字符串或对象
+func parseAppleEvent(claims oidc.Claims) (*biz.AppleEvent, error) {
+	var raw []byte
+	switch events := claims["events"].(type) {
+	case string:
+		raw = []byte(events)
+	case map[string]interface{}:
+		raw, _ = json.Marshal(events)
+	default:
+		return nil, errors.New("missing events claim")
 	}
 
-	eBytes, err := base64.RawURLEncoding.DecodeString(keyData.E)
-	if err != nil {
+	var e appleEvent
+	if err := json.Unmarshal(raw, &e); err != nil {
 		return nil, err
 	}
-
-	// 解析 e 为整数
-	var e int
-	for _, b := range eBytes {
-		e = e<<8 + int(b)
+	if e.Type == "" || e.Sub == "" {
+		return nil, errors.New("event type and sub are required")
 	}
 
-	// 构建公钥
-	publicKey := &rsa.PublicKey{
-		N: new(big.Int).SetBytes(nBytes),
-		E: e,
+	event := &biz.AppleEvent{
+		Type:    e.Type,
+		Subject: e.Sub,
+		Email:   e.Email,
+		// is_private_email 可能是字符串 "true" 或布尔值
+		IsPrivateEmail: string(e.IsPrivateEmail) == "true" || string(e.IsPrivateEmail) == `"true"`,
 	}
-
-	// 缓存公钥
-	s.publicKeys[kid] = publicKey
-
-	return publicKey, nil
+	if e.EventTime > 0 {
+		// event_time 为毫秒时间戳
+		event.EventTime = time.UnixMilli(e.EventTime)
+	}
+	return event, nil
 }
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+
+	v1 "user-service/api/auth/v1"
+	"user-service/internal/biz"
+	"user-service/third_party/jwt"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// AvatarService 用户上传头像
+type AvatarService struct {
+	log        *log.Helper
+	avatarCase *biz.AvatarCase
+}
+
+func NewAvatarService(logger log.Logger, avatarCase *biz.AvatarCase) *AvatarService {
+	return &AvatarService{
+		log:        log.NewHelper(logger),
+		avatarCase: avatarCase,
+	}
+}
+
+// Upload 保存当前用户上传的头像并返回各尺寸地址
+func (s *AvatarService) Upload(ctx context.Context, req *v1.UploadAvatarRequest) (*v1.UploadAvatarReply, error) {
+	userID, ok := jwt.FromContext(ctx)
+	if !ok {
+		return nil, biz.ErrUnauthenticated
+	}
+
+	images, err := s.avatarCase.Upload(ctx, userID, req.Image)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &v1.UploadAvatarReply{
+		Avatar: images[len(images)-1].URL,
+		Images: make([]*v1.AvatarImage, 0, len(images)),
+	}
+	for _, img := range images {
+		reply.Images = append(reply.Images, &v1.AvatarImage{Size: int32(img.Size), Url: img.URL})
+	}
+	return reply, nil
+}
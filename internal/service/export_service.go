@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	v1 "user-service/api/user/v1"
+	"user-service/internal/biz"
+	"user-service/third_party/jwt"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// RequestDataExport 申请导出个人数据
+func (s *UserService) RequestDataExport(ctx context.Context, _ *v1.RequestDataExportRequest) (*v1.DataExport, error) {
+	userID, ok := jwt.FromContext(ctx)
+	if !ok {
+		return nil, biz.ErrUnauthenticated
+	}
+	e, err := s.exportCase.Request(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.toDataExport(e), nil
+}
+
+// GetDataExport 查询导出状态和下载链接
+func (s *UserService) GetDataExport(ctx context.Context, req *v1.GetDataExportRequest) (*v1.DataExport, error) {
+	userID, ok := jwt.FromContext(ctx)
+	if !ok {
+		return nil, biz.ErrUnauthenticated
+	}
+	e, err := s.exportCase.Get(ctx, userID, req.ExportId)
+	if err != nil {
+		return nil, err
+	}
+	return s.toDataExport(e), nil
+}
+
+// DownloadDataExport 按签名链接下载导出文件, 注册在 biz.ExportDownloadPath
+func (s *UserService) DownloadDataExport(ctx http.Context) error {
+	exportID := ctx.Vars().Get("export_id")
+	query := ctx.Query()
+	data, err := s.exportCase.Download(ctx, exportID, query.Get("expires"), query.Get("signature"))
+	if err != nil {
+		return err
+	}
+
+	w := ctx.Response()
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="data-export-%s.zip"`, exportID))
+	w.Header().Set("Cache-Control", "no-store")
+	_, err = w.Write(data)
+	return err
+}
+
+func (s *UserService) toDataExport(e *biz.DataExport) *v1.DataExport {
+	reply := &v1.DataExport{
+		ExportId:  e.ExportID,
+		Status:    e.Status,
+		CreatedAt: e.CreatedAt.Unix(),
+	}
+	if e.CompletedAt != nil {
+		reply.CompletedAt = e.CompletedAt.Unix()
+	}
+	if e.ExpiresAt != nil {
+		reply.ExpiresAt = e.ExpiresAt.Unix()
+	}
+	if url, expires := s.exportCase.DownloadURL(e); url != "" {
+		reply.DownloadUrl = url
+		reply.DownloadExpiresAt = expires.Unix()
+	}
+	return reply
+}
@@ -13,7 +13,6 @@ import (
 	v1 "user-service/api/auth/v1"
 	"user-service/internal/biz"
 	"user-service/internal/conf"
-	"user-service/third_party/jwt"
 )
 
 type FacebookService struct {
@@ -21,17 +20,17 @@ type FacebookService struct {
 	log          *log.Helper
 	userAuthCase *biz.UserAuthCase
 	userCase     *biz.UserCase
-	jwt          *jwt.Generator
+	sessionCase  *biz.SessionCase
 	httpClient   *http.Client
 }
 
-func NewFacebookService(cfg *conf.Jwt, logger log.Logger, userAuthCase *biz.UserAuthCase, userCase *biz.UserCase) *FacebookService {
+func NewFacebookService(cfg *conf.Jwt, logger log.Logger, userAuthCase *biz.UserAuthCase, userCase *biz.UserCase, sessionCase *biz.SessionCase) *FacebookService {
 	return &FacebookService{
 		cfg:          cfg,
 		log:          log.NewHelper(logger),
 		userAuthCase: userAuthCase,
 		userCase:     userCase,
-		jwt:          jwt.NewGenerator(cfg.Secret, int(cfg.Expires)),
+		sessionCase:  sessionCase,
 		httpClient:   &http.Client{Timeout: 10 * time.Second},
 	}
 }
@@ -55,27 +54,19 @@ func (s *FacebookService) Login(ctx context.Context, req *v1.LoginWithFacebookRe
 	}
 
 	// 调用 Facebook Graph API 获取用户信息
-	userInfo, err := s.getUserInfo(ctx, req.AccessToken)
+	identity, err := s.Authenticate(ctx, &biz.Credential{AccessToken: req.AccessToken})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get facebook user info: %w", err)
 	}
 
 	// 查找或创建用户
-	u, isNew, err := s.userAuthCase.FindOrCreateByFacebookID(ctx, userInfo.ID, userInfo.Name, userInfo.Email)
+	u, isNew, err := s.userAuthCase.FindOrCreateByIdentity(ctx, identity)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find or create user: %w", err)
 	}
 
-	// 更新用户头像（如果有）
-	if userInfo.Picture.Data.Url != "" {
-		u.Avatar = userInfo.Picture.Data.Url
-		if _, err := s.userCase.Update(ctx, u); err != nil {
-			s.log.Errorf("failed to update user avatar, error: %v", err)
-		}
-	}
-
 	// 生成 JWT token
-	token, err := s.jwt.GenerateToken(u.UserID)
+	token, err := s.sessionCase.Issue(ctx, u.UserID, "facebook")
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate token: %w", err)
 	}
@@ -84,12 +75,7 @@ func (s *FacebookService) Login(ctx context.Context, req *v1.LoginWithFacebookRe
 	return &v1.LoginResponse{
 		Token:     token,
 		IsNewUser: isNew,
-		UserInfo: &v1.UserInfo{
-			UserId: u.UserID,
-			Name:   u.Name,
-			Avatar: u.Avatar,
-			Phone:  u.Phone,
-		},
+		UserInfo:  toUserInfo(u),
 	}, nil
 }
 
@@ -134,3 +120,31 @@ func (s *FacebookService) getUserInfo(ctx context.Context, accessToken string) (
 
 	return &userResponse, nil
 }
+
+// Provider 实现 biz.Authenticator
+func (s *FacebookService) Provider() string {
+	return "facebook"
+}
+
+// Authenticate 实现 biz.Authenticator, 用于关联账号
+func (s *FacebookService) Authenticate(ctx context.Context, cred *biz.Credential) (*biz.Identity, error) {
+	if cred.AccessToken == "" {
+		return nil, errors.New("access token is required")
+	}
+	userInfo, err := s.getUserInfo(ctx, cred.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &biz.Identity{
+		Provider: "facebook",
+		Subject:  userInfo.ID,
+		Email:    userInfo.Email,
+		// Graph API 不保证邮箱已确认, 不能用于匹配已有账号
+		EmailVerified: false,
+		Name:          userInfo.Name,
+		Avatar:        userInfo.Picture.Data.Url,
+		RawClaims:     rawClaims(userInfo),
+		AccessToken:   cred.AccessToken,
+	}, nil
+}
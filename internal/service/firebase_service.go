@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	v1 "user-service/api/auth/v1"
+	"user-service/internal/biz"
+	"user-service/internal/conf"
+	"user-service/third_party/oidc"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// firebaseIssuerPrefix Firebase ID token 的 iss 为该前缀加项目ID
+	firebaseIssuerPrefix = "https://securetoken.google.com/"
+	// firebaseJWKSURL securetoken 服务账号的签名公钥
+	firebaseJWKSURL = "https://www.googleapis.com/service_accounts/v1/jwk/securetoken@system.gserviceaccount.com"
+)
+
+type FirebaseService struct {
+	cfg          *conf.Jwt
+	firebaseCfg  *conf.Auth_Firebase
+	log          *log.Helper
+	userAuthCase *biz.UserAuthCase
+	sessionCase  *biz.SessionCase
+	verifier     *oidc.Verifier
+}
+
+func NewFirebaseService(cfg *conf.Jwt, authCfg *conf.Auth, logger log.Logger, userAuthCase *biz.UserAuthCase, sessionCase *biz.SessionCase) *FirebaseService {
+	firebaseCfg := authCfg.GetFirebase()
+	return &FirebaseService{
+		cfg:          cfg,
+		firebaseCfg:  firebaseCfg,
+		log:          log.NewHelper(logger),
+		userAuthCase: userAuthCase,
+		sessionCase:  sessionCase,
+		verifier: oidc.NewVerifier(oidc.Config{
+			Issuer:    firebaseIssuerPrefix + firebaseCfg.GetProjectId(),
+			ClientIDs: []string{firebaseCfg.GetProjectId()},
+			JWKSURL:   firebaseJWKSURL,
+		}, &http.Client{Timeout: 10 * time.Second}),
+	}
+}
+
+func (s *FirebaseService) Login(ctx context.Context, req *v1.LoginWithFirebaseRequest) (*v1.LoginResponse, error) {
+	// 验证参数
+	if req.IdToken == "" {
+		return nil, errors.New("id_token is required")
+	}
+	if s.firebaseCfg.GetProjectId() == "" {
+		return nil, biz.ErrProviderNotSupported
+	}
+
+	// 验证 id_token
+	identity, err := s.verifyIdToken(ctx, req.IdToken)
+	if err != nil {
+		s.log.WithContext(ctx).Warnf("failed to verify firebase id_token, error: %v", err)
+		return nil, biz.ErrInvalidCredential
+	}
+
+	// 查找或创建用户
+	u, isNew, err := s.userAuthCase.FindOrCreateByFirebase(ctx, identity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find or create user: %w", err)
+	}
+
+	// 生成 JWT token
+	token, err := s.sessionCase.Issue(ctx, u.UserID, "firebase")
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	// 构建响应
+	return &v1.LoginResponse{
+		Token:     token,
+		IsNewUser: isNew,
+		UserInfo:  toUserInfo(u),
+	}, nil
+}
+
+func (s *FirebaseService) verifyIdToken(ctx context.Context, idToken string) (*biz.Identity, error) {
+	// 校验签名、issuer(securetoken)、audience(项目ID) 及有效期
+	claims, err := s.verifier.Verify(ctx, idToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// auth_time 必须早于当前时间
+	authTime, ok := claims["auth_time"].(float64)
+	if !ok || time.Unix(int64(authTime), 0).After(time.Now()) {
+		return nil, errors.New("invalid auth_time")
+	}
+
+	return &biz.Identity{
+		Provider:      "firebase",
+		Subject:       claims.String("sub"),
+		Email:         claims.String("email"),
+		EmailVerified: claims.Bool("email_verified"),
+		Phone:         claims.String("phone_number"),
+		Name:          claims.String("name"),
+		Avatar:        claims.String("picture"),
+		RawClaims:     claims,
+	}, nil
+}
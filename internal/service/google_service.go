@@ -12,7 +12,6 @@ import (
 	v1 "user-service/api/auth/v1"
 	"user-service/internal/biz"
 	"user-service/internal/conf"
-	"user-service/third_party/jwt"
 
 	"github.com/go-kratos/kratos/v2/log"
 	jwtv4 "github.com/golang-jwt/jwt/v4"
@@ -23,18 +22,21 @@ type GoogleService struct {
 	log          *log.Helper
 	userAuthCase *biz.UserAuthCase
 	userCase     *biz.UserCase
-	jwtGen       *jwt.Generator
+	sessionCase  *biz.SessionCase
 	httpClient   *http.Client
+	// clientID 配置的客户端 ID, 为空时拒绝所有 id_token
+	clientID string
 }
 
-func NewGoogleService(cfg *conf.Jwt, logger log.Logger, userAuthCase *biz.UserAuthCase, userCase *biz.UserCase) *GoogleService {
+func NewGoogleService(cfg *conf.Jwt, authCfg *conf.Auth, logger log.Logger, userAuthCase *biz.UserAuthCase, userCase *biz.UserCase, sessionCase *biz.SessionCase) *GoogleService {
 	return &GoogleService{
 		cfg:          cfg,
 		log:          log.NewHelper(logger),
 		userAuthCase: userAuthCase,
 		userCase:     userCase,
-		jwtGen:       jwt.NewGenerator(cfg.Secret, int(cfg.Expires)),
+		sessionCase:  sessionCase,
 		httpClient:   &http.Client{Timeout: 10 * time.Second},
+		clientID:     authCfg.GetGoogle().GetClientId(),
 	}
 }
 
@@ -46,6 +48,8 @@ type GoogleClaims struct {
 	Name          string `json:"name"`
 	Picture       string `json:"picture"`
 	Sub           string `json:"sub"` // Google 用户唯一标识符
+	// Raw tokeninfo 原始返回
+	Raw map[string]interface{} `json:"-"`
 }
 
 func (s *GoogleService) Login(ctx context.Context, req *v1.LoginWithGoogleRequest) (*v1.LoginResponse, error) {
@@ -55,32 +59,24 @@ func (s *GoogleService) Login(ctx context.Context, req *v1.LoginWithGoogleReques
 	}
 
 	// 验证 id_token
-	claims, err := s.verifyIdToken(ctx, req.IdToken)
+	identity, err := s.Authenticate(ctx, &biz.Credential{IDToken: req.IdToken})
 	if err != nil {
 		return nil, fmt.Errorf("failed to verify google id_token: %w", err)
 	}
 
 	// 检查邮箱是否已验证
-	if !claims.EmailVerified {
+	if !identity.EmailVerified {
 		return nil, errors.New("google email is not verified")
 	}
 
 	// 查找或创建用户
-	u, isNew, err := s.userAuthCase.FindOrCreateByGoogleID(ctx, claims.Sub, claims.Name, claims.Email)
+	u, isNew, err := s.userAuthCase.FindOrCreateByIdentity(ctx, identity)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find or create user: %w", err)
 	}
 
-	// 更新用户头像
-	if claims.Picture != "" && u.Avatar != claims.Picture {
-		u.Avatar = claims.Picture
-		if _, err = s.userCase.Update(ctx, u); err != nil {
-			s.log.Errorf("failed to update user avatar, error: %v", err)
-		}
-	}
-
 	// 生成 JWT token
-	token, err := s.jwtGen.GenerateToken(u.UserID)
+	token, err := s.sessionCase.Issue(ctx, u.UserID, "google")
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate token: %w", err)
 	}
@@ -89,12 +85,7 @@ func (s *GoogleService) Login(ctx context.Context, req *v1.LoginWithGoogleReques
 	return &v1.LoginResponse{
 		Token:     token,
 		IsNewUser: isNew,
-		UserInfo: &v1.UserInfo{
-			UserId: u.UserID,
-			Name:   u.Name,
-			Avatar: u.Avatar,
-			Email:  u.Email,
-		},
+		UserInfo:  toUserInfo(u),
 	}, nil
 }
 
@@ -141,8 +132,10 @@ func (s *GoogleService) verifyIdToken(ctx context.Context, idToken string) (*Goo
 		return nil, errors.New("invalid issuer")
 	}
 
-	// 验证 audience
-	// 应该验证 audience 是否为您的应用客户端 ID
+	// 验证 audience, 否则其他应用的 id_token 也能登录
+	if s.clientID == "" || tokenInfo.Aud != s.clientID {
+		return nil, errors.New("invalid audience")
+	}
 
 	// 验证过期时间
 	now := time.Now().Unix()
@@ -163,7 +156,34 @@ func (s *GoogleService) verifyIdToken(ctx context.Context, idToken string) (*Goo
 		Name:          tokenInfo.Name,
 		Picture:       tokenInfo.Picture,
 		Sub:           tokenInfo.Sub,
+		Raw:           rawClaims(tokenInfo),
 	}
 
 	return claims, nil
 }
+
+// Provider 实现 biz.Authenticator
+func (s *GoogleService) Provider() string {
+	return "google"
+}
+
+// Authenticate 实现 biz.Authenticator, 用于关联账号
+func (s *GoogleService) Authenticate(ctx context.Context, cred *biz.Credential) (*biz.Identity, error) {
+	if cred.IDToken == "" {
+		return nil, errors.New("id_token is required")
+	}
+	claims, err := s.verifyIdToken(ctx, cred.IDToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &biz.Identity{
+		Provider:      "google",
+		Subject:       claims.Sub,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		Name:          claims.Name,
+		Avatar:        claims.Picture,
+		RawClaims:     claims.Raw,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "user-service/api/auth/v1"
+	"user-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// minDeviceSecretLen 设备密钥最短长度, 避免被猜中
+const minDeviceSecretLen = 32
+
+type GuestService struct {
+	log          *log.Helper
+	userAuthCase *biz.UserAuthCase
+	sessionCase  *biz.SessionCase
+}
+
+func NewGuestService(logger log.Logger, userAuthCase *biz.UserAuthCase, sessionCase *biz.SessionCase) *GuestService {
+	return &GuestService{
+		log:          log.NewHelper(logger),
+		userAuthCase: userAuthCase,
+		sessionCase:  sessionCase,
+	}
+}
+
+func (s *GuestService) Login(ctx context.Context, req *v1.LoginAsGuestRequest) (*v1.LoginResponse, error) {
+	// 验证参数
+	if req.DeviceSecret == "" {
+		return nil, errors.New("device_secret is required")
+	}
+	if len(req.DeviceSecret) < minDeviceSecretLen {
+		return nil, fmt.Errorf("device_secret must be at least %d characters", minDeviceSecretLen)
+	}
+
+	// 查找或创建游客
+	u, isNew, err := s.userAuthCase.FindOrCreateGuest(ctx, req.DeviceSecret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find or create guest: %w", err)
+	}
+
+	// 生成 JWT token
+	token, err := s.sessionCase.Issue(ctx, u.UserID, biz.GuestProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	// 构建响应
+	return &v1.LoginResponse{
+		Token:     token,
+		IsNewUser: isNew,
+		UserInfo:  toUserInfo(u),
+	}, nil
+}
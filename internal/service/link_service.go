@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	v1 "user-service/api/auth/v1"
+	"user-service/internal/biz"
+	"user-service/third_party/jwt"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// LinkService 已登录用户管理关联的登录方式
+type LinkService struct {
+	log          *log.Helper
+	userAuthCase *biz.UserAuthCase
+	registry     *biz.AuthenticatorRegistry
+	sessionCase  *biz.SessionCase
+}
+
+func NewLinkService(logger log.Logger, userAuthCase *biz.UserAuthCase, registry *biz.AuthenticatorRegistry, sessionCase *biz.SessionCase) *LinkService {
+	return &LinkService{
+		log:          log.NewHelper(logger),
+		userAuthCase: userAuthCase,
+		registry:     registry,
+		sessionCase:  sessionCase,
+	}
+}
+
+// Link 校验第三方凭证并关联到当前用户
+func (s *LinkService) Link(ctx context.Context, req *v1.LinkProviderRequest) (*v1.LinkedProvider, error) {
+	userID, ok := jwt.FromContext(ctx)
+	if !ok {
+		return nil, biz.ErrUnauthenticated
+	}
+	authenticator, err := s.registry.Get(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	identity, err := authenticator.Authenticate(ctx, &biz.Credential{
+		IDToken:      req.IdToken,
+		Nonce:        req.Nonce,
+		AccessToken:  req.AccessToken,
+		Code:         req.Code,
+		CodeVerifier: req.CodeVerifier,
+		RedirectURI:  req.RedirectUri,
+	})
+	if err != nil {
+		var se *kerrors.Error
+		if errors.As(err, &se) {
+			return nil, err
+		}
+		s.log.WithContext(ctx).Warnf("failed to authenticate %s for linking, error: %v", req.Provider, err)
+		return nil, biz.ErrInvalidCredential
+	}
+
+	linked, err := s.userAuthCase.LinkIdentity(ctx, userID, identity)
+	if err != nil {
+		return nil, err
+	}
+	return toLinkedProvider(linked), nil
+}
+
+// Unlink 解除当前用户关联的登录方式
+func (s *LinkService) Unlink(ctx context.Context, req *v1.UnlinkProviderRequest) (*v1.UnlinkProviderReply, error) {
+	userID, ok := jwt.FromContext(ctx)
+	if !ok {
+		return nil, biz.ErrUnauthenticated
+	}
+	if err := s.userAuthCase.UnlinkProvider(ctx, userID, req.Provider); err != nil {
+		return nil, err
+	}
+	return &v1.UnlinkProviderReply{}, nil
+}
+
+// List 列出当前用户关联的登录方式
+func (s *LinkService) List(ctx context.Context, _ *v1.ListLinkedProvidersRequest) (*v1.ListLinkedProvidersReply, error) {
+	userID, ok := jwt.FromContext(ctx)
+	if !ok {
+		return nil, biz.ErrUnauthenticated
+	}
+	providers, err := s.userAuthCase.ListLinkedProviders(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &v1.ListLinkedProvidersReply{Providers: make([]*v1.LinkedProvider, 0, len(providers))}
+	for _, p := range providers {
+		reply.Providers = append(reply.Providers, toLinkedProvider(p))
+	}
+	return reply, nil
+}
+
+// Merge 把 source_token 对应的账号合并到当前用户
+func (s *LinkService) Merge(ctx context.Context, req *v1.MergeAccountsRequest) (*v1.MergeAccountsReply, error) {
+	userID, ok := jwt.FromContext(ctx)
+	if !ok {
+		return nil, biz.ErrUnauthenticated
+	}
+	if req.SourceToken == "" {
+		return nil, errors.New("source_token is required")
+	}
+
+	// 持有被合并账号的有效 token 即证明用户拥有该账号
+	source, err := s.sessionCase.Verify(ctx, req.SourceToken)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.userAuthCase.MergeAccounts(ctx, userID, source.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MergeAccountsReply{
+		UserInfo: toUserInfo(u),
+	}, nil
+}
+
+func toLinkedProvider(p *biz.LinkedProvider) *v1.LinkedProvider {
+	return &v1.LinkedProvider{
+		Provider:      p.ProviderType,
+		ProviderId:    p.ProviderID,
+		CreatedAt:     p.CreatedAt.Unix(),
+		Email:         p.Email,
+		EmailVerified: p.EmailVerified,
+		Name:          p.Name,
+		Avatar:        p.Avatar,
+	}
+}
@@ -6,8 +6,6 @@ import (
 	v1 "user-service/api/auth/v1"
 	"user-service/internal/biz"
 	"user-service/internal/conf"
-	"user-service/third_party/jwt"
-	"user-service/third_party/snowflake"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -15,28 +13,66 @@ import (
 type LoginService struct {
 	v1.UnimplementedAuthServiceServer
 	log             *log.Helper
-	uidGen          *snowflake.Node
 	phoneService    *PhoneService
 	facebookService *FacebookService
 	appleService    *AppleService
 	googleService   *GoogleService
 	snapchatService *SnapchatService
-	jwtGenerator    *jwt.Generator
+	wechatService   *WechatService
+	oidcService     *OIDCService
+	oauthService    *OAuthService
+	firebaseService *FirebaseService
+	linkService     *LinkService
+	guestService    *GuestService
+	avatarService   *AvatarService
 }
 
-func NewLoginService(cfg *conf.Jwt, logger log.Logger, uidGen *snowflake.Node, userAuthCase *biz.UserAuthCase, userCase *biz.UserCase) *LoginService {
-	jwtGenerator := jwt.NewGenerator(cfg.Secret, int(cfg.Expires))
+func NewLoginService(cfg *conf.Jwt, authCfg *conf.Auth, dataCfg *conf.Data, logger log.Logger, userAuthCase *biz.UserAuthCase, userCase *biz.UserCase, appleNotificationCase *biz.AppleNotificationCase, sessionCase *biz.SessionCase, avatarCase *biz.AvatarCase, deletionCase *biz.DeletionCase) (*LoginService, error) {
+	facebookService := NewFacebookService(cfg, logger, userAuthCase, userCase, sessionCase)
+	appleService, err := NewAppleService(cfg, authCfg, dataCfg, logger, userAuthCase, appleNotificationCase, sessionCase)
+	if err != nil {
+		return nil, err
+	}
+	googleService := NewGoogleService(cfg, authCfg, logger, userAuthCase, userCase, sessionCase)
+	snapchatService := NewSnapchatService(cfg, logger, userAuthCase, userCase, sessionCase)
+	builtins := []biz.Authenticator{
+		facebookService,
+		appleService,
+		googleService,
+		snapchatService,
+		NewXService(authCfg, logger),
+		NewTikTokService(authCfg, logger),
+	}
+	registry := biz.NewAuthenticatorRegistry(builtins...)
+	oidcAuthenticators, err := newOIDCAuthenticators(authCfg, registry, NewMicrosoftService(authCfg, logger))
+	if err != nil {
+		return nil, err
+	}
+	// 所有登录方式共用一个注册表, 名称已保证不重复;
+	// LoginWithOIDC 只能使用 Microsoft 和配置的 OIDC 登录方式, 内置登录方式有各自的校验
+	oidcProviders := make([]string, 0, len(oidcAuthenticators))
+	for _, a := range oidcAuthenticators {
+		registry.Register(a)
+		oidcProviders = append(oidcProviders, a.Provider())
+	}
+	// 清除账号时撤销 Apple 授权, App Store 审核要求
+	deletionCase.RegisterRevoker(appleService)
 
 	return &LoginService{
 		log:             log.NewHelper(logger),
-		uidGen:          uidGen,
-		phoneService:    NewPhoneService(cfg, logger, userCase),
-		facebookService: NewFacebookService(cfg, logger, userAuthCase, userCase),
-		appleService:    NewAppleService(cfg, logger, userAuthCase),
-		googleService:   NewGoogleService(cfg, logger, userAuthCase, userCase),
-		snapchatService: NewSnapchatService(cfg, logger, userAuthCase, userCase),
-		jwtGenerator:    jwtGenerator,
-	}
+		phoneService:    NewPhoneService(cfg, logger, userCase, userAuthCase, sessionCase),
+		facebookService: facebookService,
+		appleService:    appleService,
+		googleService:   googleService,
+		snapchatService: snapchatService,
+		wechatService:   NewWechatService(cfg, authCfg, logger, userAuthCase, sessionCase),
+		oidcService:     NewOIDCService(cfg, logger, userAuthCase, registry, oidcProviders, sessionCase),
+		oauthService:    NewOAuthService(cfg, logger, userAuthCase, userCase, registry, sessionCase),
+		firebaseService: NewFirebaseService(cfg, authCfg, logger, userAuthCase, sessionCase),
+		linkService:     NewLinkService(logger, userAuthCase, registry, sessionCase),
+		guestService:    NewGuestService(logger, userAuthCase, sessionCase),
+		avatarService:   NewAvatarService(logger, avatarCase),
+	}, nil
 }
 
 // LoginWithPhone 手机号登录
@@ -63,3 +99,87 @@ func (s *LoginService) LoginWithGoogle(ctx context.Context, req *v1.LoginWithGoo
 func (s *LoginService) LoginWithSnapchat(ctx context.Context, req *v1.LoginWithSnapchatRequest) (*v1.LoginResponse, error) {
 	return s.snapchatService.Login(ctx, req)
 }
+
+// LoginWithWechat 微信移动应用登录
+func (s *LoginService) LoginWithWechat(ctx context.Context, req *v1.LoginWithWechatRequest) (*v1.LoginResponse, error) {
+	return s.wechatService.Login(ctx, req)
+}
+
+// LoginWithWechatMiniProgram 微信小程序登录
+func (s *LoginService) LoginWithWechatMiniProgram(ctx context.Context, req *v1.LoginWithWechatMiniProgramRequest) (*v1.LoginResponse, error) {
+	return s.wechatService.LoginMiniProgram(ctx, req)
+}
+
+// LoginWithOIDC 通用OIDC登录
+func (s *LoginService) LoginWithOIDC(ctx context.Context, req *v1.LoginWithOIDCRequest) (*v1.LoginResponse, error) {
+	return s.oidcService.Login(ctx, req)
+}
+
+// LoginWithOAuth OAuth 2.0 授权码(PKCE)登录
+func (s *LoginService) LoginWithOAuth(ctx context.Context, req *v1.LoginWithOAuthRequest) (*v1.LoginResponse, error) {
+	return s.oauthService.Login(ctx, req)
+}
+
+// LoginWithFirebase Firebase登录
+func (s *LoginService) LoginWithFirebase(ctx context.Context, req *v1.LoginWithFirebaseRequest) (*v1.LoginResponse, error) {
+	return s.firebaseService.Login(ctx, req)
+}
+
+// AppleNotification Apple服务端通知
+func (s *LoginService) AppleNotification(ctx context.Context, req *v1.AppleNotificationRequest) (*v1.AppleNotificationReply, error) {
+	return s.appleService.HandleNotification(ctx, req)
+}
+
+// LinkProvider 关联登录方式
+func (s *LoginService) LinkProvider(ctx context.Context, req *v1.LinkProviderRequest) (*v1.LinkedProvider, error) {
+	return s.linkService.Link(ctx, req)
+}
+
+// UnlinkProvider 解除关联登录方式
+func (s *LoginService) UnlinkProvider(ctx context.Context, req *v1.UnlinkProviderRequest) (*v1.UnlinkProviderReply, error) {
+	return s.linkService.Unlink(ctx, req)
+}
+
+// ListLinkedProviders 列出已关联的登录方式
+func (s *LoginService) ListLinkedProviders(ctx context.Context, req *v1.ListLinkedProvidersRequest) (*v1.ListLinkedProvidersReply, error) {
+	return s.linkService.List(ctx, req)
+}
+
+// MergeAccounts 合并账号
+func (s *LoginService) MergeAccounts(ctx context.Context, req *v1.MergeAccountsRequest) (*v1.MergeAccountsReply, error) {
+	return s.linkService.Merge(ctx, req)
+}
+
+// LoginAsGuest 游客登录
+func (s *LoginService) LoginAsGuest(ctx context.Context, req *v1.LoginAsGuestRequest) (*v1.LoginResponse, error) {
+	return s.guestService.Login(ctx, req)
+}
+
+// LinkPhone 绑定手机号
+func (s *LoginService) LinkPhone(ctx context.Context, req *v1.LinkPhoneRequest) (*v1.LinkPhoneReply, error) {
+	return s.phoneService.Link(ctx, req)
+}
+
+// UploadAvatar 上传头像
+func (s *LoginService) UploadAvatar(ctx context.Context, req *v1.UploadAvatarRequest) (*v1.UploadAvatarReply, error) {
+	return s.avatarService.Upload(ctx, req)
+}
+
+// toUserInfo 登录等接口返回的当前用户信息
+func toUserInfo(u *biz.User) *v1.UserInfo {
+	return &v1.UserInfo{
+		UserId:   u.UserID,
+		Name:     u.Name,
+		Avatar:   u.Avatar,
+		Phone:    u.Phone,
+		Email:    u.Email,
+		Birthday: biz.FormatBirthday(u.Birthday),
+		Gender:   u.Gender,
+		Locale:   u.Locale,
+		Timezone: u.Timezone,
+		Country:  u.Country,
+		Bio:      u.Bio,
+		Metadata: u.Metadata,
+		Handle:   u.Handle,
+	}
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	v1 "user-service/api/auth/v1"
+	"user-service/internal/biz"
+	"user-service/internal/conf"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// OAuthService OAuth 2.0 授权码(PKCE)登录, 由注册表中的认证器完成 code 交换
+type OAuthService struct {
+	cfg          *conf.Jwt
+	log          *log.Helper
+	userAuthCase *biz.UserAuthCase
+	userCase     *biz.UserCase
+	registry     *biz.AuthenticatorRegistry
+	sessionCase  *biz.SessionCase
+}
+
+func NewOAuthService(cfg *conf.Jwt, logger log.Logger, userAuthCase *biz.UserAuthCase, userCase *biz.UserCase, registry *biz.AuthenticatorRegistry, sessionCase *biz.SessionCase) *OAuthService {
+	return &OAuthService{
+		cfg:          cfg,
+		log:          log.NewHelper(logger),
+		userAuthCase: userAuthCase,
+		userCase:     userCase,
+		registry:     registry,
+		sessionCase:  sessionCase,
+	}
+}
+
+func (s *OAuthService) Login(ctx context.Context, req *v1.LoginWithOAuthRequest) (*v1.LoginResponse, error) {
+	// 验证参数
+	if req.Provider == "" {
+		return nil, errors.New("provider is required")
+	}
+	if req.Code == "" {
+		return nil, errors.New("code is required")
+	}
+	// RFC 7636: code_verifier 长度为 43~128
+	if len(req.CodeVerifier) < 43 || len(req.CodeVerifier) > 128 {
+		return nil, errors.New("invalid code_verifier")
+	}
+
+	authenticator, err := s.registry.Get(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	// 交换 code 并获取用户信息
+	identity, err := authenticator.Authenticate(ctx, &biz.Credential{
+		Code:         req.Code,
+		CodeVerifier: req.CodeVerifier,
+		RedirectURI:  req.RedirectUri,
+	})
+	if err != nil {
+		var se *kerrors.Error
+		if errors.As(err, &se) {
+			return nil, err
+		}
+		s.log.WithContext(ctx).Warnf("failed to exchange %s code, error: %v", req.Provider, err)
+		return nil, biz.ErrInvalidCredential
+	}
+
+	// 查找或创建用户
+	u, isNew, err := s.userAuthCase.FindOrCreateByIdentity(ctx, identity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find or create user: %w", err)
+	}
+
+	// 同步昵称, 头像由 biz 层异步转存
+	if !isNew && u.Name == "" && identity.Name != "" {
+		u.Name = identity.Name
+		if _, err = s.userCase.Update(ctx, u); err != nil {
+			s.log.Errorf("failed to update user profile, error: %v", err)
+		}
+	}
+
+	// 生成 JWT token
+	token, err := s.sessionCase.Issue(ctx, u.UserID, req.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	// 构建响应
+	return &v1.LoginResponse{
+		Token:     token,
+		IsNewUser: isNew,
+		UserInfo:  toUserInfo(u),
+	}, nil
+}
+
+// postForm 以表单方式调用 token 接口并解析 JSON 响应
+func postForm(ctx context.Context, client *http.Client, endpoint string, form url.Values, setAuth func(*http.Request), out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if setAuth != nil {
+		setAuth(req)
+	}
+	return doJSON(client, req, out)
+}
+
+// getJSON 携带 Bearer token 调用用户信息接口
+func getJSON(ctx context.Context, client *http.Client, endpoint, accessToken string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	return doJSON(client, req, out)
+}
+
+func doJSON(client *http.Client, req *http.Request, out interface{}) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("%s returned status code: %d, body: %s", req.URL.Path, resp.StatusCode, body)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// rawClaims 把第三方返回的结构转为 map, 作为原始声明保存
+func rawClaims(v interface{}) map[string]interface{} {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var claims map[string]interface{}
+	if err = json.Unmarshal(raw, &claims); err != nil {
+		return nil
+	}
+	return claims
+}
+
+// expiresAt 根据 expires_in 秒数计算过期时间, 未返回时为零值
+func expiresAt(expiresIn int64) time.Time {
+	if expiresIn <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(time.Duration(expiresIn) * time.Second)
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	v1 "user-service/api/auth/v1"
+	"user-service/internal/biz"
+	"user-service/internal/conf"
+	"user-service/third_party/oidc"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// OIDCService 基于配置的通用 OIDC 登录
+type OIDCService struct {
+	cfg          *conf.Jwt
+	log          *log.Helper
+	userAuthCase *biz.UserAuthCase
+	registry     *biz.AuthenticatorRegistry
+	// providers 可以通过 LoginWithOIDC 登录的认证器名称
+	providers   map[string]bool
+	sessionCase *biz.SessionCase
+}
+
+// reservedProviders 不通过认证器注册的内置登录方式, 配置的 OIDC 不能使用这些名称
+var reservedProviders = map[string]bool{
+	"phone":           true,
+	"wechat":          true,
+	"firebase":        true,
+	biz.GuestProvider: true,
+}
+
+func NewOIDCService(cfg *conf.Jwt, logger log.Logger, userAuthCase *biz.UserAuthCase, registry *biz.AuthenticatorRegistry, providers []string, sessionCase *biz.SessionCase) *OIDCService {
+	s := &OIDCService{
+		cfg:          cfg,
+		log:          log.NewHelper(logger),
+		userAuthCase: userAuthCase,
+		registry:     registry,
+		providers:    make(map[string]bool),
+		sessionCase:  sessionCase,
+	}
+	for _, p := range providers {
+		s.providers[p] = true
+	}
+	return s
+}
+
+// newOIDCAuthenticators 按配置创建通用 OIDC 认证器;
+// 名称与内置登录方式重复时拒绝启动, 否则会替换或绕过内置认证器的额外校验
+func newOIDCAuthenticators(authCfg *conf.Auth, builtins *biz.AuthenticatorRegistry, extra ...biz.Authenticator) ([]biz.Authenticator, error) {
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+	authenticators := extra
+	seen := make(map[string]bool)
+	for _, a := range extra {
+		seen[a.Provider()] = true
+	}
+	for _, p := range authCfg.GetOidc() {
+		if p.Name == "" {
+			return nil, errors.New("oidc provider name is required")
+		}
+		if builtins.Has(p.Name) || reservedProviders[p.Name] || seen[p.Name] {
+			return nil, fmt.Errorf("oidc provider %q conflicts with a built-in or another oidc provider", p.Name)
+		}
+		seen[p.Name] = true
+		authenticators = append(authenticators, newOIDCAuthenticator(p, httpClient))
+	}
+	return authenticators, nil
+}
+
+func (s *OIDCService) Login(ctx context.Context, req *v1.LoginWithOIDCRequest) (*v1.LoginResponse, error) {
+	// 验证参数
+	if req.Provider == "" {
+		return nil, errors.New("provider is required")
+	}
+	if req.IdToken == "" {
+		return nil, errors.New("id_token is required")
+	}
+
+	if !s.providers[req.Provider] {
+		return nil, biz.ErrProviderNotSupported
+	}
+	authenticator, err := s.registry.Get(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	// 校验 id_token
+	identity, err := authenticator.Authenticate(ctx, &biz.Credential{IDToken: req.IdToken, Nonce: req.Nonce})
+	if err != nil {
+		// 认证器返回的业务错误直接透传, 其他校验失败统一为凭证无效
+		var se *kerrors.Error
+		if errors.As(err, &se) {
+			return nil, err
+		}
+		s.log.WithContext(ctx).Warnf("failed to verify %s id_token, error: %v", req.Provider, err)
+		return nil, biz.ErrInvalidCredential
+	}
+
+	// 查找或创建用户
+	u, isNew, err := s.userAuthCase.FindOrCreateByIdentity(ctx, identity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find or create user: %w", err)
+	}
+
+	// 生成 JWT token
+	token, err := s.sessionCase.Issue(ctx, u.UserID, req.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	// 构建响应
+	return &v1.LoginResponse{
+		Token:     token,
+		IsNewUser: isNew,
+		UserInfo:  toUserInfo(u),
+	}, nil
+}
+
+// oidcAuthenticator 按配置校验 id_token 的认证器
+type oidcAuthenticator struct {
+	name     string
+	claims   *conf.Auth_OIDC_Claims
+	verifier *oidc.Verifier
+}
+
+func newOIDCAuthenticator(p *conf.Auth_OIDC, httpClient *http.Client) *oidcAuthenticator {
+	return &oidcAuthenticator{
+		name:   p.Name,
+		claims: p.GetClaims(),
+		verifier: oidc.NewVerifier(oidc.Config{
+			Issuer:    p.Issuer,
+			ClientIDs: p.ClientIds,
+			JWKSURL:   p.JwksUri,
+		}, httpClient),
+	}
+}
+
+func (a *oidcAuthenticator) Provider() string {
+	return a.name
+}
+
+func (a *oidcAuthenticator) Authenticate(ctx context.Context, cred *biz.Credential) (*biz.Identity, error) {
+	claims, err := a.verifier.Verify(ctx, cred.IDToken)
+	if err != nil {
+		return nil, err
+	}
+	if cred.Nonce != "" {
+		if err = oidc.VerifyNonce(claims, cred.Nonce); err != nil {
+			return nil, err
+		}
+	}
+
+	identity := &biz.Identity{
+		Provider:      a.name,
+		Subject:       claims.String(claimName(a.claims.GetSubject(), "sub")),
+		Email:         claims.String(claimName(a.claims.GetEmail(), "email")),
+		EmailVerified: claims.Bool(claimName(a.claims.GetEmailVerified(), "email_verified")),
+		Name:          claims.String(claimName(a.claims.GetName(), "name")),
+		Avatar:        claims.String(claimName(a.claims.GetPicture(), "picture")),
+		RawClaims:     claims,
+	}
+	if identity.Subject == "" {
+		return nil, errors.New("subject claim is empty")
+	}
+	return identity, nil
+}
+
+// claimName 返回配置的声明名, 未配置时使用标准声明名
+func claimName(configured, standard string) string {
+	if configured != "" {
+		return configured
+	}
+	return standard
+}
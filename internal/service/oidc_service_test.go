@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	v1 "user-service/api/auth/v1"
+	"user-service/internal/biz"
+	"user-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// stubAuthenticator 记录是否被调用, 校验总是失败
+type stubAuthenticator struct {
+	name   string
+	called bool
+}
+
+func (a *stubAuthenticator) Provider() string {
+	return a.name
+}
+
+func (a *stubAuthenticator) Authenticate(ctx context.Context, cred *biz.Credential) (*biz.Identity, error) {
+	a.called = true
+	return nil, errors.New(400, "STUB", "stub")
+}
+
+func TestOIDCLoginOnlyUsesOIDCProviders(t *testing.T) {
+	google := &stubAuthenticator{name: "google"}
+	corp := &stubAuthenticator{name: "corp"}
+	registry := biz.NewAuthenticatorRegistry(google, corp)
+	s := NewOIDCService(&conf.Jwt{}, log.DefaultLogger, nil, registry, []string{corp.name}, nil)
+
+	// 内置登录方式虽然在同一个注册表中, 也不能通过 LoginWithOIDC 使用
+	_, err := s.Login(context.Background(), &v1.LoginWithOIDCRequest{Provider: google.name, IdToken: "token"})
+	if !errors.Is(err, biz.ErrProviderNotSupported) {
+		t.Fatalf("expected ErrProviderNotSupported, got %v", err)
+	}
+	if google.called {
+		t.Fatal("expected google authenticator not to be called")
+	}
+
+	if _, err = s.Login(context.Background(), &v1.LoginWithOIDCRequest{Provider: corp.name, IdToken: "token"}); err == nil {
+		t.Fatal("expected stub error")
+	}
+	if !corp.called {
+		t.Fatal("expected corp authenticator to be called")
+	}
+}
@@ -2,7 +2,6 @@ package service
 
 import (
 	"context"
-	"errors"
 
 	"github.com/go-kratos/kratos/v2/log"
 	v1 "user-service/api/auth/v1"
@@ -15,51 +14,54 @@ import (
 // 修改PhoneService结构体
 
 type PhoneService struct {
-	log        *log.Helper
-	cfg        *conf.Jwt
-	userCase   *biz.UserCase
-	jwtGen     *jwt.Generator
-	smsService sms.Service // 添加SMS服务
+	log          *log.Helper
+	cfg          *conf.Jwt
+	userCase     *biz.UserCase
+	userAuthCase *biz.UserAuthCase
+	sessionCase  *biz.SessionCase
+	smsService   sms.Service // 添加SMS服务
 }
 
 // 修改NewPhoneService函数
 
-func NewPhoneService(cfg *conf.Jwt, logger log.Logger, userCase *biz.UserCase) *PhoneService {
+func NewPhoneService(cfg *conf.Jwt, logger log.Logger, userCase *biz.UserCase, userAuthCase *biz.UserAuthCase, sessionCase *biz.SessionCase) *PhoneService {
 	// 创建SMS服务
 	smsConfig := sms.DefaultConfig()
 	smsService := sms.NewService(smsConfig)
 
 	return &PhoneService{
-		cfg:        cfg,
-		log:        log.NewHelper(logger),
-		userCase:   userCase,
-		jwtGen:     jwt.NewGenerator(cfg.Secret, int(cfg.Expires)),
-		smsService: smsService,
+		cfg:          cfg,
+		log:          log.NewHelper(logger),
+		userCase:     userCase,
+		userAuthCase: userAuthCase,
+		sessionCase:  sessionCase,
+		smsService:   smsService,
 	}
 }
 
 func (s *PhoneService) Login(ctx context.Context, req *v1.LoginWithPhoneRequest) (*v1.LoginResponse, error) {
-	// 验证手机号格式
-	if !isValidPhone(req.PhoneNumber) {
-		return nil, errors.New("invalid phone number")
+	// 验证手机号格式, 验证码按规范化后的号码发送和校验
+	phone, err := s.userCase.NormalizePhone(req.PhoneNumber)
+	if err != nil {
+		return nil, err
 	}
 
 	// 测试不收验证码
-	code, _ := s.smsService.SendVerificationCode(ctx, req.PhoneNumber)
+	code, _ := s.smsService.SendVerificationCode(ctx, phone)
 
 	// 验证验证码
-	if err := s.smsService.VerifyCode(ctx, req.PhoneNumber, code); err != nil {
+	if err := s.smsService.VerifyCode(ctx, phone, code); err != nil {
 		return nil, err
 	}
 
 	// 查找或创建用户
-	u, isNew, err := s.userCase.FindOrCreateByPhone(ctx, req.PhoneNumber)
+	u, isNew, err := s.userCase.FindOrCreateByPhone(ctx, phone)
 	if err != nil {
 		return nil, err
 	}
 
 	// 生成JWT token
-	token, err := s.jwtGen.GenerateToken(u.UserID)
+	token, err := s.sessionCase.Issue(ctx, u.UserID, "phone")
 	if err != nil {
 		return nil, err
 	}
@@ -68,16 +70,32 @@ func (s *PhoneService) Login(ctx context.Context, req *v1.LoginWithPhoneRequest)
 	return &v1.LoginResponse{
 		Token:     token,
 		IsNewUser: isNew,
-		UserInfo: &v1.UserInfo{
-			UserId: u.UserID,
-			Name:   u.Name,
-			Phone:  u.Phone,
-		},
+		UserInfo:  toUserInfo(u),
 	}, nil
 }
 
-// 简单的手机号格式验证
-func isValidPhone(phone string) bool {
-	// 实际应用中应该使用更严格的验证
-	return len(phone) >= 10 && len(phone) <= 15
+// Link 为已登录用户绑定手机号
+func (s *PhoneService) Link(ctx context.Context, req *v1.LinkPhoneRequest) (*v1.LinkPhoneReply, error) {
+	userID, ok := jwt.FromContext(ctx)
+	if !ok {
+		return nil, biz.ErrUnauthenticated
+	}
+	// 验证手机号格式
+	phone, err := s.userCase.NormalizePhone(req.PhoneNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	// 绑定手机号必须校验用户提交的验证码
+	if err := s.smsService.VerifyCode(ctx, phone, req.VerificationCode); err != nil {
+		return nil, err
+	}
+
+	u, err := s.userAuthCase.LinkPhone(ctx, userID, phone)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.LinkPhoneReply{
+		UserInfo: toUserInfo(u),
+	}, nil
 }
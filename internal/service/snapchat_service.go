@@ -13,7 +13,6 @@ import (
 	v1 "user-service/api/auth/v1"
 	"user-service/internal/biz"
 	"user-service/internal/conf"
-	"user-service/third_party/jwt"
 )
 
 type SnapchatService struct {
@@ -21,17 +20,17 @@ type SnapchatService struct {
 	log          *log.Helper
 	userAuthCase *biz.UserAuthCase
 	userCase     *biz.UserCase
-	jwtGen       *jwt.Generator
+	sessionCase  *biz.SessionCase
 	httpClient   *http.Client
 }
 
-func NewSnapchatService(cfg *conf.Jwt, logger log.Logger, userAuthCase *biz.UserAuthCase, userCase *biz.UserCase) *SnapchatService {
+func NewSnapchatService(cfg *conf.Jwt, logger log.Logger, userAuthCase *biz.UserAuthCase, userCase *biz.UserCase, sessionCase *biz.SessionCase) *SnapchatService {
 	return &SnapchatService{
 		cfg:          cfg,
 		log:          log.NewHelper(logger),
 		userAuthCase: userAuthCase,
 		userCase:     userCase,
-		jwtGen:       jwt.NewGenerator(cfg.Secret, int(cfg.Expires)),
+		sessionCase:  sessionCase,
 		httpClient:   &http.Client{Timeout: 10 * time.Second},
 	}
 }
@@ -61,42 +60,20 @@ func (s *SnapchatService) Login(ctx context.Context, req *v1.LoginWithSnapchatRe
 		return nil, errors.New("access_token is required")
 	}
 
-	// 验证 access token
-	tokenInfo, err := s.verifyAccessToken(ctx, req.AccessToken)
+	// 验证 access token 并获取用户信息
+	identity, err := s.Authenticate(ctx, &biz.Credential{AccessToken: req.AccessToken})
 	if err != nil {
 		return nil, fmt.Errorf("failed to verify snapchat access token: %w", err)
 	}
 
-	if !tokenInfo.Data.Valid {
-		return nil, errors.New("invalid or expired access token")
-	}
-
-	// 获取用户信息
-	userInfo, err := s.getUserInfo(ctx, req.AccessToken)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get snapchat user info: %w", err)
-	}
-
 	// 查找或创建用户
-	u, isNew, err := s.userAuthCase.FindOrCreateBySnapchatID(ctx,
-		tokenInfo.Data.UserID,
-		userInfo.Data.DisplayName,
-	)
+	u, isNew, err := s.userAuthCase.FindOrCreateByIdentity(ctx, identity)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find or create user: %w", err)
 	}
 
-	// 更新用户头像（如果有）
-	if userInfo.Data.Bitmoji.AvatarURL != "" && u.Avatar != userInfo.Data.Bitmoji.AvatarURL {
-		u.Avatar = userInfo.Data.Bitmoji.AvatarURL
-
-		if _, err = s.userCase.Update(ctx, u); err != nil {
-			s.log.Errorf("failed to update user avatar, error: %v", err)
-		}
-	}
-
 	// 生成 JWT token
-	token, err := s.jwtGen.GenerateToken(u.UserID)
+	token, err := s.sessionCase.Issue(ctx, u.UserID, "snapchat")
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate token: %w", err)
 	}
@@ -105,11 +82,7 @@ func (s *SnapchatService) Login(ctx context.Context, req *v1.LoginWithSnapchatRe
 	return &v1.LoginResponse{
 		Token:     token,
 		IsNewUser: isNew,
-		UserInfo: &v1.UserInfo{
-			UserId: u.UserID,
-			Name:   u.Name,
-			Avatar: u.Avatar,
-		},
+		UserInfo:  toUserInfo(u),
 	}, nil
 }
 
@@ -193,3 +166,35 @@ func (s *SnapchatService) getUserInfo(ctx context.Context, accessToken string) (
 
 	return &userResponse, nil
 }
+
+// Provider 实现 biz.Authenticator
+func (s *SnapchatService) Provider() string {
+	return "snapchat"
+}
+
+// Authenticate 实现 biz.Authenticator, 用于关联账号
+func (s *SnapchatService) Authenticate(ctx context.Context, cred *biz.Credential) (*biz.Identity, error) {
+	if cred.AccessToken == "" {
+		return nil, errors.New("access_token is required")
+	}
+	tokenInfo, err := s.verifyAccessToken(ctx, cred.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if !tokenInfo.Data.Valid {
+		return nil, errors.New("invalid or expired access token")
+	}
+	userInfo, err := s.getUserInfo(ctx, cred.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &biz.Identity{
+		Provider:    "snapchat",
+		Subject:     tokenInfo.Data.UserID,
+		Name:        userInfo.Data.DisplayName,
+		Avatar:      userInfo.Data.Bitmoji.AvatarURL,
+		RawClaims:   rawClaims(userInfo.Data),
+		AccessToken: cred.AccessToken,
+	}, nil
+}
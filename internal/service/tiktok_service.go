@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"user-service/internal/biz"
+	"user-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	tiktokTokenURL    = "https://open.tiktokapis.com/v2/oauth/token/"
+	tiktokUserInfoURL = "https://open.tiktokapis.com/v2/user/info/?fields=open_id,union_id,avatar_url,display_name"
+)
+
+// TikTokService TikTok 登录, 作为认证器注册到 OAuth 登录中
+type TikTokService struct {
+	log        *log.Helper
+	cfg        *conf.Auth_TikTok
+	httpClient *http.Client
+}
+
+// TikTokTokenResponse 令牌响应结构
+type TikTokTokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	ExpiresIn        int64  `json:"expires_in"`
+	OpenID           string `json:"open_id"`
+	RefreshToken     string `json:"refresh_token"`
+	Scope            string `json:"scope"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// TikTokUserResponse 用户信息响应结构
+type TikTokUserResponse struct {
+	Data struct {
+		User struct {
+			OpenID      string `json:"open_id"`
+			UnionID     string `json:"union_id"`
+			AvatarURL   string `json:"avatar_url"`
+			DisplayName string `json:"display_name"`
+		} `json:"user"`
+	} `json:"data"`
+	Error struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func NewTikTokService(authCfg *conf.Auth, logger log.Logger) *TikTokService {
+	return &TikTokService{
+		log:        log.NewHelper(logger),
+		cfg:        authCfg.GetTiktok(),
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (s *TikTokService) Provider() string {
+	return "tiktok"
+}
+
+func (s *TikTokService) Authenticate(ctx context.Context, cred *biz.Credential) (*biz.Identity, error) {
+	if s.cfg.GetClientKey() == "" {
+		return nil, biz.ErrProviderNotSupported
+	}
+
+	// 授权码 + code_verifier 换取 access_token
+	var token TikTokTokenResponse
+	form := url.Values{
+		"client_key":    {s.cfg.GetClientKey()},
+		"client_secret": {s.cfg.GetClientSecret()},
+		"code":          {cred.Code},
+		"grant_type":    {"authorization_code"},
+		"redirect_uri":  {cred.RedirectURI},
+		"code_verifier": {cred.CodeVerifier},
+	}
+	if err := postForm(ctx, s.httpClient, endpointOr(s.cfg.GetTokenUrl(), tiktokTokenURL), form, nil, &token); err != nil {
+		return nil, err
+	}
+	// TikTok 出错时同样返回 200
+	if token.Error != "" {
+		return nil, fmt.Errorf("tiktok token error: %s %s", token.Error, token.ErrorDescription)
+	}
+	if token.AccessToken == "" || token.OpenID == "" {
+		return nil, errors.New("empty tiktok access token")
+	}
+
+	// 获取用户信息
+	var userInfo TikTokUserResponse
+	if err := getJSON(ctx, s.httpClient, endpointOr(s.cfg.GetUserinfoUrl(), tiktokUserInfoURL), token.AccessToken, &userInfo); err != nil {
+		return nil, err
+	}
+	if userInfo.Error.Code != "" && userInfo.Error.Code != "ok" {
+		return nil, fmt.Errorf("tiktok user info error: %s %s", userInfo.Error.Code, userInfo.Error.Message)
+	}
+
+	return &biz.Identity{
+		Provider:       s.Provider(),
+		Subject:        token.OpenID,
+		Name:           userInfo.Data.User.DisplayName,
+		Avatar:         userInfo.Data.User.AvatarURL,
+		RawClaims:      rawClaims(userInfo.Data.User),
+		AccessToken:    token.AccessToken,
+		RefreshToken:   token.RefreshToken,
+		TokenExpiresAt: expiresAt(token.ExpiresIn),
+	}, nil
+}
@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+
+	v1 "user-service/api/user/v1"
+	"user-service/internal/biz"
+	"user-service/third_party/jwt"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// UserService 用户资料查询和修改
+type UserService struct {
+	v1.UnimplementedUserServiceServer
+	log          *log.Helper
+	userCase     *biz.UserCase
+	handleCase   *biz.HandleCase
+	deletionCase *biz.DeletionCase
+	exportCase   *biz.ExportCase
+}
+
+func NewUserService(logger log.Logger, userCase *biz.UserCase, handleCase *biz.HandleCase, deletionCase *biz.DeletionCase, exportCase *biz.ExportCase) *UserService {
+	return &UserService{
+		log:          log.NewHelper(logger),
+		userCase:     userCase,
+		handleCase:   handleCase,
+		deletionCase: deletionCase,
+		exportCase:   exportCase,
+	}
+}
+
+// GetMe 当前登录用户的资料
+func (s *UserService) GetMe(ctx context.Context, _ *v1.GetMeRequest) (*v1.Profile, error) {
+	userID, ok := jwt.FromContext(ctx)
+	if !ok {
+		return nil, biz.ErrUnauthenticated
+	}
+	u, err := s.userCase.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toProfile(u), nil
+}
+
+// GetUser 其他用户的公开资料
+func (s *UserService) GetUser(ctx context.Context, req *v1.GetUserRequest) (*v1.PublicProfile, error) {
+	u, err := s.userCase.GetProfile(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return toPublicProfile(u), nil
+}
+
+// UpdateProfile 按 update_mask 更新当前用户的资料
+func (s *UserService) UpdateProfile(ctx context.Context, req *v1.UpdateProfileRequest) (*v1.Profile, error) {
+	userID, ok := jwt.FromContext(ctx)
+	if !ok {
+		return nil, biz.ErrUnauthenticated
+	}
+	if req.Profile == nil || len(req.GetUpdateMask().GetPaths()) == 0 {
+		return nil, biz.ErrInvalidFieldMask
+	}
+
+	update := &biz.ProfileUpdate{}
+	for _, path := range req.UpdateMask.Paths {
+		switch path {
+		case "name":
+			update.Name = &req.Profile.Name
+		case "birthday":
+			birthday, err := biz.ParseBirthday(req.Profile.Birthday)
+			if err != nil {
+				return nil, err
+			}
+			update.Birthday = &birthday
+		case "gender":
+			update.Gender = &req.Profile.Gender
+		case "locale":
+			update.Locale = &req.Profile.Locale
+		case "timezone":
+			update.Timezone = &req.Profile.Timezone
+		case "country":
+			update.Country = &req.Profile.Country
+		case "bio":
+			update.Bio = &req.Profile.Bio
+		case "metadata":
+			// 整体替换, 空 map 表示清空
+			update.Metadata = req.Profile.Metadata
+			if update.Metadata == nil {
+				update.Metadata = map[string]string{}
+			}
+		default:
+			return nil, biz.ErrInvalidFieldMask.WithMetadata(map[string]string{"path": path})
+		}
+	}
+
+	u, err := s.userCase.UpdateProfile(ctx, userID, update)
+	if err != nil {
+		return nil, err
+	}
+	return toProfile(u), nil
+}
+
+// CheckHandle 检查 handle 是否可用
+func (s *UserService) CheckHandle(ctx context.Context, req *v1.CheckHandleRequest) (*v1.CheckHandleReply, error) {
+	userID, ok := jwt.FromContext(ctx)
+	if !ok {
+		return nil, biz.ErrUnauthenticated
+	}
+	reason, err := s.handleCase.Check(ctx, userID, req.Handle)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CheckHandleReply{Available: reason == "", Reason: reason}, nil
+}
+
+// ChangeHandle 修改当前用户的 handle
+func (s *UserService) ChangeHandle(ctx context.Context, req *v1.ChangeHandleRequest) (*v1.Profile, error) {
+	userID, ok := jwt.FromContext(ctx)
+	if !ok {
+		return nil, biz.ErrUnauthenticated
+	}
+	u, err := s.handleCase.Change(ctx, userID, req.Handle)
+	if err != nil {
+		return nil, err
+	}
+	return toProfile(u), nil
+}
+
+// GetUserByHandle 按 handle 查找用户的公开资料
+func (s *UserService) GetUserByHandle(ctx context.Context, req *v1.GetUserByHandleRequest) (*v1.PublicProfile, error) {
+	u, err := s.handleCase.Resolve(ctx, req.Handle)
+	if err != nil {
+		return nil, err
+	}
+	return toPublicProfile(u), nil
+}
+
+// DeleteAccount 申请注销当前账号
+func (s *UserService) DeleteAccount(ctx context.Context, _ *v1.DeleteAccountRequest) (*v1.DeleteAccountReply, error) {
+	claims, ok := jwt.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, biz.ErrUnauthenticated
+	}
+	scheduledAt, err := s.deletionCase.Request(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.DeleteAccountReply{ScheduledAt: scheduledAt.Unix()}, nil
+}
+
+// BatchGetUsers 批量查询公开资料, read_mask 为空时返回全部字段
+func (s *UserService) BatchGetUsers(ctx context.Context, req *v1.BatchGetUsersRequest) (*v1.BatchGetUsersReply, error) {
+	paths := req.GetReadMask().GetPaths()
+	for _, path := range paths {
+		switch path {
+		case "name", "avatar", "bio", "handle":
+		default:
+			return nil, biz.ErrInvalidFieldMask.WithMetadata(map[string]string{"path": path})
+		}
+	}
+
+	users, missing, err := s.userCase.BatchGetProfiles(ctx, req.UserIds)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.BatchGetUsersReply{
+		Users:          make(map[int64]*v1.PublicProfile, len(users)),
+		MissingUserIds: missing,
+	}
+	for id, u := range users {
+		reply.Users[id] = maskPublicProfile(toPublicProfile(u), paths)
+	}
+	return reply, nil
+}
+
+// maskPublicProfile 只保留 paths 中的字段, paths 为空时保留全部
+func maskPublicProfile(p *v1.PublicProfile, paths []string) *v1.PublicProfile {
+	if len(paths) == 0 {
+		return p
+	}
+	masked := &v1.PublicProfile{UserId: p.UserId}
+	for _, path := range paths {
+		switch path {
+		case "name":
+			masked.Name = p.Name
+		case "avatar":
+			masked.Avatar = p.Avatar
+		case "bio":
+			masked.Bio = p.Bio
+		case "handle":
+			masked.Handle = p.Handle
+		}
+	}
+	return masked
+}
+
+func toPublicProfile(u *biz.User) *v1.PublicProfile {
+	return &v1.PublicProfile{
+		UserId: u.UserID,
+		Name:   u.Name,
+		Avatar: u.Avatar,
+		Bio:    u.Bio,
+		Handle: u.Handle,
+	}
+}
+
+func toProfile(u *biz.User) *v1.Profile {
+	return &v1.Profile{
+		UserId:        u.UserID,
+		Name:          u.Name,
+		Avatar:        u.Avatar,
+		Phone:         u.Phone,
+		Email:         u.Email,
+		EmailVerified: u.EmailVerified,
+		CreatedAt:     u.CreatedAt.Unix(),
+		Birthday:      biz.FormatBirthday(u.Birthday),
+		Gender:        u.Gender,
+		Locale:        u.Locale,
+		Timezone:      u.Timezone,
+		Country:       u.Country,
+		Bio:           u.Bio,
+		Metadata:      u.Metadata,
+		Handle:        u.Handle,
+	}
+}
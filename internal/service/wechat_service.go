@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	v1 "user-service/api/auth/v1"
+	"user-service/internal/biz"
+	"user-service/internal/conf"
+	"user-service/third_party/wechat"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type WechatService struct {
+	cfg          *conf.Jwt
+	wechatCfg    *conf.Auth_Wechat
+	log          *log.Helper
+	userAuthCase *biz.UserAuthCase
+	sessionCase  *biz.SessionCase
+	client       *wechat.Client
+}
+
+func NewWechatService(cfg *conf.Jwt, authCfg *conf.Auth, logger log.Logger, userAuthCase *biz.UserAuthCase, sessionCase *biz.SessionCase) *WechatService {
+	wechatCfg := authCfg.GetWechat()
+	return &WechatService{
+		cfg:          cfg,
+		wechatCfg:    wechatCfg,
+		log:          log.NewHelper(logger),
+		userAuthCase: userAuthCase,
+		sessionCase:  sessionCase,
+		client:       wechat.NewClient(wechatCfg.GetBaseUrl(), &http.Client{Timeout: 10 * time.Second}),
+	}
+}
+
+// Login 移动应用登录: code 换取 access_token, 再获取用户信息
+func (s *WechatService) Login(ctx context.Context, req *v1.LoginWithWechatRequest) (*v1.LoginResponse, error) {
+	// 验证参数
+	if req.Code == "" {
+		return nil, errors.New("code is required")
+	}
+	app := s.wechatCfg.GetApp()
+	if app.GetAppId() == "" {
+		return nil, biz.ErrProviderNotSupported
+	}
+
+	// code 换取 access_token
+	token, err := s.client.OAuthAccessToken(ctx, app.GetAppId(), app.GetAppSecret(), req.Code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange wechat code: %w", err)
+	}
+
+	// 获取用户信息
+	userInfo, err := s.client.UserInfo(ctx, token.AccessToken, token.OpenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wechat user info: %w", err)
+	}
+
+	unionID := token.UnionID
+	if unionID == "" {
+		unionID = userInfo.UnionID
+	}
+
+	return s.login(ctx, &biz.WechatIdentity{
+		AppID:   app.GetAppId(),
+		OpenID:  token.OpenID,
+		UnionID: unionID,
+		Name:    userInfo.Nickname,
+		Avatar:  userInfo.HeadImgURL,
+	})
+}
+
+// LoginMiniProgram 小程序登录: wx.login 的 code 调用 code2session
+func (s *WechatService) LoginMiniProgram(ctx context.Context, req *v1.LoginWithWechatMiniProgramRequest) (*v1.LoginResponse, error) {
+	// 验证参数
+	if req.Code == "" {
+		return nil, errors.New("code is required")
+	}
+	mp := s.wechatCfg.GetMiniProgram()
+	if mp.GetAppId() == "" {
+		return nil, biz.ErrProviderNotSupported
+	}
+
+	session, err := s.client.Code2Session(ctx, mp.GetAppId(), mp.GetAppSecret(), req.Code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call wechat code2session: %w", err)
+	}
+
+	// 小程序拿不到昵称头像, 只记录身份
+	return s.login(ctx, &biz.WechatIdentity{
+		AppID:   mp.GetAppId(),
+		OpenID:  session.OpenID,
+		UnionID: session.UnionID,
+	})
+}
+
+func (s *WechatService) login(ctx context.Context, identity *biz.WechatIdentity) (*v1.LoginResponse, error) {
+	// 查找或创建用户
+	u, isNew, err := s.userAuthCase.FindOrCreateByWechat(ctx, identity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find or create user: %w", err)
+	}
+
+	// 生成 JWT token
+	token, err := s.sessionCase.Issue(ctx, u.UserID, "wechat")
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	// 构建响应
+	return &v1.LoginResponse{
+		Token:     token,
+		IsNewUser: isNew,
+		UserInfo:  toUserInfo(u),
+	}, nil
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"user-service/internal/biz"
+	"user-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	xTokenURL    = "https://api.x.com/2/oauth2/token"
+	xUserInfoURL = "https://api.x.com/2/users/me?user.fields=profile_image_url"
+)
+
+// XService X (Twitter) 登录, 作为认证器注册到 OAuth 登录中
+type XService struct {
+	log        *log.Helper
+	cfg        *conf.Auth_X
+	httpClient *http.Client
+}
+
+// XTokenResponse 令牌响应结构
+type XTokenResponse struct {
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	Scope        string `json:"scope"`
+}
+
+// XUserResponse 用户信息响应结构
+type XUserResponse struct {
+	Data struct {
+		ID              string `json:"id"`
+		Name            string `json:"name"`
+		Username        string `json:"username"`
+		ProfileImageURL string `json:"profile_image_url"`
+	} `json:"data"`
+}
+
+func NewXService(authCfg *conf.Auth, logger log.Logger) *XService {
+	return &XService{
+		log:        log.NewHelper(logger),
+		cfg:        authCfg.GetX(),
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (s *XService) Provider() string {
+	return "x"
+}
+
+func (s *XService) Authenticate(ctx context.Context, cred *biz.Credential) (*biz.Identity, error) {
+	if s.cfg.GetClientId() == "" {
+		return nil, biz.ErrProviderNotSupported
+	}
+
+	// 授权码 + code_verifier 换取 access_token
+	var token XTokenResponse
+	form := url.Values{
+		"code":          {cred.Code},
+		"grant_type":    {"authorization_code"},
+		"redirect_uri":  {cred.RedirectURI},
+		"code_verifier": {cred.CodeVerifier},
+		"client_id":     {s.cfg.GetClientId()},
+	}
+	err := postForm(ctx, s.httpClient, endpointOr(s.cfg.GetTokenUrl(), xTokenURL), form, func(req *http.Request) {
+		// 机密客户端使用 Basic 认证
+		if s.cfg.GetClientSecret() != "" {
+			req.SetBasicAuth(url.QueryEscape(s.cfg.GetClientId()), url.QueryEscape(s.cfg.GetClientSecret()))
+		}
+	}, &token)
+	if err != nil {
+		return nil, err
+	}
+	if token.AccessToken == "" {
+		return nil, errors.New("empty x access token")
+	}
+
+	// 获取用户信息
+	var userInfo XUserResponse
+	if err = getJSON(ctx, s.httpClient, endpointOr(s.cfg.GetUserinfoUrl(), xUserInfoURL), token.AccessToken, &userInfo); err != nil {
+		return nil, err
+	}
+	if userInfo.Data.ID == "" {
+		return nil, errors.New("invalid x user response")
+	}
+
+	name := userInfo.Data.Name
+	if name == "" {
+		name = userInfo.Data.Username
+	}
+	return &biz.Identity{
+		Provider:       s.Provider(),
+		Subject:        userInfo.Data.ID,
+		Name:           name,
+		Avatar:         userInfo.Data.ProfileImageURL,
+		RawClaims:      rawClaims(userInfo.Data),
+		AccessToken:    token.AccessToken,
+		RefreshToken:   token.RefreshToken,
+		TokenExpiresAt: expiresAt(token.ExpiresIn),
+	}, nil
+}
+
+// endpointOr 返回配置的接口地址, 未配置时使用默认地址
+func endpointOr(configured, fallback string) string {
+	if configured != "" {
+		return configured
+	}
+	return fallback
+}
@@ -0,0 +1,176 @@
+package apple
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	// DefaultBaseURL Sign in with Apple 接口地址, 同时是 client_secret 的 aud
+	DefaultBaseURL = "https://appleid.apple.com"
+	// clientSecretTTL client_secret 有效期, Apple 允许最长 6 个月
+	clientSecretTTL = 30 * 24 * time.Hour
+)
+
+// Config Sign in with Apple 签名配置
+type Config struct {
+	TeamID   string
+	ClientID string
+	KeyID    string
+	// PrivateKey 开发者后台下载的 .p8 私钥
+	PrivateKey *ecdsa.PrivateKey
+	// BaseURL 为空时使用 Apple 正式地址
+	BaseURL string
+}
+
+// Error Apple 接口返回的错误
+type Error struct {
+	StatusCode int
+	Code       string `json:"error"`
+	Desc       string `json:"error_description"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("apple: status=%d error=%s %s", e.StatusCode, e.Code, e.Desc)
+}
+
+// TokenResponse 授权码换取的令牌
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token"`
+	IDToken      string `json:"id_token"`
+}
+
+// Client Sign in with Apple 服务端接口客户端
+type Client struct {
+	cfg        Config
+	baseURL    string
+	httpClient *http.Client
+}
+
+// LoadPrivateKey 读取 PKCS#8 PEM 格式的 .p8 私钥
+func LoadPrivateKey(path string) (*ecdsa.PrivateKey, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, errors.New("apple: invalid private key pem")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	ecKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("apple: private key is not ecdsa")
+	}
+	return ecKey, nil
+}
+
+// NewClient 创建 Apple 客户端
+func NewClient(cfg Config, httpClient *http.Client) *Client {
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &Client{
+		cfg:        cfg,
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: httpClient,
+	}
+}
+
+// ClientSecret 使用 .p8 私钥签发 ES256 client_secret
+func (c *Client) ClientSecret() (string, error) {
+	if c.cfg.PrivateKey == nil {
+		return "", errors.New("apple: private key not configured")
+	}
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.RegisteredClaims{
+		Issuer:    c.cfg.TeamID,
+		Subject:   c.cfg.ClientID,
+		Audience:  jwt.ClaimStrings{DefaultBaseURL},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(clientSecretTTL)),
+	})
+	token.Header["kid"] = c.cfg.KeyID
+	return token.SignedString(c.cfg.PrivateKey)
+}
+
+// ExchangeCode 使用授权码换取令牌, 其中的 refresh_token 用于注销账号时撤销授权
+func (c *Client) ExchangeCode(ctx context.Context, code, redirectURI string) (*TokenResponse, error) {
+	form := url.Values{
+		"grant_type": {"authorization_code"},
+		"code":       {code},
+	}
+	if redirectURI != "" {
+		form.Set("redirect_uri", redirectURI)
+	}
+
+	var token TokenResponse
+	if err := c.post(ctx, "/auth/token", form, &token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// Revoke 撤销 refresh_token 或 access_token
+func (c *Client) Revoke(ctx context.Context, token, tokenTypeHint string) error {
+	return c.post(ctx, "/auth/revoke", url.Values{
+		"token":           {token},
+		"token_type_hint": {tokenTypeHint},
+	}, nil)
+}
+
+func (c *Client) post(ctx context.Context, path string, form url.Values, out interface{}) error {
+	secret, err := c.ClientSecret()
+	if err != nil {
+		return err
+	}
+	form.Set("client_id", c.cfg.ClientID)
+	form.Set("client_secret", secret)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		apiErr := &Error{StatusCode: resp.StatusCode}
+		_ = json.NewDecoder(resp.Body).Decode(apiErr)
+		return apiErr
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,114 @@
+package apple
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// newStandIn 启动一个模拟 Apple 接口的本地服务, 校验 client_secret 签名
+func newStandIn(t *testing.T, key *ecdsa.PrivateKey) *httptest.Server {
+	t.Helper()
+	checkSecret := func(r *http.Request) bool {
+		token, err := jwt.Parse(r.PostFormValue("client_secret"), func(token *jwt.Token) (interface{}, error) {
+			return &key.PublicKey, nil
+		}, jwt.WithValidMethods([]string{"ES256"}))
+		if err != nil || !token.Valid || token.Header["kid"] != "key-1" {
+			return false
+		}
+		claims := token.Claims.(jwt.MapClaims)
+		return claims["iss"] == "team-1" && claims["sub"] == "com.example.app" && r.PostFormValue("client_id") == "com.example.app"
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/auth/token", func(w http.ResponseWriter, r *http.Request) {
+		if !checkSecret(r) {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_client"})
+			return
+		}
+		if r.PostFormValue("code") != "good-code" {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token":  "access-1",
+			"refresh_token": "refresh-1",
+			"expires_in":    3600,
+		})
+	})
+	mux.HandleFunc("/auth/revoke", func(w http.ResponseWriter, r *http.Request) {
+		if !checkSecret(r) || r.PostFormValue("token") != "refresh-1" || r.PostFormValue("token_type_hint") != "refresh_token" {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_request"})
+			return
+		}
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestExchangeAndRevoke(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating key, %s", err)
+	}
+	srv := newStandIn(t, key)
+	c := NewClient(Config{TeamID: "team-1", ClientID: "com.example.app", KeyID: "key-1", PrivateKey: key, BaseURL: srv.URL}, srv.Client())
+	ctx := context.Background()
+
+	token, err := c.ExchangeCode(ctx, "good-code", "")
+	if err != nil {
+		t.Fatalf("error exchanging code, %s", err)
+	}
+	if token.RefreshToken != "refresh-1" {
+		t.Fatalf("unexpected token %+v", token)
+	}
+
+	_, err = c.ExchangeCode(ctx, "bad-code", "")
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Code != "invalid_grant" {
+		t.Fatalf("expected invalid_grant, got %v", err)
+	}
+
+	if err = c.Revoke(ctx, token.RefreshToken, "refresh_token"); err != nil {
+		t.Fatalf("error revoking token, %s", err)
+	}
+}
+
+func TestLoadPrivateKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating key, %s", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("error marshaling key, %s", err)
+	}
+	path := filepath.Join(t.TempDir(), "AuthKey.p8")
+	if err = os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("error writing key, %s", err)
+	}
+
+	loaded, err := LoadPrivateKey(path)
+	if err != nil {
+		t.Fatalf("error loading key, %s", err)
+	}
+	if !loaded.Equal(key) {
+		t.Fatal("loaded key does not match")
+	}
+}
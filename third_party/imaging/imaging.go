@@ -0,0 +1,137 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"net/http"
+
+	// 注册支持的图片格式
+	_ "image/gif"
+	_ "image/png"
+)
+
+var (
+	// ErrUnsupportedFormat 不是支持的图片格式
+	ErrUnsupportedFormat = errors.New("imaging: unsupported image format")
+	// ErrTooLarge 图片尺寸超过限制
+	ErrTooLarge = errors.New("imaging: image dimensions too large")
+)
+
+// ContentTypes 支持的图片类型
+var ContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+}
+
+// DetectContentType 根据文件内容判断图片类型, 不信任客户端或上游声明的类型
+func DetectContentType(data []byte) (string, error) {
+	contentType := http.DetectContentType(data)
+	if !ContentTypes[contentType] {
+		return "", ErrUnsupportedFormat
+	}
+	return contentType, nil
+}
+
+// Decode 校验类型和尺寸后解码图片, 先读取头部尺寸, 避免解码超大图片耗尽内存
+// JPEG 会按 EXIF 方向摆正, 之后重新编码时不再需要保留 EXIF
+func Decode(data []byte, maxDimension int) (image.Image, string, error) {
+	contentType, err := DetectContentType(data)
+	if err != nil {
+		return nil, "", err
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", err
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		return nil, "", ErrUnsupportedFormat
+	}
+	if maxDimension > 0 && (cfg.Width > maxDimension || cfg.Height > maxDimension) {
+		return nil, "", ErrTooLarge
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", err
+	}
+	if contentType == "image/jpeg" {
+		img = Orient(img, Orientation(data))
+	}
+	return img, contentType, nil
+}
+
+// Thumbnail 居中裁剪为正方形并缩放到 size x size, 透明区域填充白色
+func Thumbnail(src image.Image, size int) *image.RGBA {
+	b := src.Bounds()
+	side := b.Dx()
+	if b.Dy() < side {
+		side = b.Dy()
+	}
+	crop := image.Rect(0, 0, side, side)
+	origin := image.Pt(b.Min.X+(b.Dx()-side)/2, b.Min.Y+(b.Dy()-side)/2)
+
+	// 先合成到白色背景, 之后直接操作像素
+	square := image.NewRGBA(crop)
+	draw.Draw(square, crop, image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(square, crop, src, origin, draw.Over)
+
+	return resize(square, size)
+}
+
+// resize 把正方形图片缩放到 size x size, 缩小时对覆盖区域取平均, 放大时取最近像素
+func resize(src *image.RGBA, size int) *image.RGBA {
+	side := src.Bounds().Dx()
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	if side == size {
+		copy(dst.Pix, src.Pix)
+		return dst
+	}
+
+	for y := 0; y < size; y++ {
+		y0, y1 := span(y, size, side)
+		for x := 0; x < size; x++ {
+			x0, x1 := span(x, size, side)
+			var r, g, b, a, n int
+			for sy := y0; sy < y1; sy++ {
+				i := src.PixOffset(x0, sy)
+				for sx := x0; sx < x1; sx++ {
+					r += int(src.Pix[i])
+					g += int(src.Pix[i+1])
+					b += int(src.Pix[i+2])
+					a += int(src.Pix[i+3])
+					i += 4
+					n++
+				}
+			}
+			j := dst.PixOffset(x, y)
+			dst.Pix[j] = uint8(r / n)
+			dst.Pix[j+1] = uint8(g / n)
+			dst.Pix[j+2] = uint8(b / n)
+			dst.Pix[j+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
+
+// span 目标像素 i 在原图中覆盖的区间, 至少包含一个像素
+func span(i, size, side int) (int, int) {
+	start := i * side / size
+	end := (i + 1) * side / size
+	if end <= start {
+		end = start + 1
+	}
+	return start, end
+}
+
+// EncodeJPEG 编码为 JPEG, 重新编码不会保留原图的 EXIF 等元数据
+func EncodeJPEG(img image.Image, quality int) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
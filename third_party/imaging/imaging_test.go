@@ -0,0 +1,137 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("error encoding png, %s", err)
+	}
+	return buf.Bytes()
+}
+
+func TestDecode(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 40, 20))
+	data := encodePNG(t, img)
+
+	decoded, contentType, err := Decode(data, 100)
+	if err != nil {
+		t.Fatalf("error decoding, %s", err)
+	}
+	if contentType != "image/png" || decoded.Bounds().Dx() != 40 {
+		t.Fatalf("unexpected result %s %v", contentType, decoded.Bounds())
+	}
+
+	if _, _, err = Decode(data, 30); !errors.Is(err, ErrTooLarge) {
+		t.Fatalf("expected ErrTooLarge, got %v", err)
+	}
+	if _, _, err = Decode([]byte("<html></html>"), 100); !errors.Is(err, ErrUnsupportedFormat) {
+		t.Fatalf("expected ErrUnsupportedFormat, got %v", err)
+	}
+}
+
+func TestThumbnail(t *testing.T) {
+	// 左半红色右半蓝色的横图, 居中裁剪后两种颜色各占一半
+	img := image.NewRGBA(image.Rect(0, 0, 200, 100))
+	for y := 0; y < 100; y++ {
+		for x := 0; x < 200; x++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= 100 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			img.Set(x, y, c)
+		}
+	}
+
+	thumb := Thumbnail(img, 10)
+	if thumb.Bounds().Dx() != 10 || thumb.Bounds().Dy() != 10 {
+		t.Fatalf("unexpected bounds %v", thumb.Bounds())
+	}
+	if c := thumb.RGBAAt(0, 5); c.R != 255 || c.B != 0 {
+		t.Fatalf("unexpected left pixel %v", c)
+	}
+	if c := thumb.RGBAAt(9, 5); c.B != 255 || c.R != 0 {
+		t.Fatalf("unexpected right pixel %v", c)
+	}
+
+	// 透明像素填充白色
+	transparent := Thumbnail(image.NewRGBA(image.Rect(0, 0, 4, 4)), 8)
+	if c := transparent.RGBAAt(3, 3); c != (color.RGBA{R: 255, G: 255, B: 255, A: 255}) {
+		t.Fatalf("unexpected transparent pixel %v", c)
+	}
+
+	data, err := EncodeJPEG(thumb, 85)
+	if err != nil {
+		t.Fatalf("error encoding jpeg, %s", err)
+	}
+	if contentType, _ := DetectContentType(data); contentType != "image/jpeg" {
+		t.Fatalf("unexpected content type %s", contentType)
+	}
+}
+
+// withOrientation 在 JPEG 的 SOI 之后插入只包含 Orientation 的 EXIF 段
+func withOrientation(data []byte, orientation byte) []byte {
+	tiff := []byte{
+		'M', 'M', 0x00, 0x2A, 0x00, 0x00, 0x00, 0x08,
+		0x00, 0x01,
+		0x01, 0x12, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01, 0x00, orientation, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00,
+	}
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	size := len(segment) + 2
+	app1 := append([]byte{0xFF, 0xE1, byte(size >> 8), byte(size)}, segment...)
+
+	out := append([]byte{}, data[:2]...)
+	out = append(out, app1...)
+	return append(out, data[2:]...)
+}
+
+func TestOrientation(t *testing.T) {
+	// 4x2 的横图, 左半红色右半蓝色
+	img := image.NewRGBA(image.Rect(0, 0, 4, 2))
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 4; x++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= 2 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			img.Set(x, y, c)
+		}
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 100}); err != nil {
+		t.Fatalf("error encoding jpeg, %s", err)
+	}
+
+	if o := Orientation(buf.Bytes()); o != 1 {
+		t.Fatalf("expected orientation 1, got %d", o)
+	}
+	data := withOrientation(buf.Bytes(), 6)
+	if o := Orientation(data); o != 6 {
+		t.Fatalf("expected orientation 6, got %d", o)
+	}
+
+	// 顺时针旋转 90° 后变为 2x4, 红色在上
+	decoded, _, err := Decode(data, 100)
+	if err != nil {
+		t.Fatalf("error decoding, %s", err)
+	}
+	if decoded.Bounds().Dx() != 2 || decoded.Bounds().Dy() != 4 {
+		t.Fatalf("unexpected bounds %v", decoded.Bounds())
+	}
+	if r, _, b, _ := decoded.At(0, 0).RGBA(); r < b {
+		t.Fatalf("expected red on top")
+	}
+	if r, _, b, _ := decoded.At(0, 3).RGBA(); b < r {
+		t.Fatalf("expected blue at bottom")
+	}
+}
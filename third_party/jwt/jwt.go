@@ -1,6 +1,8 @@
 package jwt
 
 import (
+	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
@@ -11,6 +13,15 @@ type Generator struct {
 	expires int // 小时
 }
 
+// Claims 登录 token 中的声明
+type Claims struct {
+	UserID int64
+	// SessionID 对应会话记录, 旧版 token 没有该字段
+	SessionID string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
 func NewGenerator(secret string, expires int) *Generator {
 	return &Generator{
 		secret:  secret,
@@ -19,11 +30,23 @@ func NewGenerator(secret string, expires int) *Generator {
 }
 
 func (g *Generator) GenerateToken(userID int64) (string, error) {
+	token, _, err := g.GenerateSessionToken(userID, "")
+	return token, err
+}
+
+// GenerateSessionToken 生成带会话ID(jti)的 token, 返回过期时间
+func (g *Generator) GenerateSessionToken(userID int64, sessionID string) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(time.Hour * time.Duration(g.expires))
+
 	// 创建claims
 	claims := jwt.MapClaims{
 		"user_id": userID,
-		"exp":     time.Now().Add(time.Hour * time.Duration(g.expires)).Unix(),
-		"iat":     time.Now().Unix(),
+		"exp":     expiresAt.Unix(),
+		"iat":     now.Unix(),
+	}
+	if sessionID != "" {
+		claims["jti"] = sessionID
 	}
 
 	// 创建token
@@ -32,5 +55,44 @@ func (g *Generator) GenerateToken(userID int64) (string, error) {
 	// 签名并获取完整的编码后的字符串token
 	tokenString, err := token.SignedString([]byte(g.secret))
 
-	return tokenString, err
+	return tokenString, expiresAt, err
+}
+
+// ParseToken 校验 token 签名和有效期, 返回声明
+func (g *Generator) ParseToken(tokenString string) (*Claims, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		return []byte(g.secret), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithJSONNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	mapClaims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return nil, errors.New("invalid token claims")
+	}
+	// user_id 是雪花ID, 按 float64 解析会丢失低位
+	userID, ok := int64Claim(mapClaims, "user_id")
+	if !ok || userID <= 0 {
+		return nil, errors.New("invalid user_id claim")
+	}
+
+	claims := &Claims{UserID: userID}
+	claims.SessionID, _ = mapClaims["jti"].(string)
+	if iat, ok := int64Claim(mapClaims, "iat"); ok {
+		claims.IssuedAt = time.Unix(iat, 0)
+	}
+	if exp, ok := int64Claim(mapClaims, "exp"); ok {
+		claims.ExpiresAt = time.Unix(exp, 0)
+	}
+	return claims, nil
+}
+
+func int64Claim(claims jwt.MapClaims, key string) (int64, bool) {
+	n, ok := claims[key].(json.Number)
+	if !ok {
+		return 0, false
+	}
+	v, err := n.Int64()
+	return v, err == nil
 }
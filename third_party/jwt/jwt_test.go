@@ -0,0 +1,65 @@
+package jwt
+
+import "testing"
+
+func TestParseToken(t *testing.T) {
+	gen := NewGenerator("secret", 1)
+	token, err := gen.GenerateToken(42)
+	if err != nil {
+		t.Fatalf("error generating token, %s", err)
+	}
+
+	claims, err := gen.ParseToken(token)
+	if err != nil {
+		t.Fatalf("error parsing token, %s", err)
+	}
+	if claims.UserID != 42 || claims.SessionID != "" {
+		t.Fatalf("unexpected claims %+v", claims)
+	}
+
+	token, _, err = gen.GenerateSessionToken(42, "session-1")
+	if err != nil {
+		t.Fatalf("error generating session token, %s", err)
+	}
+	if claims, err = gen.ParseToken(token); err != nil || claims.SessionID != "session-1" {
+		t.Fatalf("unexpected claims %+v, error %v", claims, err)
+	}
+
+	if _, err = NewGenerator("other", 1).ParseToken(token); err == nil {
+		t.Fatal("expected error for wrong secret")
+	}
+	if _, err = NewGenerator("secret", -1).ParseToken(mustToken(t, NewGenerator("secret", -1))); err == nil {
+		t.Fatal("expected error for expired token")
+	}
+}
+
+func TestParseTokenSnowflakeUserID(t *testing.T) {
+	// 雪花ID超过 2^53, 且序号不为 0
+	const userID int64 = 1853445566778899001
+	gen := NewGenerator("secret", 1)
+	for _, sessionID := range []string{"", "session-1"} {
+		token, _, err := gen.GenerateSessionToken(userID, sessionID)
+		if err != nil {
+			t.Fatalf("error generating token, %s", err)
+		}
+		claims, err := gen.ParseToken(token)
+		if err != nil {
+			t.Fatalf("error parsing token, %s", err)
+		}
+		if claims.UserID != userID {
+			t.Fatalf("expected user_id %d, got %d", userID, claims.UserID)
+		}
+		if claims.ExpiresAt.IsZero() || claims.IssuedAt.IsZero() {
+			t.Fatalf("expected iat and exp, got %+v", claims)
+		}
+	}
+}
+
+func mustToken(t *testing.T, gen *Generator) string {
+	t.Helper()
+	token, err := gen.GenerateToken(42)
+	if err != nil {
+		t.Fatalf("error generating token, %s", err)
+	}
+	return token
+}
@@ -0,0 +1,178 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrKeyNotFound JWKS 中找不到对应 kid 的公钥
+var ErrKeyNotFound = errors.New("oidc: signing key not found")
+
+// minRefreshInterval 两次拉取 JWKS 的最小间隔
+const minRefreshInterval = time.Minute
+
+// jsonWebKey JWKS 中的单个公钥
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// KeySet 远程 JWKS 公钥集合, 按 kid 缓存, 遇到未知 kid 时刷新
+type KeySet struct {
+	url        string
+	httpClient *http.Client
+	ttl        time.Duration
+
+	mu        sync.RWMutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+// NewKeySet 创建 JWKS 公钥集合
+func NewKeySet(url string, httpClient *http.Client) *KeySet {
+	return &KeySet{
+		url:        url,
+		httpClient: httpClient,
+		ttl:        time.Hour,
+		keys:       make(map[string]crypto.PublicKey),
+	}
+}
+
+// Key 根据 kid 获取公钥
+func (ks *KeySet) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	ks.mu.RLock()
+	key, ok := ks.keys[kid]
+	age := time.Since(ks.fetchedAt)
+	ks.mu.RUnlock()
+	if ok && age < ks.ttl {
+		return key, nil
+	}
+	if !ok && age < minRefreshInterval {
+		// 刚刷新过仍找不到, 避免伪造 kid 频繁触发拉取
+		return nil, fmt.Errorf("%w: kid %s", ErrKeyNotFound, kid)
+	}
+
+	// 缓存过期或 kid 未知(密钥轮换), 重新拉取
+	if err := ks.refresh(ctx); err != nil {
+		if ok {
+			// 拉取失败时继续使用旧公钥
+			return key, nil
+		}
+		return nil, err
+	}
+
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	if key, ok = ks.keys[kid]; !ok {
+		return nil, fmt.Errorf("%w: kid %s", ErrKeyNotFound, kid)
+	}
+	return key, nil
+}
+
+func (ks *KeySet) refresh(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ks.url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := ks.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("oidc: jwks endpoint returned status code: %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return err
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(body.Keys))
+	for _, k := range body.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		pub, err := k.publicKey()
+		if err != nil {
+			// 跳过无法识别的公钥, 不影响其他公钥使用
+			continue
+		}
+		keys[k.Kid] = pub
+	}
+
+	ks.mu.Lock()
+	ks.keys = keys
+	ks.fetchedAt = time.Now()
+	ks.mu.Unlock()
+	return nil
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("oidc: unsupported curve %s", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{
+			Curve: curve,
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}, nil
+	default:
+		return nil, fmt.Errorf("oidc: unsupported key type %s", k.Kty)
+	}
+}
@@ -0,0 +1,137 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// newTestIssuer 启动一个提供 discovery 和 JWKS 的本地 issuer
+func newTestIssuer(t *testing.T, key *rsa.PrivateKey, kid string) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   srv.URL,
+			"jwks_uri": srv.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kid": kid,
+				"kty": "RSA",
+				"alg": "RS256",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func signToken(t *testing.T, key *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	raw, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("error signing token, %s", err)
+	}
+	return raw
+}
+
+func TestVerify(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("error generating key, %s", err)
+	}
+	srv := newTestIssuer(t, key, "k1")
+	v := NewVerifier(Config{Issuer: srv.URL, ClientIDs: []string{"client-a"}}, srv.Client())
+
+	raw := signToken(t, key, "k1", jwt.MapClaims{
+		"iss":            srv.URL,
+		"aud":            "client-a",
+		"sub":            "user-1",
+		"email":          "a@example.com",
+		"email_verified": "true",
+		"exp":            time.Now().Add(time.Hour).Unix(),
+	})
+	claims, err := v.Verify(context.Background(), raw)
+	if err != nil {
+		t.Fatalf("error verifying token, %s", err)
+	}
+	if claims.String("sub") != "user-1" || !claims.Bool("email_verified") {
+		t.Fatalf("unexpected claims %v", claims)
+	}
+}
+
+func TestVerifyRejects(t *testing.T) {
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	other, _ := rsa.GenerateKey(rand.Reader, 2048)
+	srv := newTestIssuer(t, key, "k1")
+	v := NewVerifier(Config{Issuer: srv.URL, ClientIDs: []string{"client-a"}}, srv.Client())
+	exp := time.Now().Add(time.Hour).Unix()
+
+	tests := []struct {
+		name   string
+		token  string
+		target error
+	}{
+		{"issuer", signToken(t, key, "k1", jwt.MapClaims{"iss": "https://evil", "aud": "client-a", "sub": "1", "exp": exp}), ErrInvalidIssuer},
+		{"audience", signToken(t, key, "k1", jwt.MapClaims{"iss": srv.URL, "aud": "client-b", "sub": "1", "exp": exp}), ErrInvalidAudience},
+		{"subject", signToken(t, key, "k1", jwt.MapClaims{"iss": srv.URL, "aud": "client-a", "exp": exp}), ErrMissingSubject},
+		{"kid", signToken(t, key, "k2", jwt.MapClaims{"iss": srv.URL, "aud": "client-a", "sub": "1", "exp": exp}), ErrKeyNotFound},
+		{"signature", signToken(t, other, "k1", jwt.MapClaims{"iss": srv.URL, "aud": "client-a", "sub": "1", "exp": exp}), nil},
+		{"expired", signToken(t, key, "k1", jwt.MapClaims{"iss": srv.URL, "aud": "client-a", "sub": "1", "exp": time.Now().Add(-time.Hour).Unix()}), nil},
+	}
+	for _, tt := range tests {
+		_, err := v.Verify(context.Background(), tt.token)
+		if err == nil {
+			t.Errorf("%s: expected error", tt.name)
+			continue
+		}
+		if tt.target != nil && !errors.Is(err, tt.target) {
+			t.Errorf("%s: got %v, want %v", tt.name, err, tt.target)
+		}
+	}
+}
+
+func TestVerifyWithoutClientIDs(t *testing.T) {
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	srv := newTestIssuer(t, key, "k1")
+	v := NewVerifier(Config{Issuer: srv.URL}, srv.Client())
+
+	raw := signToken(t, key, "k1", jwt.MapClaims{"iss": srv.URL, "aud": "any-app", "sub": "1", "exp": time.Now().Add(time.Hour).Unix()})
+	if _, err := v.Verify(context.Background(), raw); !errors.Is(err, ErrInvalidAudience) {
+		t.Fatalf("got %v, want %v", err, ErrInvalidAudience)
+	}
+}
+
+func TestVerifyNonce(t *testing.T) {
+	sum := sha256.Sum256([]byte("raw-nonce"))
+	if err := VerifyNonce(Claims{"nonce": "raw-nonce"}, "raw-nonce"); err != nil {
+		t.Fatalf("plain nonce rejected, %s", err)
+	}
+	if err := VerifyNonce(Claims{"nonce": hex.EncodeToString(sum[:])}, "raw-nonce"); err != nil {
+		t.Fatalf("hashed nonce rejected, %s", err)
+	}
+	if err := VerifyNonce(Claims{"nonce": "other"}, "raw-nonce"); err == nil {
+		t.Fatalf("mismatched nonce accepted")
+	}
+}
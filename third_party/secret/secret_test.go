@@ -0,0 +1,50 @@
+package secret
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+)
+
+func newTestCipher(t *testing.T) *Cipher {
+	t.Helper()
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatalf("error generating key, %s", err)
+	}
+	c, err := NewCipher(base64.StdEncoding.EncodeToString(key))
+	if err != nil {
+		t.Fatalf("error creating cipher, %s", err)
+	}
+	return c
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	c := newTestCipher(t)
+	ciphertext, err := c.Encrypt("access-token")
+	if err != nil {
+		t.Fatalf("error encrypting, %s", err)
+	}
+	if !IsEncrypted(ciphertext) {
+		t.Fatalf("expected encrypted value, got %s", ciphertext)
+	}
+
+	plaintext, err := c.Decrypt(ciphertext)
+	if err != nil {
+		t.Fatalf("error decrypting, %s", err)
+	}
+	if plaintext != "access-token" {
+		t.Fatalf("expected access-token, got %s", plaintext)
+	}
+
+	// 其他密钥无法解密
+	if _, err = newTestCipher(t).Decrypt(ciphertext); err != ErrInvalidCiphertext {
+		t.Fatalf("expected ErrInvalidCiphertext, got %v", err)
+	}
+}
+
+func TestNewCipherRejectsShortKey(t *testing.T) {
+	if _, err := NewCipher(base64.StdEncoding.EncodeToString([]byte("short"))); err == nil {
+		t.Fatal("expected error for short key")
+	}
+}
@@ -0,0 +1,153 @@
+package wechat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultBaseURL 微信开放接口地址
+const DefaultBaseURL = "https://api.weixin.qq.com"
+
+// Error 微信接口返回的错误
+type Error struct {
+	Code int    `json:"errcode"`
+	Msg  string `json:"errmsg"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("wechat: errcode=%d errmsg=%s", e.Code, e.Msg)
+}
+
+// AccessToken 移动应用 OAuth code 换取的令牌
+type AccessToken struct {
+	AccessToken  string `json:"access_token"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token"`
+	OpenID       string `json:"openid"`
+	Scope        string `json:"scope"`
+	UnionID      string `json:"unionid"`
+}
+
+// UserInfo 移动应用用户信息
+type UserInfo struct {
+	OpenID     string `json:"openid"`
+	UnionID    string `json:"unionid"`
+	Nickname   string `json:"nickname"`
+	HeadImgURL string `json:"headimgurl"`
+}
+
+// Session 小程序 code2session 结果
+type Session struct {
+	OpenID     string `json:"openid"`
+	SessionKey string `json:"session_key"`
+	UnionID    string `json:"unionid"`
+}
+
+// Client 微信开放接口客户端
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient 创建微信客户端, baseURL 为空时使用微信正式地址
+func NewClient(baseURL string, httpClient *http.Client) *Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: httpClient,
+	}
+}
+
+// OAuthAccessToken 移动应用使用授权 code 换取 access_token
+func (c *Client) OAuthAccessToken(ctx context.Context, appID, appSecret, code string) (*AccessToken, error) {
+	var token AccessToken
+	err := c.get(ctx, "/sns/oauth2/access_token", url.Values{
+		"appid":      {appID},
+		"secret":     {appSecret},
+		"code":       {code},
+		"grant_type": {"authorization_code"},
+	}, &token)
+	if err != nil {
+		return nil, err
+	}
+	if token.OpenID == "" {
+		return nil, fmt.Errorf("wechat: empty openid in access_token response")
+	}
+	return &token, nil
+}
+
+// UserInfo 使用 access_token 获取用户信息
+func (c *Client) UserInfo(ctx context.Context, accessToken, openID string) (*UserInfo, error) {
+	var info UserInfo
+	err := c.get(ctx, "/sns/userinfo", url.Values{
+		"access_token": {accessToken},
+		"openid":       {openID},
+		"lang":         {"zh_CN"},
+	}, &info)
+	if err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// Code2Session 小程序使用 wx.login 的 code 换取 openid/unionid
+func (c *Client) Code2Session(ctx context.Context, appID, appSecret, code string) (*Session, error) {
+	var session Session
+	err := c.get(ctx, "/sns/jscode2session", url.Values{
+		"appid":      {appID},
+		"secret":     {appSecret},
+		"js_code":    {code},
+		"grant_type": {"authorization_code"},
+	}, &session)
+	if err != nil {
+		return nil, err
+	}
+	if session.OpenID == "" {
+		return nil, fmt.Errorf("wechat: empty openid in code2session response")
+	}
+	return &session, nil
+}
+
+func (c *Client) get(ctx context.Context, path string, query url.Values, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path+"?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("wechat: %s returned status code: %d", path, resp.StatusCode)
+	}
+
+	// 微信接口出错时同样返回 200, 需要先检查 errcode
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	var apiErr Error
+	if err = json.Unmarshal(body, &apiErr); err != nil {
+		return err
+	}
+	if apiErr.Code != 0 {
+		return &apiErr
+	}
+	return json.Unmarshal(body, out)
+}
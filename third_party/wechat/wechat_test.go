@@ -0,0 +1,104 @@
+package wechat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newStandIn 启动一个模拟微信开放接口的本地服务
+func newStandIn(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/sns/oauth2/access_token", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("code") != "good-code" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"errcode": 40029, "errmsg": "invalid code"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "token-1",
+			"expires_in":   7200,
+			"openid":       "app-openid",
+			"unionid":      "union-1",
+		})
+	})
+	mux.HandleFunc("/sns/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("access_token") != "token-1" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"errcode": 40001, "errmsg": "invalid credential"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"openid":     r.URL.Query().Get("openid"),
+			"unionid":    "union-1",
+			"nickname":   "微信用户",
+			"headimgurl": "https://thirdwx.qlogo.cn/avatar",
+		})
+	})
+	mux.HandleFunc("/sns/jscode2session", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("js_code") != "mp-code" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"errcode": 40163, "errmsg": "code been used"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"openid":      "mp-openid",
+			"session_key": "key",
+			"unionid":     "union-1",
+		})
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestAppLogin(t *testing.T) {
+	srv := newStandIn(t)
+	c := NewClient(srv.URL, srv.Client())
+
+	token, err := c.OAuthAccessToken(context.Background(), "app-id", "secret", "good-code")
+	if err != nil {
+		t.Fatalf("error exchanging code, %s", err)
+	}
+	if token.OpenID != "app-openid" || token.UnionID != "union-1" {
+		t.Fatalf("unexpected token %+v", token)
+	}
+
+	info, err := c.UserInfo(context.Background(), token.AccessToken, token.OpenID)
+	if err != nil {
+		t.Fatalf("error getting user info, %s", err)
+	}
+	if info.Nickname != "微信用户" || info.UnionID != "union-1" {
+		t.Fatalf("unexpected user info %+v", info)
+	}
+}
+
+func TestMiniProgramLogin(t *testing.T) {
+	srv := newStandIn(t)
+	c := NewClient(srv.URL, srv.Client())
+
+	session, err := c.Code2Session(context.Background(), "mp-id", "secret", "mp-code")
+	if err != nil {
+		t.Fatalf("error calling code2session, %s", err)
+	}
+	if session.OpenID != "mp-openid" || session.UnionID != "union-1" {
+		t.Fatalf("unexpected session %+v", session)
+	}
+}
+
+func TestErrCode(t *testing.T) {
+	srv := newStandIn(t)
+	c := NewClient(srv.URL, srv.Client())
+
+	_, err := c.OAuthAccessToken(context.Background(), "app-id", "secret", "bad-code")
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Code != 40029 {
+		t.Fatalf("expected errcode 40029, got %v", err)
+	}
+
+	_, err = c.Code2Session(context.Background(), "mp-id", "secret", "used-code")
+	if !errors.As(err, &apiErr) || apiErr.Code != 40163 {
+		t.Fatalf("expected errcode 40163, got %v", err)
+	}
+}